@@ -0,0 +1,297 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// ================================
+// ADVERSARIAL INPUT GENERATORS
+// ================================
+
+// AdversarialSortedArray returns 0..n-1 in ascending order. QuickSelect
+// always pivots on the last element, so on sorted input every partition
+// splits off a single element and selecting the minimum takes n²/2
+// comparisons.
+func AdversarialSortedArray(n int) []int {
+	values := make([]int, n)
+	for i := range values {
+		values[i] = i
+	}
+	return values
+}
+
+// AdversarialMatchingInput returns a text of n 'A's and a pattern of m-1
+// 'A's followed by a 'B'. Naive matching compares all m characters at every
+// one of the n-m+1 alignments before failing on the 'B'.
+func AdversarialMatchingInput(n, m int) (text, pattern string) {
+	return strings.Repeat("A", n), strings.Repeat("A", m-1) + "B"
+}
+
+// AdversarialUnionSequence returns the unions (0, 1), (0, 2), ..., (0, n-1).
+// Without union by rank, each union hangs the root of 0's tree under the
+// new element, growing one long chain that every later Find(0) must walk.
+func AdversarialUnionSequence(n int) [][2]int {
+	unions := make([][2]int, 0, n-1)
+	for i := 1; i < n; i++ {
+		unions = append(unions, [2]int{0, i})
+	}
+	return unions
+}
+
+// AdversarialBSTKeys returns 0..n-1 in ascending order: inserted into an
+// unbalanced BST they form a single right spine of height n
+func AdversarialBSTKeys(n int) []int {
+	return AdversarialSortedArray(n)
+}
+
+// ================================
+// INSTRUMENTED VERSIONS
+// ================================
+
+// countQuickSelect runs the same Lomuto-partition QuickSelect as
+// QuickSelect, with the pivot index chosen by pickPivot, and returns the
+// k-th smallest value and the number of element comparisons
+func countQuickSelect(values []int, k int, pickPivot func(left, right int) int) (int, int) {
+	nums := append([]int(nil), values...)
+	comparisons := 0
+	left, right := 0, len(nums)-1
+	for left < right {
+		p := pickPivot(left, right)
+		nums[p], nums[right] = nums[right], nums[p]
+		pivot, i := nums[right], left
+		for j := left; j < right; j++ {
+			comparisons++
+			if nums[j] <= pivot {
+				nums[i], nums[j] = nums[j], nums[i]
+				i++
+			}
+		}
+		nums[i], nums[right] = nums[right], nums[i]
+		switch {
+		case k == i:
+			return nums[k], comparisons
+		case k < i:
+			right = i - 1
+		default:
+			left = i + 1
+		}
+	}
+	return nums[k], comparisons
+}
+
+// countNaiveSearch returns the matches of NaiveSearch (without its
+// tracing) and the number of character comparisons
+func countNaiveSearch(text, pattern string) ([]int, int) {
+	matches, comparisons := []int{}, 0
+	for i := 0; i+len(pattern) <= len(text); i++ {
+		j := 0
+		for j < len(pattern) {
+			comparisons++
+			if text[i+j] != pattern[j] {
+				break
+			}
+			j++
+		}
+		if j == len(pattern) {
+			matches = append(matches, i)
+		}
+	}
+	return matches, comparisons
+}
+
+// countKMPSearch returns the matches of KMPSearchSimple and the number of
+// character comparisons, not counting the LPS table
+func countKMPSearch(text, pattern string) ([]int, int) {
+	lps := buildLPS(pattern)
+	matches, comparisons := []int{}, 0
+	for i, j := 0, 0; i < len(text); {
+		comparisons++
+		if text[i] == pattern[j] {
+			i++
+			j++
+			if j == len(pattern) {
+				matches = append(matches, i-j)
+				j = lps[j-1]
+			}
+		} else if j != 0 {
+			j = lps[j-1]
+		} else {
+			i++
+		}
+	}
+	return matches, comparisons
+}
+
+// countingUnionFind is a union-find whose two optimizations can be switched
+// off separately; hops counts parent pointers followed by Find
+type countingUnionFind struct {
+	parent, rank []int
+	byRank       bool
+	compress     bool
+	hops         int
+}
+
+func newCountingUnionFind(n int, byRank, compress bool) *countingUnionFind {
+	uf := &countingUnionFind{parent: make([]int, n), rank: make([]int, n), byRank: byRank, compress: compress}
+	for i := range uf.parent {
+		uf.parent[i] = i
+	}
+	return uf
+}
+
+func (uf *countingUnionFind) Find(x int) int {
+	root := x
+	for uf.parent[root] != root {
+		root = uf.parent[root]
+		uf.hops++
+	}
+	for uf.compress && uf.parent[x] != root {
+		uf.parent[x], x = root, uf.parent[x]
+	}
+	return root
+}
+
+func (uf *countingUnionFind) Union(x, y int) {
+	rootX, rootY := uf.Find(x), uf.Find(y)
+	if rootX == rootY {
+		return
+	}
+	if uf.byRank && uf.rank[rootX] > uf.rank[rootY] {
+		rootX, rootY = rootY, rootX
+	}
+	uf.parent[rootX] = rootY // the naive rule: x's root goes under y's
+	if uf.byRank && uf.rank[rootX] == uf.rank[rootY] {
+		uf.rank[rootY]++
+	}
+}
+
+// bstTotalDepth returns the sum of the depths of all nodes, counting the
+// root as 1: the number of comparisons Insert made to build the tree
+func bstTotalDepth(node *BSTNode, depth int) int {
+	if node == nil {
+		return 0
+	}
+	return depth + bstTotalDepth(node.Left, depth+1) + bstTotalDepth(node.Right, depth+1)
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoAdversarialInputs feeds each algorithm its worst case and a random
+// input of the same size, and counts the work done
+func DemoAdversarialInputs() {
+	fmt.Println("=== ADVERSARIAL INPUTS ===")
+	fmt.Println()
+	rng := rand.New(rand.NewSource(1036))
+	row := func(label string, random, adversarial int) {
+		fmt.Printf("  %-34s random %12d   adversarial %12d   (%.0fx)\n",
+			label, random, adversarial, float64(adversarial)/float64(max(random, 1)))
+	}
+
+	// Example 1: QuickSelect
+	const n = 20_000
+	fmt.Printf("=== EXAMPLE 1: QuickSelect, Minimum of %d Values ===\n", n)
+	shuffled := rng.Perm(n)
+	sorted := AdversarialSortedArray(n)
+	lastPivot := func(left, right int) int { return right }
+	randomPivot := func(left, right int) int { return left + rng.Intn(right-left+1) }
+	_, randomLast := countQuickSelect(shuffled, 0, lastPivot)
+	_, sortedLast := countQuickSelect(sorted, 0, lastPivot)
+	_, randomRandom := countQuickSelect(shuffled, 0, randomPivot)
+	_, sortedRandom := countQuickSelect(sorted, 0, randomPivot)
+	fmt.Println("Comparisons:")
+	row("last-element pivot", randomLast, sortedLast)
+	row("random pivot", randomRandom, sortedRandom)
+	start := time.Now()
+	QuickSelect(sorted, 0)
+	naiveTime := time.Since(start)
+	start = time.Now()
+	QuickSelectRandomized(sorted, 0)
+	fmt.Printf("QuickSelect on sorted input: %v; QuickSelectRandomized: %v\n",
+		naiveTime.Round(time.Millisecond), time.Since(start).Round(time.Microsecond))
+	fmt.Println()
+
+	// Example 2: String matching
+	const textLength, patternLength = 100_000, 200
+	fmt.Printf("=== EXAMPLE 2: Matching a %d-Character Pattern in %d Characters ===\n", patternLength, textLength)
+	text, pattern := AdversarialMatchingInput(textLength, patternLength)
+	randomBytes := make([]byte, textLength)
+	for i := range randomBytes {
+		randomBytes[i] = "AB"[rng.Intn(2)]
+	}
+	randomText := string(randomBytes)
+	_, randomNaive := countNaiveSearch(randomText, pattern)
+	_, adversarialNaive := countNaiveSearch(text, pattern)
+	_, randomKMP := countKMPSearch(randomText, pattern)
+	_, adversarialKMP := countKMPSearch(text, pattern)
+	fmt.Printf("Text %q..., pattern %q...%q\n", text[:8], pattern[:8], pattern[len(pattern)-3:])
+	fmt.Println("Character comparisons:")
+	row("naive", randomNaive, adversarialNaive)
+	row("KMP", randomKMP, adversarialKMP)
+	fmt.Println()
+
+	// Example 3: Union-find
+	const elements = 10_000
+	fmt.Printf("=== EXAMPLE 3: Union-Find, %d Unions then %d Finds ===\n", elements-1, elements)
+	randomUnions := make([][2]int, elements-1)
+	for i := range randomUnions {
+		randomUnions[i] = [2]int{rng.Intn(elements), rng.Intn(elements)}
+	}
+	hops := func(unions [][2]int, byRank, compress bool) int {
+		uf := newCountingUnionFind(elements, byRank, compress)
+		for _, u := range unions {
+			uf.Union(u[0], u[1])
+		}
+		for i := 0; i < elements; i++ {
+			uf.Find(0)
+		}
+		return uf.hops
+	}
+	fmt.Println("Parent pointers followed:")
+	for _, variant := range []struct {
+		label            string
+		byRank, compress bool
+	}{
+		{"no optimizations", false, false},
+		{"path compression only", false, true},
+		{"union by rank only", true, false},
+		{"both (as in UnionFind)", true, true},
+	} {
+		row(variant.label, hops(randomUnions, variant.byRank, variant.compress),
+			hops(AdversarialUnionSequence(elements), variant.byRank, variant.compress))
+	}
+	fmt.Println()
+
+	// Example 4: Binary search tree
+	const keys = 5_000
+	fmt.Printf("=== EXAMPLE 4: Inserting %d Keys into a BST ===\n", keys)
+	balanced, skewed := NewBST(), NewBST()
+	for _, key := range rng.Perm(keys) {
+		balanced.Insert(key)
+	}
+	start = time.Now()
+	for _, key := range AdversarialBSTKeys(keys) {
+		skewed.Insert(key)
+	}
+	skewedTime := time.Since(start)
+	avl := NewOrderStatisticTree()
+	start = time.Now()
+	for _, key := range AdversarialBSTKeys(keys) {
+		avl.Insert(key)
+	}
+	avlTime := time.Since(start)
+	row("BST height", balanced.Height(), skewed.Height())
+	row("BST comparisons while inserting", bstTotalDepth(balanced.root, 1), bstTotalDepth(skewed.root, 1))
+	fmt.Printf("Sorted keys into the BST: %v; into the AVL OrderStatisticTree: %v (height %d)\n",
+		skewedTime.Round(time.Microsecond), avlTime.Round(time.Microsecond), ostHeight(avl.root))
+	fmt.Println()
+
+	fmt.Println("The worst cases are not rare accidents but specific, easy-to-produce")
+	fmt.Println("inputs, often just sorted data. Each optimization turns its quadratic")
+	fmt.Println("blow-up back into near-linear work, whatever the input.")
+	fmt.Println()
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+)
+
+// ================================
+// ALIEN DICTIONARY
+// ================================
+
+// AlienOrder derives an alphabet order from words sorted in an unknown
+// language. Each adjacent pair of words gives at most one rule: the first
+// position where they differ says which letter comes first. The rules form
+// a DirectedGraph, and any topological order of it is a valid alphabet.
+// Returns an error when the list contradicts itself (a cycle, or a word
+// listed before its own prefix).
+// Time Complexity: O(C) where C = total number of characters
+// Space Complexity: O(U + min(U², N)) for U unique letters and N words
+func AlienOrder(words []string) (string, error) {
+	// Number letters in order of first appearance
+	letters := []rune{}
+	index := make(map[rune]int)
+	for _, word := range words {
+		for _, letter := range word {
+			if _, seen := index[letter]; !seen {
+				index[letter] = len(letters)
+				letters = append(letters, letter)
+			}
+		}
+	}
+
+	graph := NewDirectedGraph(len(letters))
+	added := make(map[[2]int]bool)
+
+	for i := 0; i+1 < len(words); i++ {
+		first, second := []rune(words[i]), []rune(words[i+1])
+		length := min(len(first), len(second))
+
+		differs := false
+		for j := 0; j < length; j++ {
+			if first[j] != second[j] {
+				rule := [2]int{index[first[j]], index[second[j]]}
+				if !added[rule] {
+					added[rule] = true
+					graph.AddEdge(rule[0], rule[1])
+				}
+				differs = true
+				break
+			}
+		}
+
+		if !differs && len(first) > len(second) {
+			return "", fmt.Errorf("%q is listed before its prefix %q", words[i], words[i+1])
+		}
+	}
+
+	order, err := TopologicalOrder(graph)
+	if err != nil {
+		return "", fmt.Errorf("contradictory ordering: %w", err)
+	}
+
+	alphabet := make([]rune, len(order))
+	for i, v := range order {
+		alphabet[i] = letters[v]
+	}
+	return string(alphabet), nil
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoAlienDictionary derives alphabets from sorted word lists
+func DemoAlienDictionary() {
+	fmt.Println("=== ALIEN DICTIONARY ===")
+	fmt.Println()
+
+	fmt.Println("Given words sorted in an unknown alphabet, recover the alphabet.")
+	fmt.Println("Comparing neighbors gives rules like 't < f'; a topological sort")
+	fmt.Println("of those rules is a consistent letter order.")
+	fmt.Println()
+
+	examples := []struct {
+		name  string
+		words []string
+	}{
+		{"Classic", []string{"wrt", "wrf", "er", "ett", "rftt"}},
+		{"Two letters", []string{"z", "x"}},
+		{"Contradiction", []string{"z", "x", "z"}},
+		{"Prefix after longer word", []string{"abc", "ab"}},
+		{"Unicode", []string{"αβ", "αγ", "βγ"}},
+	}
+
+	for _, example := range examples {
+		order, err := AlienOrder(example.words)
+		fmt.Printf("%s: %v\n", example.name, example.words)
+		if err != nil {
+			fmt.Printf("  Error: %v\n\n", err)
+			continue
+		}
+		fmt.Printf("  Alphabet: %s\n\n", order)
+	}
+
+	fmt.Println("Letters with no rules between them may appear in any relative order.")
+	fmt.Println()
+}
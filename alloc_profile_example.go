@@ -0,0 +1,150 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"runtime"
+	"time"
+)
+
+// ================================
+// ALLOCATION PROFILING
+// ================================
+
+// allocationProfile is the cost of one call, averaged over several runs
+type allocationProfile struct {
+	allocs  float64
+	bytes   float64
+	elapsed time.Duration
+}
+
+// profileAllocations runs f the given number of times and reports the heap
+// allocations, allocated bytes and time per run
+func profileAllocations(runs int, f func()) allocationProfile {
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+	for i := 0; i < runs; i++ {
+		f()
+	}
+	elapsed := time.Since(start)
+	runtime.ReadMemStats(&after)
+	return allocationProfile{
+		allocs:  float64(after.Mallocs-before.Mallocs) / float64(runs),
+		bytes:   float64(after.TotalAlloc-before.TotalAlloc) / float64(runs),
+		elapsed: elapsed / time.Duration(runs),
+	}
+}
+
+// ================================
+// PREVIOUS VERSIONS (BASELINES)
+// ================================
+
+// getPathPrepend is how GetPath used to rebuild a path: prepending copies
+// the whole path at every step
+func getPathPrepend(previous []int, target int) []int {
+	path := []int{}
+	for current := target; current != -1; current = previous[current] {
+		path = append([]int{current}, path...)
+	}
+	return path
+}
+
+// collectWordsConcat is how Trie.collectWords used to build words: a new
+// string per node visited
+func collectWordsConcat(node *TrieNode, currentWord string, words *[]string) {
+	if node.isEnd {
+		for i := 0; i < node.count; i++ {
+			*words = append(*words, currentWord)
+		}
+	}
+	for _, char := range sortedKeys(node.children) {
+		collectWordsConcat(node.children[char], currentWord+string(char), words)
+	}
+}
+
+// traverseBFSReslice is how TraverseBFS used to queue vertices: the slice
+// is re-sliced from the front, so its capacity keeps being abandoned and
+// append has to grow a fresh array again and again
+func traverseBFSReslice(g AdjacencyGraph, start int) []int {
+	visited := map[int]bool{start: true}
+	queue := []int{start}
+	order := []int{}
+	for len(queue) > 0 {
+		vertex := queue[0]
+		queue = queue[1:]
+		order = append(order, vertex)
+		for _, neighbor := range g.Neighbors(vertex) {
+			if !visited[neighbor] {
+				visited[neighbor] = true
+				queue = append(queue, neighbor)
+			}
+		}
+	}
+	return order
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoAllocationProfile compares the allocation-aware hot paths with the
+// versions they replaced
+func DemoAllocationProfile() {
+	fmt.Println("=== ALLOCATIONS IN HOT PATHS ===")
+	fmt.Println()
+	row := func(label string, p allocationProfile) {
+		fmt.Printf("  %-28s %10.0f allocs %12.0f bytes %12v\n", label, p.allocs, p.bytes, p.elapsed.Round(time.Microsecond))
+	}
+
+	// Example 1: Path reconstruction
+	const pathLength = 5_000
+	fmt.Printf("=== EXAMPLE 1: GetPath on a %d-Vertex Chain ===\n", pathLength)
+	result := &DijkstraResult{distances: make([]float64, pathLength), previous: make([]int, pathLength)}
+	for v := range result.previous {
+		result.previous[v] = v - 1
+	}
+	before, after := getPathPrepend(result.previous, pathLength-1), result.GetPath(pathLength-1)
+	row("prepend (before)", profileAllocations(20, func() { getPathPrepend(result.previous, pathLength-1) }))
+	row("append + reverse (after)", profileAllocations(20, func() { result.GetPath(pathLength - 1) }))
+	fmt.Printf("  Same path: %v\n", fmt.Sprint(before) == fmt.Sprint(after))
+	fmt.Println()
+
+	// Example 2: Word collection
+	words := docWords()
+	fmt.Printf("=== EXAMPLE 2: Trie.GetAllWords over %d Words ===\n", len(words))
+	trie := NewTrie()
+	for _, word := range words {
+		trie.InsertSimple(word)
+	}
+	var concatenated []string
+	collectWordsConcat(trie.root, "", &concatenated)
+	row("string concat (before)", profileAllocations(20, func() {
+		var collected []string
+		collectWordsConcat(trie.root, "", &collected)
+	}))
+	row("byte buffer (after)", profileAllocations(20, func() { trie.GetAllWords() }))
+	fmt.Printf("  Same words: %v\n", fmt.Sprint(concatenated) == fmt.Sprint(trie.GetAllWords()))
+	fmt.Println()
+
+	// Example 3: BFS queue
+	const vertices = 100_000
+	fmt.Printf("=== EXAMPLE 3: TraverseBFS on a Random Graph (V=%d, E=%d) ===\n", vertices, 3*vertices)
+	graph := NewGraph(vertices)
+	rng := rand.New(rand.NewSource(1038))
+	for e := 0; e < 3*vertices; e++ {
+		graph.AddEdge(rng.Intn(vertices), rng.Intn(vertices))
+	}
+	row("re-sliced slice (before)", profileAllocations(5, func() { traverseBFSReslice(graph, 0) }))
+	row("ring-buffer Queue (after)", profileAllocations(5, func() { TraverseBFS(graph, 0) }))
+	fmt.Printf("  Same order: %v\n", fmt.Sprint(traverseBFSReslice(graph, 0)) == fmt.Sprint(TraverseBFS(graph, 0)))
+	fmt.Println()
+
+	fmt.Println("Prepending was the real hazard: quadratic copying that dominates long")
+	fmt.Println("paths. The byte buffer removes the per-node prefix strings; most of what")
+	fmt.Println("remains is the sorted child keys and the words themselves. The BFS queue")
+	fmt.Println("saves bytes rather than time, since the visited map dominates there.")
+	fmt.Println("For steadier numbers run: go test -run '^$' -bench 'GetPath|CollectWords|TraverseBFS' -benchmem")
+	fmt.Println()
+}
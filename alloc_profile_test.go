@@ -0,0 +1,107 @@
+package main
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// The benchmarks below compare each hot path with the version it replaced,
+// on the inputs of DemoAllocationProfile. Run them with
+//
+//	go test -run '^$' -bench 'GetPath|CollectWords|TraverseBFS' -benchmem
+
+// chainResult is a DijkstraResult whose shortest-path tree is one chain
+func chainResult(length int) *DijkstraResult {
+	result := &DijkstraResult{distances: make([]float64, length), previous: make([]int, length)}
+	for v := range result.previous {
+		result.previous[v] = v - 1
+	}
+	return result
+}
+
+func docTrie() *Trie {
+	trie := NewTrie()
+	for _, word := range docWords() {
+		trie.InsertSimple(word)
+	}
+	return trie
+}
+
+func randomBFSGraph(vertices int) *Graph {
+	graph := NewGraph(vertices)
+	rng := rand.New(rand.NewSource(1038))
+	for e := 0; e < 3*vertices; e++ {
+		graph.AddEdge(rng.Intn(vertices), rng.Intn(vertices))
+	}
+	return graph
+}
+
+func TestAllocationBaselinesAgree(t *testing.T) {
+	result := chainResult(500)
+	if got, want := result.GetPath(499), getPathPrepend(result.previous, 499); !slices.Equal(got, want) {
+		t.Errorf("GetPath = %v, want %v", got, want)
+	}
+
+	trie := docTrie()
+	var concatenated []string
+	collectWordsConcat(trie.root, "", &concatenated)
+	if got := trie.GetAllWords(); !slices.Equal(got, concatenated) {
+		t.Errorf("GetAllWords = %v, want %v", got, concatenated)
+	}
+
+	graph := randomBFSGraph(2_000)
+	if got, want := TraverseBFS(graph, 0), traverseBFSReslice(graph, 0); !slices.Equal(got, want) {
+		t.Errorf("TraverseBFS differs from the re-sliced queue:\n%v\n%v", got, want)
+	}
+}
+
+func BenchmarkGetPath(b *testing.B) {
+	const length = 5_000
+	result := chainResult(length)
+	b.Run("prepend", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			getPathPrepend(result.previous, length-1)
+		}
+	})
+	b.Run("append+reverse", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			result.GetPath(length - 1)
+		}
+	})
+}
+
+func BenchmarkCollectWords(b *testing.B) {
+	trie := docTrie()
+	b.Run("concat", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var words []string
+			collectWordsConcat(trie.root, "", &words)
+		}
+	})
+	b.Run("buffer", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			trie.GetAllWords()
+		}
+	})
+}
+
+func BenchmarkTraverseBFS(b *testing.B) {
+	graph := randomBFSGraph(100_000)
+	b.Run("reslice", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			traverseBFSReslice(graph, 0)
+		}
+	})
+	b.Run("queue", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			TraverseBFS(graph, 0)
+		}
+	})
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ================================
+// ARTICULATION POINTS AND BRIDGES
+// ================================
+
+// CutAnalysis holds the single points of failure of an undirected graph
+type CutAnalysis struct {
+	ArticulationPoints []int   // vertices whose removal disconnects the graph
+	Bridges            [][]int // edges {u, v} with u < v whose removal disconnects the graph
+}
+
+// FindCuts finds articulation points and bridges with Tarjan's low-link DFS.
+// low[v] is the earliest discovery time reachable from v's subtree using at
+// most one back edge:
+//   - tree edge (u, v) is a bridge when low[v] > disc[u]
+//   - non-root u is a cut vertex when some child v has low[v] >= disc[u]
+//   - the DFS root is a cut vertex when it has two or more children
+//
+// Parallel edges are handled by skipping the parent edge only once.
+// Time Complexity: O(V + E)
+// Space Complexity: O(V)
+func (g *Graph) FindCuts() CutAnalysis {
+	timer := 0
+	discovery := make([]int, g.vertices)
+	low := make([]int, g.vertices)
+	for i := range discovery {
+		discovery[i] = -1
+	}
+
+	isCut := make([]bool, g.vertices)
+	bridges := [][]int{}
+
+	var dfs func(u, parent int)
+	dfs = func(u, parent int) {
+		discovery[u] = timer
+		low[u] = timer
+		timer++
+
+		children := 0
+		skippedParent := false
+		for _, v := range g.adjList[u] {
+			if v == parent && !skippedParent {
+				skippedParent = true
+				continue
+			}
+
+			if discovery[v] == -1 {
+				children++
+				dfs(v, u)
+				low[u] = min(low[u], low[v])
+
+				if low[v] > discovery[u] {
+					bridges = append(bridges, []int{min(u, v), max(u, v)})
+				}
+				if parent != -1 && low[v] >= discovery[u] {
+					isCut[u] = true
+				}
+			} else {
+				low[u] = min(low[u], discovery[v])
+			}
+		}
+
+		if parent == -1 && children > 1 {
+			isCut[u] = true
+		}
+	}
+
+	for v := 0; v < g.vertices; v++ {
+		if discovery[v] == -1 {
+			dfs(v, -1)
+		}
+	}
+
+	points := []int{}
+	for v, cut := range isCut {
+		if cut {
+			points = append(points, v)
+		}
+	}
+	sort.Slice(bridges, func(i, j int) bool {
+		if bridges[i][0] != bridges[j][0] {
+			return bridges[i][0] < bridges[j][0]
+		}
+		return bridges[i][1] < bridges[j][1]
+	})
+
+	return CutAnalysis{ArticulationPoints: points, Bridges: bridges}
+}
+
+// ArticulationPoints returns the cut vertices of the graph in ascending order
+func (g *Graph) ArticulationPoints() []int {
+	return g.FindCuts().ArticulationPoints
+}
+
+// Bridges returns the bridge edges of the graph as sorted {u, v} pairs
+func (g *Graph) Bridges() [][]int {
+	return g.FindCuts().Bridges
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoArticulationPoints finds single points of failure in a router network
+func DemoArticulationPoints() {
+	fmt.Println("=== ARTICULATION POINTS AND BRIDGES ===")
+	fmt.Println()
+
+	// Example 1: Small textbook graph
+	fmt.Println("=== EXAMPLE 1: Basic Graph ===")
+	graph := NewGraph(5)
+	graph.AddEdge(1, 0)
+	graph.AddEdge(0, 2)
+	graph.AddEdge(2, 1)
+	graph.AddEdge(0, 3)
+	graph.AddEdge(3, 4)
+	fmt.Println("Edges: 1-0, 0-2, 2-1, 0-3, 3-4")
+	fmt.Printf("Articulation points: %v\n", graph.ArticulationPoints())
+	fmt.Printf("Bridges: %v\n\n", graph.Bridges())
+
+	// Example 2: Network reliability
+	fmt.Println("=== EXAMPLE 2: Network Reliability ===")
+	routers := []string{"Core-1", "Core-2", "Core-3", "Edge-A", "Edge-B", "Branch", "Office"}
+	network := NewGraph(len(routers))
+	links := [][]int{
+		{0, 1}, {1, 2}, {2, 0}, // redundant core ring
+		{2, 3}, {3, 4}, {4, 2}, // edge routers dual-homed to Core-3
+		{4, 5}, // single uplink to the branch
+		{5, 6}, // office behind the branch router
+	}
+	fmt.Println("Links:")
+	for _, link := range links {
+		network.AddEdge(link[0], link[1])
+		fmt.Printf("  %s <-> %s\n", routers[link[0]], routers[link[1]])
+	}
+	fmt.Println()
+
+	cuts := network.FindCuts()
+	fmt.Println("Routers that are single points of failure:")
+	for _, v := range cuts.ArticulationPoints {
+		fmt.Printf("  %s\n", routers[v])
+	}
+	fmt.Println("Links that are single points of failure:")
+	for _, bridge := range cuts.Bridges {
+		fmt.Printf("  %s <-> %s\n", routers[bridge[0]], routers[bridge[1]])
+	}
+	fmt.Println()
+
+	// Adding a backup link removes the weak spots on that path
+	fmt.Println("After adding a backup link Office <-> Edge-A:")
+	network.AddEdge(6, 3)
+	cuts = network.FindCuts()
+	fmt.Printf("  Articulation points: %d, bridges: %d\n", len(cuts.ArticulationPoints), len(cuts.Bridges))
+	for _, v := range cuts.ArticulationPoints {
+		fmt.Printf("  Still critical: %s\n", routers[v])
+	}
+	fmt.Println()
+
+	// Example 3: Parallel links are not bridges
+	fmt.Println("=== EXAMPLE 3: Parallel Links ===")
+	parallel := NewGraph(3)
+	parallel.AddEdge(0, 1)
+	parallel.AddEdge(0, 1)
+	parallel.AddEdge(1, 2)
+	fmt.Println("Edges: 0-1 (twice), 1-2")
+	fmt.Printf("Bridges: %v\n", parallel.Bridges())
+	fmt.Printf("Articulation points: %v\n\n", parallel.ArticulationPoints())
+
+	fmt.Println("Time Complexity: O(V + E) - a single DFS computes both")
+	fmt.Println()
+}
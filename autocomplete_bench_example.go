@@ -0,0 +1,258 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// ================================
+// AUTOCOMPLETE BENCHMARK
+// ================================
+
+// prefixIndex is a structure that can answer "all words with this prefix"
+type prefixIndex struct {
+	name  string
+	build func(words []string) func(prefix string) []string
+}
+
+// prefixIndexes are the structures the benchmark compares. The sorted
+// slice is the baseline every tree has to beat: binary search to the first
+// match, then a scan.
+var prefixIndexes = []prefixIndex{
+	{"Trie", func(words []string) func(string) []string {
+		trie := NewTrie()
+		for _, word := range words {
+			trie.InsertSimple(word)
+		}
+		return trie.WordsWithPrefix
+	}},
+	{"RadixTree", func(words []string) func(string) []string {
+		tree := NewRadixTree()
+		for _, word := range words {
+			tree.Insert(word)
+		}
+		return tree.WordsWithPrefix
+	}},
+	{"TernarySearchTree", func(words []string) func(string) []string {
+		tree := NewTernarySearchTree()
+		for _, word := range words {
+			tree.Insert(word)
+		}
+		return tree.WordsWithPrefix
+	}},
+	{"TrieMap", func(words []string) func(string) []string {
+		trie := NewTrieMap[struct{}]()
+		for _, word := range words {
+			trie.Put(word, struct{}{})
+		}
+		return func(prefix string) []string {
+			return slices.AppendSeq([]string{}, trie.Keys(prefix))
+		}
+	}},
+	{"sorted slice", func(words []string) func(string) []string {
+		sorted := slices.Clone(words)
+		sort.Strings(sorted)
+		return func(prefix string) []string {
+			matches := []string{}
+			for i := sort.SearchStrings(sorted, prefix); i < len(sorted) && strings.HasPrefix(sorted[i], prefix); i++ {
+				matches = append(matches, sorted[i])
+			}
+			return matches
+		}
+	}},
+}
+
+// AutocompleteBenchResult is how one structure fared on the query stream
+type AutocompleteBenchResult struct {
+	Structure     string
+	Build         time.Duration
+	HeapBytes     uint64
+	Throughput    float64 // queries per second
+	P50, P95, P99 time.Duration
+	Mismatches    int // queries whose answer differs from the first structure's
+}
+
+// percentile returns the p-th percentile of sorted durations (nearest rank)
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(p/100*float64(len(sorted))+0.5) - 1
+	return sorted[min(max(rank, 0), len(sorted)-1)]
+}
+
+// RunAutocompleteBench builds every structure from words and replays the
+// queries against each, timing every query on its own so the latency
+// percentiles show the slow tail, not just the average. Answers are
+// compared with the first structure's.
+func RunAutocompleteBench(words, queries []string) []AutocompleteBenchResult {
+	results := []AutocompleteBenchResult{}
+	var expected [][]string
+	for _, index := range prefixIndexes {
+		before := heapInUse()
+		start := time.Now()
+		query := index.build(words)
+		result := AutocompleteBenchResult{Structure: index.name, Build: time.Since(start)}
+		if after := heapInUse(); after > before {
+			result.HeapBytes = after - before
+		}
+
+		latencies := make([]time.Duration, len(queries))
+		answers := make([][]string, len(queries))
+		total := time.Now()
+		for i, prefix := range queries {
+			start := time.Now()
+			answers[i] = query(prefix)
+			latencies[i] = time.Since(start)
+		}
+		result.Throughput = float64(len(queries)) / time.Since(total).Seconds()
+
+		slices.Sort(latencies)
+		result.P50, result.P95, result.P99 = percentile(latencies, 50), percentile(latencies, 95), percentile(latencies, 99)
+		if expected == nil {
+			expected = answers
+		}
+		for i := range answers {
+			if !slices.Equal(answers[i], expected[i]) {
+				result.Mismatches++
+			}
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// autocompleteQueries replays users typing: each query is the first 3 to 7
+// characters of a random corpus word, with the occasional prefix that
+// matches nothing
+func autocompleteQueries(words []string, n int, rng *rand.Rand) []string {
+	queries := make([]string, n)
+	for i := range queries {
+		word := []rune(words[rng.Intn(len(words))])
+		length := min(len(word), 3+rng.Intn(5))
+		queries[i] = string(word[:length])
+		if rng.Intn(20) == 0 {
+			queries[i] += "qx"
+		}
+	}
+	return queries
+}
+
+// readCorpus returns the distinct lowercase words in r
+func readCorpus(r io.Reader) ([]string, error) {
+	seen := map[string]bool{}
+	tokens := bufio.NewScanner(r)
+	tokens.Split(bufio.ScanWords)
+	for tokens.Scan() {
+		for _, word := range strings.FieldsFunc(strings.ToLower(tokens.Text()), func(r rune) bool {
+			return !unicode.IsLetter(r)
+		}) {
+			seen[word] = true
+		}
+	}
+	if err := tokens.Err(); err != nil {
+		return nil, err
+	}
+	return sortedKeys(seen), nil
+}
+
+// printAutocompleteBench prints the results as a table
+func printAutocompleteBench(out io.Writer, results []AutocompleteBenchResult) {
+	fmt.Fprintf(out, "%-18s %9s %9s %12s %9s %9s %9s %s\n",
+		"Structure", "Build", "Heap", "Queries/s", "p50", "p95", "p99", "Mismatches")
+	for _, r := range results {
+		fmt.Fprintf(out, "%-18s %9v %8.1fM %12.0f %9v %9v %9v %d\n",
+			r.Structure, r.Build.Round(time.Millisecond), float64(r.HeapBytes)/(1<<20), r.Throughput,
+			r.P50.Round(100*time.Nanosecond), r.P95.Round(100*time.Nanosecond), r.P99.Round(100*time.Nanosecond), r.Mismatches)
+	}
+}
+
+// runAutocompleteBenchCommand implements
+//
+//	dsa bench-autocomplete [--corpus FILE] [--words N] [--queries N] [--seed N]
+//
+// Without --corpus it uses the words of the bundled docs plus synthetic
+// words up to --words.
+func runAutocompleteBenchCommand(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("bench-autocomplete", flag.ContinueOnError)
+	corpusPath := flags.String("corpus", "", "text file to take words from (default: synthetic corpus)")
+	wordCount := flags.Int("words", 100_000, "size of the synthetic corpus")
+	queryCount := flags.Int("queries", 10_000, "number of prefix queries to replay")
+	seed := flags.Int64("seed", 1043, "random seed for the corpus and queries")
+	if err := flags.Parse(args); errors.Is(err, flag.ErrHelp) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if *wordCount < 1 || *queryCount < 1 {
+		return fmt.Errorf("--words and --queries must be positive")
+	}
+
+	rng := rand.New(rand.NewSource(*seed))
+	var words []string
+	if *corpusPath != "" {
+		file, err := os.Open(*corpusPath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		if words, err = readCorpus(file); err != nil {
+			return fmt.Errorf("%s: %w", *corpusPath, err)
+		}
+		if len(words) == 0 {
+			return fmt.Errorf("%s: no words", *corpusPath)
+		}
+	} else {
+		words = docWords()
+		seen := map[string]bool{}
+		for _, word := range words {
+			seen[word] = true
+		}
+		for _, word := range syntheticWords(max(*wordCount-len(words), 0), rng) {
+			if !seen[word] {
+				words = append(words, word)
+			}
+		}
+	}
+
+	queries := autocompleteQueries(words, *queryCount, rng)
+	fmt.Fprintf(stdout, "%d words, %d prefix queries\n", len(words), len(queries))
+	printAutocompleteBench(stdout, RunAutocompleteBench(words, queries))
+	return nil
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoAutocompleteBenchmark backs the prefix-search claims with latencies
+func DemoAutocompleteBenchmark() {
+	fmt.Println("=== AUTOCOMPLETE BENCHMARK ===")
+	fmt.Println()
+
+	fmt.Println("=== EXAMPLE 1: 100k-Word Corpus, 1000 Prefix Queries ===")
+	fmt.Println("$ dsa bench-autocomplete --words 100000 --queries 1000")
+	if err := runAutocompleteBenchCommand([]string{"--words", "100000", "--queries", "1000"}, os.Stdout); err != nil {
+		fmt.Printf("benchmark failed: %v\n", err)
+	}
+	fmt.Println()
+
+	fmt.Println("All five return the same sorted matches. Locating the prefix is cheap")
+	fmt.Println("everywhere; the time goes into collecting matches, and short prefixes")
+	fmt.Println("that match thousands of words make up the p95/p99 tail. The sorted slice")
+	fmt.Println("wins because its matches are already contiguous and in order, while the")
+	fmt.Println("trees walk a node per character and Trie and TrieMap sort every child")
+	fmt.Println("map on the way. The trees pay off when the dictionary changes: an insert")
+	fmt.Println("into a sorted slice shifts O(n) words.")
+	fmt.Println()
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+// TestBellmanFordMarksEveryNegativeCycle checks that vertices after a
+// second reachable negative cycle are -∞ too, not only those after the
+// cycle that gets reported
+func TestBellmanFordMarksEveryNegativeCycle(t *testing.T) {
+	g := NewWeightedGraph(8)
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(2, 1, -3) // cycle 1 <-> 2
+	g.AddEdge(2, 3, 1)
+	g.AddEdge(0, 4, 1)
+	g.AddEdge(4, 5, 1)
+	g.AddEdge(5, 4, -3) // cycle 4 <-> 5
+	g.AddEdge(5, 6, 1)
+	g.AddEdge(0, 7, 2) // untouched by either cycle
+
+	result := g.BellmanFord(0)
+	if !result.HasNegativeCycle() {
+		t.Fatal("HasNegativeCycle() = false, want true")
+	}
+	want := []float64{0, math.Inf(-1), math.Inf(-1), math.Inf(-1), math.Inf(-1), math.Inf(-1), math.Inf(-1), 2}
+	for v, distance := range result.distances {
+		if distance != want[v] {
+			t.Errorf("distance to %d = %v, want %v", v, distance, want[v])
+		}
+	}
+}
+
+func TestBellmanFordWithoutNegativeCycle(t *testing.T) {
+	g := NewWeightedGraph(4)
+	g.AddEdge(0, 1, 4)
+	g.AddEdge(0, 2, 1)
+	g.AddEdge(2, 1, -2)
+	g.AddEdge(1, 3, 1)
+
+	result := g.BellmanFord(0)
+	if result.HasNegativeCycle() {
+		t.Fatalf("HasNegativeCycle() = true, cycle %v", result.NegativeCycle())
+	}
+	want := []float64{0, -1, 1, 0}
+	for v, distance := range result.distances {
+		if distance != want[v] {
+			t.Errorf("distance to %d = %v, want %v", v, distance, want[v])
+		}
+	}
+}
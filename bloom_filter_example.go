@@ -0,0 +1,279 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// ================================
+// BLOOM FILTER
+// ================================
+
+// BloomFilter answers "definitely not present" or "possibly present" for
+// strings in a fixed-size bit array. Each item sets k bits; an item is
+// possibly present when all of its k bits are set, which can also happen
+// by accident (a false positive). There are never false negatives.
+type BloomFilter struct {
+	bits   []uint64
+	m      uint64 // number of bits
+	k      int    // number of hash functions
+	items  int    // items added
+	target float64
+}
+
+// NewBloomFilter sizes a filter for expectedItems at the given false
+// positive rate: m = -n ln p / (ln 2)^2 bits and k = (m/n) ln 2 hashes
+func NewBloomFilter(expectedItems int, falsePositiveRate float64) (*BloomFilter, error) {
+	if expectedItems < 1 {
+		return nil, fmt.Errorf("expected items %d must be at least 1", expectedItems)
+	}
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		return nil, fmt.Errorf("false positive rate %v must be between 0 and 1", falsePositiveRate)
+	}
+	n := float64(expectedItems)
+	m := uint64(math.Ceil(-n * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)))
+	m = (m + 63) / 64 * 64
+	k := max(1, int(math.Round(float64(m)/n*math.Ln2)))
+	return &BloomFilter{bits: make([]uint64, m/64), m: m, k: k, target: falsePositiveRate}, nil
+}
+
+// bloomHashes returns two 64-bit hashes of item (FNV-1a with two
+// different offset bases) in one pass. Probe i uses h1 + i*h2, which keeps
+// the false positive rate of k independent hashes (Kirsch and Mitzenmacher).
+func bloomHashes(item string) (h1, h2 uint64) {
+	const prime = 1099511628211
+	h1, h2 = 14695981039346656037, 9650029242287828579
+	for i := 0; i < len(item); i++ {
+		h1 = (h1 ^ uint64(item[i])) * prime
+		h2 = (h2 ^ uint64(item[i])) * prime
+	}
+	return h1, h2 | 1 // odd, so the k probes never repeat one bit
+}
+
+// Add inserts item
+// Time Complexity: O(len(item) + k)
+func (bf *BloomFilter) Add(item string) {
+	h1, h2 := bloomHashes(item)
+	for i := 0; i < bf.k; i++ {
+		bit := (h1 + uint64(i)*h2) % bf.m
+		bf.bits[bit/64] |= 1 << (bit % 64)
+	}
+	bf.items++
+}
+
+// MayContain reports whether item is possibly in the filter; false means
+// it was definitely never added
+// Time Complexity: O(len(item) + k), stopping at the first clear bit
+func (bf *BloomFilter) MayContain(item string) bool {
+	h1, h2 := bloomHashes(item)
+	for i := 0; i < bf.k; i++ {
+		bit := (h1 + uint64(i)*h2) % bf.m
+		if bf.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// EstimatedFalsePositiveRate returns (1 - e^(-kn/m))^k for the items
+// added so far; it exceeds the target once more items than expected are in
+func (bf *BloomFilter) EstimatedFalsePositiveRate() float64 {
+	return math.Pow(1-math.Exp(-float64(bf.k)*float64(bf.items)/float64(bf.m)), float64(bf.k))
+}
+
+// String describes the filter's size and configuration
+func (bf *BloomFilter) String() string {
+	return fmt.Sprintf("%d bits (%.1f KB), %d hashes, %d items, target FP rate %.3g",
+		bf.m, float64(bf.m)/8/1024, bf.k, bf.items, bf.target)
+}
+
+// ================================
+// SPELL CHECK BENCHMARK
+// ================================
+
+// SpellCheckBenchResult is how one spell checker configuration fared on
+// the query stream
+type SpellCheckBenchResult struct {
+	Config     string
+	Throughput float64 // lookups per second
+	Stats      SpellFilterStats
+	Mismatches int // answers that differ from the plain trie's
+}
+
+// RunSpellCheckBench checks the same queries against a trie-only spell
+// checker and one with a Bloom filter in front, repeating the stream until
+// each has run for at least minDuration
+func RunSpellCheckBench(dictionary, queries []string, falsePositiveRate float64, minDuration time.Duration) ([]SpellCheckBenchResult, error) {
+	plain := NewSpellChecker()
+	filtered := NewSpellChecker()
+	for _, word := range dictionary {
+		plain.AddToDictionary(word)
+		filtered.AddToDictionary(word)
+	}
+	if err := filtered.EnableBloomFilter(len(dictionary), falsePositiveRate); err != nil {
+		return nil, err
+	}
+
+	expected := make([]bool, len(queries))
+	for i, query := range queries {
+		expected[i] = plain.CheckSpelling(query)
+	}
+
+	results := []SpellCheckBenchResult{}
+	for _, config := range []struct {
+		name    string
+		checker *SpellChecker
+	}{{"Trie only", plain}, {"Bloom + Trie", filtered}} {
+		result := SpellCheckBenchResult{Config: config.name}
+		for i, query := range queries {
+			if config.checker.CheckSpelling(query) != expected[i] {
+				result.Mismatches++
+			}
+		}
+		result.Stats = config.checker.FilterStats() // one pass over the stream
+
+		lookups := 0
+		start := time.Now()
+		for lookups == 0 || time.Since(start) < minDuration {
+			for _, query := range queries {
+				config.checker.CheckSpelling(query)
+			}
+			lookups += len(queries)
+		}
+		result.Throughput = float64(lookups) / time.Since(start).Seconds()
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// misspell replaces one letter of word with a random different letter
+func misspell(word string, rng *rand.Rand) string {
+	letters := []byte(word)
+	i := rng.Intn(len(letters))
+	for {
+		if letter := byte('a' + rng.Intn(26)); letter != letters[i] {
+			letters[i] = letter
+			return string(letters)
+		}
+	}
+}
+
+// spellCheckQueries draws n queries from dictionary, a fraction
+// missRate of them misspelled (and not accidentally another word)
+func spellCheckQueries(dictionary []string, n int, missRate float64, rng *rand.Rand) []string {
+	known := make(map[string]bool, len(dictionary))
+	for _, word := range dictionary {
+		known[word] = true
+	}
+	queries := make([]string, n)
+	for i := range queries {
+		word := dictionary[rng.Intn(len(dictionary))]
+		if rng.Float64() < missRate {
+			for known[word] {
+				word = misspell(word, rng)
+			}
+		}
+		queries[i] = word
+	}
+	return queries
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoBloomFilter shows the filter's false positive rate against its
+// estimate and benchmarks it in front of the spell checker's trie
+func DemoBloomFilter() {
+	fmt.Println("=== BLOOM FILTER IN FRONT OF A TRIE ===")
+	fmt.Println()
+	rng := rand.New(rand.NewSource(1052))
+	dictionary := append(docWords(), syntheticWords(300_000, rng)...)
+
+	// Example 1: Measured false positives match the formula
+	fmt.Println("=== EXAMPLE 1: False Positive Rate, Target vs Measured ===")
+	known := make(map[string]bool, len(dictionary))
+	for _, word := range dictionary {
+		known[word] = true
+	}
+	absent := []string{}
+	for len(absent) < 200_000 {
+		if word := misspell(dictionary[rng.Intn(len(dictionary))], rng); !known[word] {
+			absent = append(absent, word)
+		}
+	}
+	fmt.Printf("%-8s %-42s %10s %10s\n", "Target", "Filter", "Estimated", "Measured")
+	for _, target := range []float64{0.1, 0.01, 0.001} {
+		filter, _ := NewBloomFilter(len(dictionary), target)
+		for _, word := range dictionary {
+			filter.Add(word)
+		}
+		falsePositives := 0
+		for _, word := range absent {
+			if filter.MayContain(word) {
+				falsePositives++
+			}
+		}
+		fmt.Printf("%-8g %-42s %9.3f%% %9.3f%%\n", target,
+			fmt.Sprintf("%d bits (%.0f KB), %d hashes", filter.m, float64(filter.m)/8/1024, filter.k),
+			100*filter.EstimatedFalsePositiveRate(), 100*float64(falsePositives)/float64(len(absent)))
+	}
+	overfull, _ := NewBloomFilter(len(dictionary)/4, 0.01)
+	for _, word := range dictionary {
+		overfull.Add(word)
+	}
+	fmt.Printf("Sized for a quarter of the words, a 1%% filter degrades to an estimated %.1f%%\n",
+		100*overfull.EstimatedFalsePositiveRate())
+	fmt.Println()
+
+	// Example 2: Spell checking with and without the filter
+	fmt.Println("=== EXAMPLE 2: Spell Check Throughput ===")
+	fmt.Printf("Dictionary: %d words\n", len(dictionary))
+	fmt.Printf("%-10s %-13s %14s %10s %12s %10s %10s\n", "Misspelled", "Config", "Lookups/s", "Filtered", "Trie lookups", "False +", "Mismatches")
+	for _, missRate := range []float64{0.1, 0.5, 0.9} {
+		queries := spellCheckQueries(dictionary, 100_000, missRate, rng)
+		results, err := RunSpellCheckBench(dictionary, queries, 0.01, 200*time.Millisecond)
+		if err != nil {
+			fmt.Println("Error:", err)
+			return
+		}
+		for _, result := range results {
+			trieLookups := result.Stats.Passed
+			if result.Config == "Trie only" {
+				trieLookups = len(queries)
+			}
+			fmt.Printf("%-10s %-13s %14.0f %10d %12d %10d %10d\n", fmt.Sprintf("%.0f%%", 100*missRate), result.Config,
+				result.Throughput, result.Stats.Rejected, trieLookups, result.Stats.FalsePositives, result.Mismatches)
+		}
+	}
+	fmt.Println()
+
+	// Example 3: The false positive fallback
+	fmt.Println("=== EXAMPLE 3: Fallback on a False Positive ===")
+	checker := NewSpellChecker()
+	for _, word := range []string{"apple", "banana", "cherry"} {
+		checker.AddToDictionary(word)
+	}
+	checker.EnableBloomFilter(3, 0.2) // deliberately small so it collides
+	fmt.Printf("Filter: %v\n", checker.filter)
+	shown := 0
+	for _, word := range syntheticWords(1_000, rng) {
+		passed := checker.filter.MayContain(word)
+		if correct := checker.CheckSpelling(word); passed && !correct && shown < 3 {
+			fmt.Printf("%-12q passes the filter, the trie says spelled correctly: %v\n", word, correct)
+			shown++
+		}
+	}
+	fmt.Printf("apple: spelled correctly: %v\n", checker.CheckSpelling("apple"))
+	fmt.Printf("%+v\n", checker.FilterStats())
+	fmt.Println()
+
+	fmt.Println("The filter only answers \"no\" with certainty, so it pays off on the")
+	fmt.Println("misspelled words: they are rejected after one hash pass and a few bit")
+	fmt.Println("probes instead of a walk through scattered trie nodes. Words it lets")
+	fmt.Println("through, correct or falsely positive, still go to the trie, so answers")
+	fmt.Println("never change; a wrongly sized filter only costs speed.")
+	fmt.Println()
+}
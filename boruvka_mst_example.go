@@ -0,0 +1,221 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// ================================
+// BORŮVKA'S MINIMUM SPANNING TREE
+// ================================
+
+// BoruvkaMST finds a Minimum Spanning Tree (forest, if disconnected) using
+// Borůvka's algorithm. Each round every component picks its cheapest outgoing
+// edge and all of them are added at once, so the number of components at
+// least halves per round.
+// Time Complexity: O(E log V)
+// Space Complexity: O(V)
+func BoruvkaMST(n int, edges []Edge) ([]Edge, int) {
+	uf := NewUnionFind(n)
+	mst := []Edge{}
+	totalWeight := 0
+
+	// cheaper breaks weight ties by edge index so no cycle can form
+	cheaper := func(a, b int) bool {
+		if edges[a].Weight != edges[b].Weight {
+			return edges[a].Weight < edges[b].Weight
+		}
+		return a < b
+	}
+
+	for {
+		cheapest := make([]int, n) // cheapest[root] = index of best outgoing edge
+		for i := range cheapest {
+			cheapest[i] = -1
+		}
+
+		for i, edge := range edges {
+			rootFrom, rootTo := uf.Find(edge.From), uf.Find(edge.To)
+			if rootFrom == rootTo {
+				continue
+			}
+			if cheapest[rootFrom] == -1 || cheaper(i, cheapest[rootFrom]) {
+				cheapest[rootFrom] = i
+			}
+			if cheapest[rootTo] == -1 || cheaper(i, cheapest[rootTo]) {
+				cheapest[rootTo] = i
+			}
+		}
+
+		merged := false
+		for _, i := range cheapest {
+			if i == -1 {
+				continue
+			}
+			// Two components may pick the same edge; Union rejects the duplicate
+			if uf.Union(edges[i].From, edges[i].To) {
+				mst = append(mst, edges[i])
+				totalWeight += edges[i].Weight
+				merged = true
+			}
+		}
+
+		if !merged {
+			break
+		}
+	}
+
+	return mst, totalWeight
+}
+
+// ================================
+// PRIM'S MINIMUM SPANNING TREE
+// ================================
+
+// PrimMST finds a Minimum Spanning Tree using Prim's algorithm with the
+// Dijkstra priority queue. Only the component containing vertex 0 is spanned.
+// Time Complexity: O(E log V)
+// Space Complexity: O(V + E)
+func PrimMST(n int, edges []Edge) ([]Edge, int) {
+	if n == 0 {
+		return []Edge{}, 0
+	}
+
+	adjacency := make([][]Edge, n)
+	for _, edge := range edges {
+		adjacency[edge.From] = append(adjacency[edge.From], edge)
+		adjacency[edge.To] = append(adjacency[edge.To], Edge{edge.To, edge.From, edge.Weight})
+	}
+
+	key := make([]float64, n)   // cheapest known edge into the tree
+	bestEdge := make([]Edge, n) // edge achieving key[v]
+	inTree := make([]bool, n)
+	for i := range key {
+		key[i] = math.Inf(1)
+	}
+	key[0] = 0
+
+	pq := NewIndexedMinHeap[float64](n)
+	pq.Push(0, 0)
+
+	mst := []Edge{}
+	totalWeight := 0
+
+	for pq.Len() > 0 {
+		u, _, _ := pq.Pop()
+		inTree[u] = true
+		if u != 0 {
+			mst = append(mst, bestEdge[u])
+			totalWeight += bestEdge[u].Weight
+		}
+
+		for _, edge := range adjacency[u] {
+			v := edge.To
+			if !inTree[v] && float64(edge.Weight) < key[v] {
+				key[v] = float64(edge.Weight)
+				bestEdge[v] = edge
+				pq.PushOrDecrease(v, key[v])
+			}
+		}
+	}
+
+	return mst, totalWeight
+}
+
+// ================================
+// RANDOM GRAPHS AND BENCHMARK
+// ================================
+
+// randomConnectedEdges builds a connected graph with n vertices and about m
+// edges: a random spanning tree plus random extra edges
+func randomConnectedEdges(n, m int, rng *rand.Rand) []Edge {
+	edges := make([]Edge, 0, m)
+	for v := 1; v < n; v++ {
+		edges = append(edges, Edge{rng.Intn(v), v, 1 + rng.Intn(1000)})
+	}
+	for len(edges) < m {
+		u, v := rng.Intn(n), rng.Intn(n)
+		if u != v {
+			edges = append(edges, Edge{u, v, 1 + rng.Intn(1000)})
+		}
+	}
+	return edges
+}
+
+// timeMST runs an MST function on a copy of edges and reports weight and duration
+func timeMST(mstFunc func(int, []Edge) ([]Edge, int), n int, edges []Edge) (int, time.Duration) {
+	edgesCopy := make([]Edge, len(edges))
+	copy(edgesCopy, edges) // KruskalMST sorts its input in place
+
+	start := time.Now()
+	_, weight := mstFunc(n, edgesCopy)
+	return weight, time.Since(start)
+}
+
+// DemoBoruvka demonstrates Borůvka's algorithm and compares it with Kruskal and Prim
+func DemoBoruvka() {
+	fmt.Println("=== BORŮVKA'S MINIMUM SPANNING TREE ===")
+	fmt.Println()
+
+	fmt.Println("Each round, every component selects its cheapest outgoing edge;")
+	fmt.Println("all selected edges are added at once, halving the component count.")
+	fmt.Println()
+
+	edges := []Edge{
+		{0, 1, 4}, {0, 7, 8}, {1, 2, 8}, {1, 7, 11},
+		{2, 3, 7}, {2, 8, 2}, {2, 5, 4}, {3, 4, 9},
+		{3, 5, 14}, {4, 5, 10}, {5, 6, 2}, {6, 7, 1},
+		{6, 8, 6}, {7, 8, 7},
+	}
+
+	mst, totalWeight := BoruvkaMST(9, edges)
+	fmt.Printf("Borůvka MST (weight = %d):\n", totalWeight)
+	for _, edge := range mst {
+		fmt.Printf("(%d, %d, %d) ", edge.From, edge.To, edge.Weight)
+	}
+	fmt.Println()
+
+	_, primWeight := PrimMST(9, edges)
+	kruskalWeight, _ := timeMST(KruskalMST, 9, edges)
+	fmt.Printf("Prim weight: %d, Kruskal weight: %d\n\n", primWeight, kruskalWeight)
+
+	// Benchmark on random graphs
+	fmt.Println("=== BENCHMARK: DENSE VS SPARSE RANDOM GRAPHS ===")
+	rng := rand.New(rand.NewSource(42))
+
+	cases := []struct {
+		name string
+		n, m int
+	}{
+		{"Sparse (V=5000, E=3V)", 5000, 15000},
+		{"Dense  (V=400, E≈V²/4)", 400, 40000},
+	}
+
+	algorithms := []struct {
+		name string
+		run  func(int, []Edge) ([]Edge, int)
+	}{
+		{"Kruskal", KruskalMST},
+		{"Prim   ", PrimMST},
+		{"Borůvka", BoruvkaMST},
+	}
+
+	for _, c := range cases {
+		graphEdges := randomConnectedEdges(c.n, c.m, rng)
+		fmt.Printf("%s:\n", c.name)
+		for _, algorithm := range algorithms {
+			weight, elapsed := timeMST(algorithm.run, c.n, graphEdges)
+			fmt.Printf("  %s weight=%d time=%v\n", algorithm.name, weight, elapsed)
+		}
+		fmt.Println()
+	}
+
+	fmt.Println("Observations:")
+	fmt.Println("- All three produce the same total weight")
+	fmt.Println("- Borůvka scans every edge once per round (≤ log V rounds)")
+	fmt.Println("- Its rounds are independent per component, which makes it")
+	fmt.Println("  the natural choice for parallel and distributed MST")
+	fmt.Println()
+}
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"fmt"
+)
+
+// ================================
+// BINARY SEARCH TREE
+// ================================
+
+// BSTNode represents a node in a binary search tree
+type BSTNode struct {
+	Val   int
+	Left  *BSTNode
+	Right *BSTNode
+	size  int // nodes in the subtree rooted here, for KthSmallest
+}
+
+// bstSize returns the size of a possibly nil subtree
+func bstSize(node *BSTNode) int {
+	if node == nil {
+		return 0
+	}
+	return node.size
+}
+
+// BST is an unbalanced binary search tree of distinct integers:
+// every value in a node's left subtree is smaller, every value in its right
+// subtree is larger
+type BST struct {
+	root *BSTNode
+	size int
+}
+
+// NewBST creates an empty binary search tree
+func NewBST() *BST {
+	return &BST{}
+}
+
+// Insert adds val to the tree. Returns false if it was already present.
+// Time Complexity: O(h) where h = height (O(log n) balanced, O(n) worst)
+func (t *BST) Insert(val int) bool {
+	if t.Contains(val) {
+		return false
+	}
+	link := &t.root
+	for *link != nil {
+		(*link).size++
+		if val < (*link).Val {
+			link = &(*link).Left
+		} else {
+			link = &(*link).Right
+		}
+	}
+	*link = &BSTNode{Val: val, size: 1}
+	t.size++
+	return true
+}
+
+// Contains reports whether val is in the tree
+// Time Complexity: O(h)
+func (t *BST) Contains(val int) bool {
+	node := t.root
+	for node != nil {
+		switch {
+		case val < node.Val:
+			node = node.Left
+		case val > node.Val:
+			node = node.Right
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// Delete removes val from the tree. Returns false if it was not present.
+// A node with two children is replaced by its inorder successor.
+// Time Complexity: O(h)
+func (t *BST) Delete(val int) bool {
+	if !t.Contains(val) {
+		return false
+	}
+	link := &t.root
+	for (*link).Val != val {
+		(*link).size--
+		if val < (*link).Val {
+			link = &(*link).Left
+		} else {
+			link = &(*link).Right
+		}
+	}
+
+	node := *link
+	switch {
+	case node.Left == nil:
+		*link = node.Right
+	case node.Right == nil:
+		*link = node.Left
+	default:
+		// Unlink the smallest node of the right subtree and put it here
+		successorLink := &node.Right
+		for (*successorLink).Left != nil {
+			(*successorLink).size--
+			successorLink = &(*successorLink).Left
+		}
+		successor := *successorLink
+		*successorLink = successor.Right
+		successor.Left, successor.Right = node.Left, node.Right
+		successor.size = node.size - 1
+		*link = successor
+	}
+	t.size--
+	return true
+}
+
+// InOrder returns all values in ascending order
+func (t *BST) InOrder() []int {
+	values := []int{}
+	var walk func(node *BSTNode)
+	walk = func(node *BSTNode) {
+		if node == nil {
+			return
+		}
+		walk(node.Left)
+		values = append(values, node.Val)
+		walk(node.Right)
+	}
+	walk(t.root)
+	return values
+}
+
+// Height returns the number of nodes on the longest root-to-leaf path
+func (t *BST) Height() int {
+	var height func(node *BSTNode) int
+	height = func(node *BSTNode) int {
+		if node == nil {
+			return 0
+		}
+		return 1 + max(height(node.Left), height(node.Right))
+	}
+	return height(t.root)
+}
+
+// Size returns the number of values in the tree
+func (t *BST) Size() int {
+	return t.size
+}
+
+// KthSmallest returns the k-th smallest value (1-based). Every node knows
+// its subtree size, so each step either stops or skips a whole subtree.
+// Time Complexity: O(h)
+func (t *BST) KthSmallest(k int) (int, error) {
+	if k < 1 || k > t.size {
+		return 0, fmt.Errorf("k = %d out of range [1, %d]", k, t.size)
+	}
+	node := t.root
+	for {
+		smaller := bstSize(node.Left)
+		switch {
+		case k <= smaller:
+			node = node.Left
+		case k == smaller+1:
+			return node.Val, nil
+		default:
+			k -= smaller + 1
+			node = node.Right
+		}
+	}
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoBST demonstrates insertion, search and the three deletion cases
+func DemoBST() {
+	fmt.Println("=== BINARY SEARCH TREE ===")
+	fmt.Println()
+
+	tree := NewBST()
+	for _, val := range []int{50, 30, 70, 20, 40, 60, 80, 65} {
+		tree.Insert(val)
+	}
+	fmt.Printf("Inorder: %v (size %d, height %d)\n", tree.InOrder(), tree.Size(), tree.Height())
+	fmt.Printf("Insert duplicate 40: %v\n", tree.Insert(40))
+	fmt.Printf("Contains 65: %v, contains 66: %v\n\n", tree.Contains(65), tree.Contains(66))
+
+	fmt.Println("Deleting:")
+	for _, val := range []int{20, 60, 50, 99} {
+		deleted := tree.Delete(val)
+		fmt.Printf("  %d (deleted: %v) -> %v\n", val, deleted, tree.InOrder())
+	}
+	fmt.Println("  20 is a leaf, 60 has one child, 50 (the root) has two children")
+	fmt.Println()
+
+	sorted := NewBST()
+	for val := 1; val <= 10; val++ {
+		sorted.Insert(val)
+	}
+	fmt.Printf("Inserting 1..10 in order gives height %d: without balancing,\n", sorted.Height())
+	fmt.Println("sorted input degrades the tree into a linked list.")
+	fmt.Println()
+}
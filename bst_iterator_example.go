@@ -0,0 +1,201 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// ================================
+// BST ITERATOR (STACK)
+// ================================
+
+// BSTIterator yields the values of a BST in ascending order, one at a
+// time. The stack holds the path of nodes whose value has not been
+// returned yet: the left spine below the next node, so at most h nodes.
+//
+//	it := tree.Iterator()
+//	for it.HasNext() {
+//		val, _ := it.Next()
+//		fmt.Println(val)
+//	}
+type BSTIterator struct {
+	stack []*BSTNode
+}
+
+// Iterator returns a stack-based iterator over the tree. The tree must not
+// be modified while the iterator is in use.
+func (t *BST) Iterator() *BSTIterator {
+	it := &BSTIterator{}
+	it.pushLeft(t.root)
+	return it
+}
+
+// pushLeft pushes node and its chain of left children
+func (it *BSTIterator) pushLeft(node *BSTNode) {
+	for ; node != nil; node = node.Left {
+		it.stack = append(it.stack, node)
+	}
+}
+
+// HasNext reports whether Next has a value to return
+func (it *BSTIterator) HasNext() bool {
+	return len(it.stack) > 0
+}
+
+// Next returns the next value in ascending order, or false once the tree
+// is exhausted
+// Time Complexity: O(1) amortized, O(h) worst case
+// Space Complexity: O(h) for the stack
+func (it *BSTIterator) Next() (int, bool) {
+	if len(it.stack) == 0 {
+		return 0, false
+	}
+	node := it.stack[len(it.stack)-1]
+	it.stack = it.stack[:len(it.stack)-1]
+	it.pushLeft(node.Right)
+	return node.Val, true
+}
+
+// ================================
+// BST ITERATOR (MORRIS)
+// ================================
+
+// MorrisBSTIterator yields the same values as BSTIterator in O(1) extra
+// space. Instead of a stack it threads the tree: the rightmost node of a
+// left subtree temporarily points back to the node to return after it.
+// Each thread is removed on the second visit, so a walk run to the end
+// leaves the tree as it found it. Until then the tree is not a valid BST
+// and must not be read or modified; call Close to stop early.
+type MorrisBSTIterator struct {
+	cursor morrisCursor[*BSTNode]
+}
+
+// MorrisIterator returns a Morris iterator over the tree
+func (t *BST) MorrisIterator() *MorrisBSTIterator {
+	return &MorrisBSTIterator{cursor: morrisCursor[*BSTNode]{current: t.root, links: bstMorrisLinks}}
+}
+
+// HasNext reports whether Next has a value to return: a node still to be
+// walked always has at least itself left to visit
+func (it *MorrisBSTIterator) HasNext() bool {
+	return it.cursor.current != nil
+}
+
+// Next returns the next value in ascending order, or false once the tree
+// is exhausted
+// Time Complexity: O(1) amortized (each edge is walked at most 3 times)
+// Space Complexity: O(1)
+func (it *MorrisBSTIterator) Next() (int, bool) {
+	node, ok := it.cursor.next()
+	if !ok {
+		return 0, false
+	}
+	return node.Val, true
+}
+
+// Close finishes the walk so that every thread is removed. It is needed
+// only when iteration stops before HasNext turns false.
+// Time Complexity: O(n) in the worst case
+func (it *MorrisBSTIterator) Close() {
+	it.cursor.finish()
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoBSTIterator contrasts the stack iterator with the Morris iterator:
+// same order, O(h) against O(1) memory, and what the threads cost
+func DemoBSTIterator() {
+	fmt.Println("=== BST ITERATORS: STACK VS MORRIS ===")
+	fmt.Println()
+
+	// Example 1: Same values, one at a time
+	fmt.Println("=== EXAMPLE 1: Stepping Through a Tree ===")
+	tree := NewBST()
+	for _, val := range []int{50, 30, 70, 20, 40, 60, 80, 65} {
+		tree.Insert(val)
+	}
+	fmt.Printf("Inorder: %v\n", tree.InOrder())
+	fmt.Printf("%-7s %-25s %s\n", "", "Values", "Stack depth / threads after each Next")
+	stackIt := tree.Iterator()
+	values, depths := []int{}, []int{}
+	for stackIt.HasNext() {
+		val, _ := stackIt.Next()
+		values, depths = append(values, val), append(depths, len(stackIt.stack))
+	}
+	fmt.Printf("%-7s %-25s %v\n", "Stack", fmt.Sprint(values), depths)
+	// A separate walk: while Morris threads are in place, another reader of
+	// the tree would follow them
+	morrisIt := tree.MorrisIterator()
+	values, depths = []int{}, []int{}
+	for morrisIt.HasNext() {
+		val, _ := morrisIt.Next()
+		values, depths = append(values, val), append(depths, morrisIt.cursor.threads)
+	}
+	fmt.Printf("%-7s %-25s %v\n", "Morris", fmt.Sprint(values), depths)
+	fmt.Printf("Inorder after the Morris walk: %v\n", tree.InOrder())
+	fmt.Println()
+
+	// Example 2: Stopping early
+	fmt.Println("=== EXAMPLE 2: Stopping Early ===")
+	morrisIt = tree.MorrisIterator()
+	first := []int{}
+	for len(first) < 3 && morrisIt.HasNext() {
+		val, _ := morrisIt.Next()
+		first = append(first, val)
+	}
+	fmt.Printf("First three: %v, threads left in the tree: %d\n", first, morrisIt.cursor.threads)
+	morrisIt.Close()
+	fmt.Printf("After Close: threads %d, inorder %v, sizes valid: %v\n",
+		morrisIt.cursor.threads, tree.InOrder(), bstSizesValid(tree.root))
+	fmt.Println("The stack iterator can simply be dropped; the Morris iterator has")
+	fmt.Println("rewired the tree and must finish the walk to put it back.")
+	fmt.Println()
+
+	// Example 3: Memory follows the height
+	fmt.Println("=== EXAMPLE 3: Memory and Speed ===")
+	const n = 200_000
+	random, descending := NewBST(), NewBST()
+	for _, val := range rand.New(rand.NewSource(1057)).Perm(n) {
+		random.Insert(val)
+	}
+	for val := 2_000; val > 0; val-- {
+		descending.Insert(val)
+	}
+	fmt.Printf("%-22s %7s %7s %16s %12s %12s\n", "Tree", "Nodes", "Height", "Max stack depth", "Stack", "Morris")
+	for _, test := range []struct {
+		name string
+		tree *BST
+	}{{"random inserts", random}, {"descending inserts", descending}} {
+		maxDepth, sum := 0, 0
+		start := time.Now()
+		for it := test.tree.Iterator(); it.HasNext(); {
+			maxDepth = max(maxDepth, len(it.stack))
+			val, _ := it.Next()
+			sum += val
+		}
+		stackTime := time.Since(start)
+		start = time.Now()
+		for it := test.tree.MorrisIterator(); it.HasNext(); {
+			val, _ := it.Next()
+			sum -= val
+		}
+		morrisTime := time.Since(start)
+		fmt.Printf("%-22s %7d %7d %16d %12v %12v\n", test.name, test.tree.Size(), test.tree.Height(),
+			maxDepth, stackTime.Round(time.Microsecond), morrisTime.Round(time.Microsecond))
+		if sum != 0 {
+			fmt.Println("  the two iterators disagree!")
+		}
+	}
+	fmt.Println()
+
+	fmt.Println("Both iterators spread an inorder traversal over Next calls. The stack")
+	fmt.Println("version keeps the pending left spine, O(h) memory, and leaves the tree")
+	fmt.Println("alone. The Morris version keeps one pointer and borrows nil right")
+	fmt.Println("pointers as threads instead, walking some edges twice more: O(1)")
+	fmt.Println("memory, paid for with extra pointer chasing and a tree that is off")
+	fmt.Println("limits until the walk ends. Use it when the stack really cannot fit.")
+	fmt.Println()
+}
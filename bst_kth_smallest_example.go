@@ -0,0 +1,195 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// ================================
+// K-TH SMALLEST: SIZES VS MORRIS SCAN
+// ================================
+
+// bstKthMorris finds the k-th smallest value of a BST with a Morris inorder
+// walk: O(1) extra space and no augmentation, but O(n) per query. After the
+// k-th node the walk carries on only until every thread it created has been
+// removed.
+// Time Complexity: O(n)
+func bstKthMorris(root *BSTNode, k int) (int, bool) {
+	cursor := morrisCursor[*BSTNode]{current: root, links: bstMorrisLinks}
+	defer cursor.finish()
+	for count := 1; ; count++ {
+		node, ok := cursor.next()
+		if !ok {
+			return 0, false
+		}
+		if count == k {
+			return node.Val, true
+		}
+	}
+}
+
+// bstMorrisLinks gives a morrisCursor the inorder children of a BSTNode
+func bstMorrisLinks(node *BSTNode) (near, far **BSTNode) {
+	return &node.Left, &node.Right
+}
+
+// bstSizesValid checks that every node's size is 1 + its children's sizes
+func bstSizesValid(node *BSTNode) bool {
+	if node == nil {
+		return true
+	}
+	return node.size == 1+bstSize(node.Left)+bstSize(node.Right) &&
+		bstSizesValid(node.Left) && bstSizesValid(node.Right)
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoBSTKthSmallest answers k-th smallest queries on a changing BST with
+// subtree sizes, a Morris scan and the AVL OrderStatisticTree
+func DemoBSTKthSmallest() {
+	fmt.Println("=== K-TH SMALLEST IN A SIZE-AUGMENTED BST ===")
+	fmt.Println()
+
+	// Example 1: Sizes steer the descent
+	fmt.Println("=== EXAMPLE 1: Subtree Sizes ===")
+	tree := NewBST()
+	for _, val := range []int{50, 30, 70, 20, 40, 60, 80, 65} {
+		tree.Insert(val)
+	}
+	fmt.Printf("Inorder: %v\n", tree.InOrder())
+	fmt.Printf("Root %d has size %d: %d on the left, %d on the right\n",
+		tree.root.Val, tree.root.size, bstSize(tree.root.Left), bstSize(tree.root.Right))
+	for _, k := range []int{1, 4, 6, 8, 9} {
+		if val, err := tree.KthSmallest(k); err != nil {
+			fmt.Printf("k=%d: error: %v\n", k, err)
+		} else {
+			fmt.Printf("k=%d: %d\n", k, val)
+		}
+	}
+	tree.Delete(50) // two children: the successor 60 moves up
+	tree.Delete(20)
+	fmt.Printf("After deleting 50 and 20: %v, sizes valid: %v, 4th smallest: ", tree.InOrder(), bstSizesValid(tree.root))
+	fourth, _ := tree.KthSmallest(4)
+	fmt.Println(fourth)
+	fmt.Println()
+
+	// Example 2: Many queries against a tree that keeps changing
+	fmt.Println("=== EXAMPLE 2: Queries Interleaved with Updates ===")
+	rng := rand.New(rand.NewSource(1053))
+	const keySpace, rounds, queriesPerRound = 1_000_000, 300, 10
+	initial := rand.New(rand.NewSource(1053)).Perm(keySpace)[:50_000]
+	type operation struct {
+		insert, remove int
+		ks             []int
+	}
+	operations := make([]operation, rounds)
+	for i := range operations {
+		operations[i] = operation{insert: rng.Intn(keySpace), remove: rng.Intn(keySpace)}
+		for q := 0; q < queriesPerRound; q++ {
+			operations[i].ks = append(operations[i].ks, 1+rng.Intn(len(initial)/2))
+		}
+	}
+	fmt.Printf("%d keys, %d rounds of 1 insert + 1 delete + %d queries\n", len(initial), rounds, queriesPerRound)
+
+	// Each method replays the same updates on its own tree and only the
+	// queries are timed
+	methods := []struct {
+		name  string
+		build func() (update func(op operation), query func(k int) int)
+	}{
+		{"BST.KthSmallest (sizes)", func() (func(operation), func(int) int) {
+			bst := NewBST()
+			for _, key := range initial {
+				bst.Insert(key)
+			}
+			return func(op operation) { bst.Insert(op.insert); bst.Delete(op.remove) },
+				func(k int) int { val, _ := bst.KthSmallest(k); return val }
+		}},
+		{"Morris inorder scan", func() (func(operation), func(int) int) {
+			bst := NewBST()
+			for _, key := range initial {
+				bst.Insert(key)
+			}
+			return func(op operation) { bst.Insert(op.insert); bst.Delete(op.remove) },
+				func(k int) int { val, _ := bstKthMorris(bst.root, k); return val }
+		}},
+		{"AVL OrderStatisticTree", func() (func(operation), func(int) int) {
+			avl := NewOrderStatisticTree()
+			for _, key := range initial {
+				avl.Insert(key)
+			}
+			update := func(op operation) {
+				if avl.CountLess(op.insert+1) == avl.CountLess(op.insert) {
+					avl.Insert(op.insert) // the BSTs ignore duplicates
+				}
+				avl.Delete(op.remove)
+			}
+			return update, func(k int) int { val, _ := avl.Select(k); return val }
+		}},
+	}
+	fmt.Printf("%-26s %12s %12s\n", "Method", "Per query", "Mismatches")
+	var expected []int
+	for _, method := range methods {
+		update, query := method.build()
+		answers := []int{}
+		var elapsed time.Duration
+		for _, op := range operations {
+			update(op)
+			start := time.Now()
+			for _, k := range op.ks {
+				answers = append(answers, query(k))
+			}
+			elapsed += time.Since(start)
+		}
+		if expected == nil {
+			expected = answers
+		}
+		mismatches := 0
+		for i := range answers {
+			if answers[i] != expected[i] {
+				mismatches++
+			}
+		}
+		fmt.Printf("%-26s %12v %12d\n", method.name, elapsed/time.Duration(len(answers)), mismatches)
+	}
+	check := NewBST()
+	for _, key := range initial {
+		check.Insert(key)
+	}
+	for _, op := range operations {
+		check.Insert(op.insert)
+		check.Delete(op.remove)
+	}
+	fmt.Printf("Final BST: height %d, sizes valid: %v\n", check.Height(), bstSizesValid(check.root))
+	fmt.Println()
+
+	// Example 3: O(h) is only as good as the height
+	fmt.Println("=== EXAMPLE 3: Sorted Inserts ===")
+	skewed, balanced := NewBST(), NewOrderStatisticTree()
+	for val := 1; val <= 5_000; val++ {
+		skewed.Insert(val)
+		balanced.Insert(val)
+	}
+	start := time.Now()
+	for k := 1; k <= 5_000; k += 5 {
+		skewed.KthSmallest(k)
+	}
+	skewedTime := time.Since(start) / 1_000
+	start = time.Now()
+	for k := 1; k <= 5_000; k += 5 {
+		balanced.Select(k)
+	}
+	balancedTime := time.Since(start) / 1_000
+	fmt.Printf("BST height %d: %v per query; AVL: %v per query\n", skewed.Height(), skewedTime, balancedTime)
+	fmt.Println()
+
+	fmt.Println("A size per node costs one counter update on every node of the insert")
+	fmt.Println("or delete path, and turns each k-th smallest query from a full inorder")
+	fmt.Println("scan into a single root-to-node descent. The Morris scan needs no extra")
+	fmt.Println("fields, but it pays O(n) on every query. The descent is O(h), so on")
+	fmt.Println("sorted input it is linear too; the AVL tree keeps it logarithmic.")
+	fmt.Println()
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// testRouter is the network of DemoConcurrentGraph; its best Client ->
+// Server route costs 33 ms in both link configurations below
+func testRouter() *ConcurrentRouter {
+	network := NewNetworkRouter([]string{"Router-A", "Router-B", "Router-C", "Router-D", "Server", "Client"})
+	network.AddConnection("Client", "Router-A", 5.0)
+	network.AddConnection("Router-A", "Router-B", 10.0)
+	network.AddConnection("Router-A", "Router-C", 15.0)
+	network.AddConnection("Router-B", "Router-D", 12.0)
+	network.AddConnection("Router-C", "Router-D", 8.0)
+	network.AddConnection("Router-D", "Server", 6.0)
+	network.AddConnection("Router-B", "Server", 20.0)
+	return NewConcurrentRouter(network)
+}
+
+// TestSnapshotGraphConcurrent races readers against a writer that swaps
+// two link latencies in one update. A reader seeing half an update would
+// get 31 or 35 ms. Run with -race to check for data races as well.
+func TestSnapshotGraphConcurrent(t *testing.T) {
+	router := testRouter()
+	configurations := [][]LinkUpdate{
+		{{A: "Router-A", B: "Router-B", Latency: 10}, {A: "Router-B", B: "Router-D", Latency: 12}},
+		{{A: "Router-A", B: "Router-B", Latency: 12}, {A: "Router-B", B: "Router-D", Latency: 10}},
+	}
+
+	var stop atomic.Bool
+	var wg sync.WaitGroup
+	errs := make(chan error, 4)
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for !stop.Load() {
+				_, latency, err := router.Route("Client", "Server")
+				if err != nil || latency != 33 {
+					errs <- fmt.Errorf("Route = %v, %v; want 33, nil", latency, err)
+					return
+				}
+			}
+		}()
+	}
+	for i := 0; i < 2_000; i++ {
+		if err := router.UpdateLinks(configurations[i%2]...); err != nil {
+			t.Fatalf("UpdateLinks: %v", err)
+		}
+	}
+	stop.Store(true)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+	if got := router.links.Version(); got != 2_000 {
+		t.Errorf("Version() = %d, want 2000", got)
+	}
+}
+
+// TestLockedGraphConcurrent runs the same kind of load on the mutex design
+func TestLockedGraphConcurrent(t *testing.T) {
+	rng := rand.New(rand.NewSource(1048))
+	locked := NewLockedGraph(randomNetwork(200, 600, rng))
+	var wg sync.WaitGroup
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func(source int) {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				locked.ShortestPaths(source)
+			}
+		}(r)
+	}
+	for i := 0; i < 200; i++ {
+		if err := locked.Apply(undirectedUpdate(rng.Intn(200), rng.Intn(200), float64(1+rng.Intn(100)), i%3 == 0)...); err != nil {
+			t.Fatalf("Apply: %v", err)
+		}
+	}
+	wg.Wait()
+}
+
+func TestApplyRejectsOutOfRangeVertices(t *testing.T) {
+	base := NewWeightedGraph(3)
+	base.AddEdge(0, 1, 1)
+	snapshots, locked := NewSnapshotGraph(base), NewLockedGraph(base)
+	for _, bad := range []EdgeUpdate{{From: 0, To: 3}, {From: -1, To: 0}, {From: 3, To: 0, Remove: true}} {
+		// The valid first update must not be applied either
+		updates := []EdgeUpdate{{From: 0, To: 1, Weight: 5}, bad}
+		if err := snapshots.Apply(updates...); err == nil {
+			t.Errorf("SnapshotGraph.Apply(%v) succeeded", bad)
+		}
+		if err := locked.Apply(updates...); err == nil {
+			t.Errorf("LockedGraph.Apply(%v) succeeded", bad)
+		}
+	}
+	if got := snapshots.ShortestPaths(0).distances[1]; got != 1 {
+		t.Errorf("SnapshotGraph distance to 1 = %v, want 1", got)
+	}
+	if got := locked.ShortestPaths(0).distances[1]; got != 1 {
+		t.Errorf("LockedGraph distance to 1 = %v, want 1", got)
+	}
+	if got := snapshots.Version(); got != 0 {
+		t.Errorf("Version() = %d after rejected updates, want 0", got)
+	}
+}
+
+// BenchmarkRouteConcurrent measures Route from parallel goroutines while a
+// writer keeps changing link latencies
+func BenchmarkRouteConcurrent(b *testing.B) {
+	const nodes = 2_000
+	names := make([]string, nodes)
+	for i := range names {
+		names[i] = fmt.Sprintf("node-%d", i)
+	}
+	network := NewNetworkRouter(names)
+	rng := rand.New(rand.NewSource(1048))
+	for i := 0; i < 3*nodes; i++ {
+		a, c := i%nodes, (i+1)%nodes
+		if i >= nodes {
+			a, c = rng.Intn(nodes), rng.Intn(nodes)
+		}
+		network.AddConnection(names[a], names[c], float64(1+rng.Intn(100)))
+	}
+	router := NewConcurrentRouter(network)
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		writer := rand.New(rand.NewSource(1))
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			a, c := writer.Intn(nodes), writer.Intn(nodes)
+			router.UpdateLinks(LinkUpdate{A: names[a], B: names[c], Latency: float64(1 + writer.Intn(100))})
+		}
+	}()
+
+	var seed atomic.Int64
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		reader := rand.New(rand.NewSource(seed.Add(1)))
+		for pb.Next() {
+			router.Route(names[reader.Intn(nodes)], names[reader.Intn(nodes)])
+		}
+	})
+	b.StopTimer()
+	close(stop)
+	<-done
+}
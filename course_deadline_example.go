@@ -0,0 +1,123 @@
+package main
+
+import (
+	"container/heap"
+	"fmt"
+	"sort"
+)
+
+// ================================
+// COURSE SCHEDULE III (DEADLINES)
+// ================================
+
+// TimedCourse is a course that takes Duration days and must be finished
+// by day Deadline
+type TimedCourse struct {
+	Name     string
+	Duration int
+	Deadline int
+}
+
+// courseHeap is a max-heap of courses by duration
+type courseHeap []TimedCourse
+
+func (h courseHeap) Len() int           { return len(h) }
+func (h courseHeap) Less(i, j int) bool { return h[i].Duration > h[j].Duration }
+func (h courseHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *courseHeap) Push(x interface{}) { *h = append(*h, x.(TimedCourse)) }
+
+func (h *courseHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[0 : n-1]
+	return item
+}
+
+// MaxCoursesWithinDeadlines picks the largest set of courses that can be
+// taken one after another, each finishing by its deadline. The chosen
+// courses are returned in the order they should be taken.
+// Greedy: take courses by deadline; whenever the running total overshoots
+// the current deadline, drop the longest course taken so far. Dropping the
+// longest frees the most time while losing only one course.
+// Time Complexity: O(n log n)
+// Space Complexity: O(n)
+func MaxCoursesWithinDeadlines(courses []TimedCourse) []TimedCourse {
+	byDeadline := make([]TimedCourse, len(courses))
+	copy(byDeadline, courses)
+	sort.SliceStable(byDeadline, func(i, j int) bool {
+		return byDeadline[i].Deadline < byDeadline[j].Deadline
+	})
+
+	taken := &courseHeap{}
+	day := 0
+	for _, course := range byDeadline {
+		heap.Push(taken, course)
+		day += course.Duration
+		if day > course.Deadline {
+			dropped := heap.Pop(taken).(TimedCourse)
+			day -= dropped.Duration
+		}
+	}
+
+	// Taking the kept courses by deadline meets every deadline
+	chosen := append([]TimedCourse(nil), (*taken)...)
+	sort.SliceStable(chosen, func(i, j int) bool {
+		return chosen[i].Deadline < chosen[j].Deadline
+	})
+	return chosen
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoCourseDeadlines demonstrates Course Schedule III
+func DemoCourseDeadlines() {
+	fmt.Println("=== COURSE SCHEDULE III: COURSES WITH DEADLINES ===")
+	fmt.Println()
+
+	fmt.Println("CourseSchedule orders courses by prerequisites. Here courses have no")
+	fmt.Println("prerequisites but take time and close on a deadline; only one course")
+	fmt.Println("can be taken at a time. Take as many as possible.")
+	fmt.Println()
+
+	courses := []TimedCourse{
+		{"Math", 100, 200},
+		{"Physics", 200, 1300},
+		{"Chemistry", 1000, 1250},
+		{"Biology", 2000, 3200},
+	}
+
+	fmt.Println("Courses (duration, deadline):")
+	for _, course := range courses {
+		fmt.Printf("  %-10s %5d %5d\n", course.Name, course.Duration, course.Deadline)
+	}
+
+	chosen := MaxCoursesWithinDeadlines(courses)
+	fmt.Printf("\nMaximum courses: %d\n", len(chosen))
+	day := 0
+	for _, course := range chosen {
+		day += course.Duration
+		fmt.Printf("  %-10s finishes day %4d (deadline %d)\n", course.Name, day, course.Deadline)
+	}
+	fmt.Println()
+
+	// A long course taken early is swapped out for two short ones
+	fmt.Println("Swapping a long course for shorter ones:")
+	swap := []TimedCourse{
+		{"Thesis", 5, 5},
+		{"Seminar", 2, 6},
+		{"Lab", 2, 7},
+	}
+	names := []string{}
+	for _, course := range MaxCoursesWithinDeadlines(swap) {
+		names = append(names, course.Name)
+	}
+	fmt.Printf("  Chosen: %v (Thesis is dropped once it blocks two courses)\n", names)
+	fmt.Println()
+
+	fmt.Println("Time Complexity: O(n log n) - sort plus one heap operation per course")
+	fmt.Println()
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestDeltaSteppingMatchesDijkstraForAnyDelta(t *testing.T) {
+	rng := rand.New(rand.NewSource(1024))
+	graph := randomSparseGraph(300, 1_500, rng)
+	want := DijkstraArray(graph, 0).distances
+	for _, delta := range []float64{0, -3, math.NaN(), math.Inf(-1), 1e-300, 1e-12, 1, 50, 1000, math.Inf(1)} {
+		if got, err := DeltaStepping(graph, 0, delta, 4); err != nil || !slices.Equal(got, want) {
+			t.Errorf("DeltaStepping with delta %v differs from Dijkstra", delta)
+		}
+	}
+}
+
+func TestDeltaSteppingWithoutPositiveWeights(t *testing.T) {
+	graph := NewWeightedGraph(3)
+	graph.AddEdge(0, 1, 0)
+	want := []float64{0, 0, math.Inf(1)}
+	if got, err := DeltaStepping(graph, 0, 0, 1); err != nil || !slices.Equal(got, want) {
+		t.Errorf("DeltaStepping = %v, %v; want %v", got, err, want)
+	}
+}
+
+func TestDeltaSteppingRejectsNegativeWeights(t *testing.T) {
+	graph := NewWeightedGraph(3)
+	graph.AddEdge(0, 1, 5)
+	graph.AddEdge(1, 2, -7)
+	if got, err := DeltaStepping(graph, 0, 0, 1); err == nil {
+		t.Errorf("DeltaStepping = %v, want an error for the negative edge", got)
+	}
+}
+
+func TestDeltaSteppingWideWeightSpread(t *testing.T) {
+	for _, heavy := range []float64{1e9, 1e300} {
+		graph := NewWeightedGraph(5)
+		graph.AddEdge(0, 1, 1)
+		graph.AddEdge(1, 2, heavy)
+		graph.AddEdge(0, 2, heavy+heavy)
+		graph.AddEdge(2, 3, 1)
+		graph.AddEdge(0, 3, heavy*3)
+		graph.AddEdge(3, 4, heavy)
+		want := DijkstraArray(graph, 0).distances
+		for _, delta := range []float64{1, 0} {
+			if got, err := DeltaStepping(graph, 0, delta, 2); err != nil || !slices.Equal(got, want) {
+				t.Errorf("weights {1, %v}, delta %v: DeltaStepping = %v, %v; want %v", heavy, delta, got, err, want)
+			}
+		}
+	}
+}
@@ -2,12 +2,31 @@ package main
 
 import (
 	"fmt"
+	"slices"
+	"sort"
 )
 
-// Graph represents an adjacency list graph
+// Graph represents an adjacency list graph. Its edges are either all
+// undirected (AddEdge) or all directed (AddDirectedEdge); the first edge
+// added decides. Adding an edge of the other kind panics, since algorithms
+// such as cycle detection could not tell the arcs apart later.
 type Graph struct {
 	vertices int
 	adjList  map[int][]int
+	kind     graphEdgeKind
+}
+
+// graphEdgeKind records which kind of edges a Graph holds
+type graphEdgeKind int
+
+const (
+	graphNoEdges graphEdgeKind = iota
+	graphUndirected
+	graphDirected
+)
+
+func (k graphEdgeKind) String() string {
+	return [...]string{"no", "undirected", "directed"}[k]
 }
 
 // NewGraph creates a new graph with given number of vertices
@@ -18,17 +37,29 @@ func NewGraph(vertices int) *Graph {
 	}
 }
 
-// AddEdge adds an edge between two vertices (undirected graph)
+// AddEdge adds an edge between two vertices (undirected graph).
+// Panics if the graph already has directed edges.
 func (g *Graph) AddEdge(u, v int) {
+	g.setEdgeKind(graphUndirected)
 	g.adjList[u] = append(g.adjList[u], v)
 	g.adjList[v] = append(g.adjList[v], u) // For undirected graph
 }
 
-// AddDirectedEdge adds a directed edge from u to v
+// AddDirectedEdge adds a directed edge from u to v.
+// Panics if the graph already has undirected edges.
 func (g *Graph) AddDirectedEdge(u, v int) {
+	g.setEdgeKind(graphDirected)
 	g.adjList[u] = append(g.adjList[u], v)
 }
 
+// setEdgeKind fixes the kind of the graph's edges on the first edge added
+func (g *Graph) setEdgeKind(kind graphEdgeKind) {
+	if g.kind != graphNoEdges && g.kind != kind {
+		panic(fmt.Sprintf("graph: cannot add a %v edge to a graph with %v edges", kind, g.kind))
+	}
+	g.kind = kind
+}
+
 // TreeNode represents a binary tree node
 type TreeNode struct {
 	Val   int
@@ -44,24 +75,11 @@ type TreeNode struct {
 // Time Complexity: O(V + E) where V = vertices, E = edges
 // Space Complexity: O(V) for visited array and recursion stack
 func (g *Graph) DFS(start int) {
-	visited := make(map[int]bool)
 	fmt.Print("DFS Traversal: ")
-	g.dfsUtil(start, visited)
-	fmt.Println()
-}
-
-// dfsUtil is a recursive utility function for DFS
-func (g *Graph) dfsUtil(vertex int, visited map[int]bool) {
-	// Mark current vertex as visited and print it
-	visited[vertex] = true
-	fmt.Printf("%d ", vertex)
-
-	// Recursively visit all adjacent vertices
-	for _, neighbor := range g.adjList[vertex] {
-		if !visited[neighbor] {
-			g.dfsUtil(neighbor, visited)
-		}
+	for _, vertex := range TraverseDFS(g, start) {
+		fmt.Printf("%d ", vertex)
 	}
+	fmt.Println()
 }
 
 // DFSIterative performs DFS using a stack (iterative approach)
@@ -131,25 +149,9 @@ func DFSPostorder(root *TreeNode) {
 // Time Complexity: O(V + E) where V = vertices, E = edges
 // Space Complexity: O(V) for visited array and queue
 func (g *Graph) BFS(start int) {
-	visited := make(map[int]bool)
-	queue := []int{start}
-	visited[start] = true
-
 	fmt.Print("BFS Traversal: ")
-
-	for len(queue) > 0 {
-		// Dequeue
-		vertex := queue[0]
-		queue = queue[1:]
+	for _, vertex := range TraverseBFS(g, start) {
 		fmt.Printf("%d ", vertex)
-
-		// Add all unvisited neighbors to queue
-		for _, neighbor := range g.adjList[vertex] {
-			if !visited[neighbor] {
-				visited[neighbor] = true
-				queue = append(queue, neighbor)
-			}
-		}
 	}
 	fmt.Println()
 }
@@ -160,20 +162,20 @@ func BFSLevelOrder(root *TreeNode) {
 		return
 	}
 
-	queue := []*TreeNode{root}
+	queue := Queue[*TreeNode]{}
+	queue.Enqueue(root)
 	fmt.Print("BFS Level Order: ")
 
-	for len(queue) > 0 {
-		node := queue[0]
-		queue = queue[1:]
+	for queue.Len() > 0 {
+		node, _ := queue.Dequeue()
 
 		fmt.Printf("%d ", node.Val)
 
 		if node.Left != nil {
-			queue = append(queue, node.Left)
+			queue.Enqueue(node.Left)
 		}
 		if node.Right != nil {
-			queue = append(queue, node.Right)
+			queue.Enqueue(node.Right)
 		}
 	}
 	fmt.Println()
@@ -185,23 +187,23 @@ func (g *Graph) BFSShortestPath(start, end int) int {
 		return 0
 	}
 
+	type entry struct{ vertex, distance int }
 	visited := make(map[int]bool)
-	queue := [][]int{{start, 0}} // [vertex, distance]
+	queue := Queue[entry]{}
+	queue.Enqueue(entry{start, 0})
 	visited[start] = true
 
-	for len(queue) > 0 {
-		current := queue[0]
-		queue = queue[1:]
-		vertex, distance := current[0], current[1]
+	for queue.Len() > 0 {
+		current, _ := queue.Dequeue()
 
-		for _, neighbor := range g.adjList[vertex] {
+		for _, neighbor := range g.adjList[current.vertex] {
 			if neighbor == end {
-				return distance + 1
+				return current.distance + 1
 			}
 
 			if !visited[neighbor] {
 				visited[neighbor] = true
-				queue = append(queue, []int{neighbor, distance + 1})
+				queue.Enqueue(entry{neighbor, current.distance + 1})
 			}
 		}
 	}
@@ -209,53 +211,169 @@ func (g *Graph) BFSShortestPath(start, end int) int {
 	return -1 // Path not found
 }
 
-// ================================
-// EXAMPLE APPLICATIONS
-// ================================
+// validVertex checks that v is a vertex of the graph
+func (g *Graph) validVertex(v int) error {
+	if v < 0 || v >= g.vertices {
+		return fmt.Errorf("vertex %d out of range [0, %d)", v, g.vertices)
+	}
+	return nil
+}
 
-// Check if graph has a cycle using DFS
-func (g *Graph) HasCycleDFS() bool {
-	visited := make(map[int]bool)
-	recStack := make(map[int]bool)
+// ShortestPath returns the vertex sequence of one shortest path from start to end
+// BFS records each vertex's predecessor, then the path is rebuilt backwards
+// Time Complexity: O(V + E)
+// Space Complexity: O(V)
+func (g *Graph) ShortestPath(start, end int) ([]int, error) {
+	if err := g.validVertex(start); err != nil {
+		return nil, err
+	}
+	if err := g.validVertex(end); err != nil {
+		return nil, err
+	}
 
-	for vertex := 0; vertex < g.vertices; vertex++ {
-		if !visited[vertex] {
-			if g.hasCycleDFSUtil(vertex, visited, recStack) {
-				return true
+	previous := map[int]int{start: -1}
+	queue := Queue[int]{}
+	queue.Enqueue(start)
+
+	for queue.Len() > 0 && !containsKey(previous, end) {
+		vertex, _ := queue.Dequeue()
+
+		for _, neighbor := range g.adjList[vertex] {
+			if _, seen := previous[neighbor]; !seen {
+				previous[neighbor] = vertex
+				queue.Enqueue(neighbor)
 			}
 		}
 	}
-	return false
+
+	if !containsKey(previous, end) {
+		return nil, fmt.Errorf("no path from %d to %d", start, end)
+	}
+
+	path := []int{}
+	for current := end; current != -1; current = previous[current] {
+		path = append(path, current)
+	}
+	slices.Reverse(path)
+	return path, nil
 }
 
-func (g *Graph) hasCycleDFSUtil(vertex int, visited, recStack map[int]bool) bool {
-	visited[vertex] = true
-	recStack[vertex] = true
+// AllShortestPaths returns every shortest path from start to end.
+// BFS keeps all predecessors that lie on a shortest path, then the paths are
+// enumerated by DFS over that predecessor DAG.
+func (g *Graph) AllShortestPaths(start, end int) ([][]int, error) {
+	if err := g.validVertex(start); err != nil {
+		return nil, err
+	}
+	if err := g.validVertex(end); err != nil {
+		return nil, err
+	}
+
+	distance := map[int]int{start: 0}
+	predecessors := make(map[int][]int)
+	queue := Queue[int]{}
+	queue.Enqueue(start)
+
+	for queue.Len() > 0 {
+		vertex, _ := queue.Dequeue()
+
+		for _, neighbor := range g.adjList[vertex] {
+			d, seen := distance[neighbor]
+			if !seen {
+				distance[neighbor] = distance[vertex] + 1
+				predecessors[neighbor] = []int{vertex}
+				queue.Enqueue(neighbor)
+			} else if preds := predecessors[neighbor]; d == distance[vertex]+1 && preds[len(preds)-1] != vertex {
+				// A parallel edge repeats vertex right after its first entry
+				predecessors[neighbor] = append(preds, vertex)
+			}
+		}
+	}
+
+	if !containsKey(distance, end) {
+		return nil, fmt.Errorf("no path from %d to %d", start, end)
+	}
 
-	for _, neighbor := range g.adjList[vertex] {
-		if !visited[neighbor] && g.hasCycleDFSUtil(neighbor, visited, recStack) {
-			return true
-		} else if recStack[neighbor] {
-			return true
+	paths := [][]int{}
+	reversed := []int{end}
+	var collect func(vertex int)
+	collect = func(vertex int) {
+		if vertex == start {
+			path := make([]int, len(reversed))
+			for i, v := range reversed {
+				path[len(reversed)-1-i] = v
+			}
+			paths = append(paths, path)
+			return
+		}
+		for _, pred := range predecessors[vertex] {
+			reversed = append(reversed, pred)
+			collect(pred)
+			reversed = reversed[:len(reversed)-1]
 		}
 	}
+	collect(end)
 
-	recStack[vertex] = false
-	return false
+	return paths, nil
 }
 
-// Count connected components using DFS
-func (g *Graph) CountConnectedComponents() int {
+// containsKey reports whether key is present in m
+func containsKey[K comparable, V any](m map[K]V, key K) bool {
+	_, ok := m[key]
+	return ok
+}
+
+// ================================
+// EXAMPLE APPLICATIONS
+// ================================
+
+// HasCycleDFS checks if the graph has a cycle using DFS.
+// Graphs built with AddEdge are undirected, so the edge back to the parent is
+// not a cycle; once AddDirectedEdge has been used the recursion-stack check
+// for directed graphs applies instead.
+func (g *Graph) HasCycleDFS() bool {
+	return GraphHasCycle(g)
+}
+
+// ConnectedComponents returns the vertices of each connected component.
+// On a directed graph these are the weakly connected components: arcs are
+// followed in both directions, since following only outgoing arcs would
+// split or repeat components depending on the start vertex.
+// Components are ordered by their smallest vertex; members are sorted
+// Time Complexity: O(V + E)
+// Space Complexity: O(V + E) for a directed graph, O(V) otherwise
+func (g *Graph) ConnectedComponents() [][]int {
+	view := AdjacencyGraph(g)
+	if g.IsDirected() {
+		undirected := NewGraph(g.vertices)
+		for u, neighbors := range g.adjList {
+			for _, v := range neighbors {
+				undirected.AddEdge(u, v)
+			}
+		}
+		view = undirected
+	}
+
 	visited := make(map[int]bool)
-	count := 0
+	components := [][]int{}
 
 	for vertex := 0; vertex < g.vertices; vertex++ {
-		if !visited[vertex] {
-			g.dfsUtil(vertex, visited)
-			count++
+		if visited[vertex] {
+			continue
 		}
+		component := TraverseDFS(view, vertex)
+		for _, member := range component {
+			visited[member] = true
+		}
+		sort.Ints(component)
+		components = append(components, component)
 	}
-	return count
+	return components
+}
+
+// Count connected components using DFS
+func (g *Graph) CountConnectedComponents() int {
+	return len(g.ConnectedComponents())
 }
 
 // ================================
@@ -263,7 +381,8 @@ func (g *Graph) CountConnectedComponents() int {
 // ================================
 
 func DemoDFSBFS() {
-	fmt.Println("=== DFS and BFS Algorithms in Go ===\n")
+	fmt.Println("=== DFS and BFS Algorithms in Go ===")
+	fmt.Println()
 
 	// Create a sample graph
 	// Graph structure:
@@ -303,7 +422,26 @@ func DemoDFSBFS() {
 	// Shortest path using BFS
 	fmt.Println("=== BFS Shortest Path ===")
 	distance := graph.BFSShortestPath(0, 6)
-	fmt.Printf("Shortest path from 0 to 6: %d edges\n\n", distance)
+	fmt.Printf("Shortest path from 0 to 6: %d edges\n", distance)
+	if path, err := graph.ShortestPath(0, 6); err == nil {
+		fmt.Printf("Route: %v\n", path)
+	}
+
+	// A square 0-1-3 / 0-2-3 has two equally short routes
+	square := NewGraph(4)
+	square.AddEdge(0, 1)
+	square.AddEdge(0, 2)
+	square.AddEdge(1, 3)
+	square.AddEdge(2, 3)
+	allPaths, _ := square.AllShortestPaths(0, 3)
+	fmt.Printf("All shortest paths 0 -> 3 in a square: %v\n", allPaths)
+
+	split := NewGraph(3)
+	split.AddEdge(0, 1)
+	if _, err := split.ShortestPath(0, 2); err != nil {
+		fmt.Printf("Unreachable target: %v\n", err)
+	}
+	fmt.Println()
 
 	// Binary Tree Examples
 	fmt.Println("=== BINARY TREE TRAVERSALS ===")
@@ -348,6 +486,18 @@ func DemoDFSBFS() {
 
 	fmt.Printf("Directed graph has cycle: %v\n", directedGraph.HasCycleDFS())
 
+	// The same check on undirected graphs must not count the edge back to the parent
+	undirectedPath := NewGraph(2)
+	undirectedPath.AddEdge(0, 1)
+	fmt.Printf("Undirected single edge has cycle: %v\n", undirectedPath.HasCycleDFS())
+
+	undirectedTriangle := NewGraph(3)
+	undirectedTriangle.AddEdge(0, 1)
+	undirectedTriangle.AddEdge(1, 2)
+	undirectedTriangle.AddEdge(2, 0)
+	fmt.Printf("Undirected triangle has cycle: %v\n", undirectedTriangle.HasCycleDFS())
+	fmt.Printf("Sample tree graph has cycle: %v\n", graph.HasCycleDFS())
+
 	// Count connected components
 	disconnectedGraph := NewGraph(6)
 	disconnectedGraph.AddEdge(0, 1)
@@ -355,4 +505,5 @@ func DemoDFSBFS() {
 	// Vertex 4 and 5 are isolated
 
 	fmt.Printf("Connected components: %d\n", disconnectedGraph.CountConnectedComponents())
+	fmt.Printf("Component members: %v\n", disconnectedGraph.ConnectedComponents())
 }
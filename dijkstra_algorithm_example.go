@@ -1,9 +1,13 @@
 package main
 
 import (
-	"container/heap"
+	"errors"
 	"fmt"
+	"io"
 	"math"
+	"os"
+	"slices"
+	"strings"
 )
 
 // ================================
@@ -16,29 +20,39 @@ type WeightedEdge struct {
 	weight float64 // edge weight
 }
 
-// WeightedGraph represents a weighted directed graph
+// WeightedGraph represents a weighted directed graph. Undirected edges are
+// stored as two arcs; the graph counts as undirected only while every
+// edge was added with AddUndirectedEdge.
 type WeightedGraph struct {
-	vertices int
-	adjList  [][]WeightedEdge
+	vertices   int
+	adjList    [][]WeightedEdge
+	undirected bool // no edge has been added with AddEdge
 }
 
 // NewWeightedGraph creates a new weighted graph
 func NewWeightedGraph(vertices int) *WeightedGraph {
 	return &WeightedGraph{
-		vertices: vertices,
-		adjList:  make([][]WeightedEdge, vertices),
+		vertices:   vertices,
+		adjList:    make([][]WeightedEdge, vertices),
+		undirected: true,
 	}
 }
 
-// AddEdge adds a weighted edge to the graph
+// AddEdge adds a weighted directed edge to the graph
 func (g *WeightedGraph) AddEdge(from, to int, weight float64) {
-	g.adjList[from] = append(g.adjList[from], WeightedEdge{to: to, weight: weight})
+	g.addArc(from, to, weight)
+	g.undirected = false
 }
 
 // AddUndirectedEdge adds an undirected weighted edge
 func (g *WeightedGraph) AddUndirectedEdge(u, v int, weight float64) {
-	g.AddEdge(u, v, weight)
-	g.AddEdge(v, u, weight)
+	g.addArc(u, v, weight)
+	g.addArc(v, u, weight)
+}
+
+// addArc appends the arc from -> to without changing the edge kind
+func (g *WeightedGraph) addArc(from, to int, weight float64) {
+	g.adjList[from] = append(g.adjList[from], WeightedEdge{to: to, weight: weight})
 }
 
 // PrintGraph displays the graph structure
@@ -65,7 +79,9 @@ type PQItem struct {
 	index    int     // index in the heap
 }
 
-// PriorityQueue implements a min-heap for Dijkstra's algorithm
+// PriorityQueue is a container/heap min-heap of PQItems. Searches that may
+// queue a vertex more than once (skipping stale entries when popped) use it;
+// IndexedMinHeap keeps one entry per vertex instead.
 type PriorityQueue []*PQItem
 
 func (pq PriorityQueue) Len() int { return len(pq) }
@@ -97,12 +113,6 @@ func (pq *PriorityQueue) Pop() interface{} {
 	return item
 }
 
-// update modifies the distance of an item in the queue
-func (pq *PriorityQueue) update(item *PQItem, distance float64) {
-	item.distance = distance
-	heap.Fix(pq, item.index)
-}
-
 // ================================
 // DIJKSTRA'S ALGORITHM IMPLEMENTATION
 // ================================
@@ -115,9 +125,16 @@ type DijkstraResult struct {
 	visited   []bool    // vertices that have been processed
 }
 
-// Dijkstra implements Dijkstra's shortest path algorithm
+// Dijkstra implements Dijkstra's shortest path algorithm, printing each
+// step to standard output
 func (g *WeightedGraph) Dijkstra(source int) *DijkstraResult {
-	fmt.Printf("=== DIJKSTRA'S ALGORITHM FROM VERTEX %d ===\n\n", source)
+	return g.DijkstraTrace(os.Stdout, source)
+}
+
+// DijkstraTrace is Dijkstra writing its step trace to w; pass io.Discard
+// to run it silently
+func (g *WeightedGraph) DijkstraTrace(w io.Writer, source int) *DijkstraResult {
+	fmt.Fprintf(w, "=== DIJKSTRA'S ALGORITHM FROM VERTEX %d ===\n\n", source)
 
 	// Initialize distances and previous vertices
 	distances := make([]float64, g.vertices)
@@ -131,48 +148,33 @@ func (g *WeightedGraph) Dijkstra(source int) *DijkstraResult {
 	}
 	distances[source] = 0
 
-	// Create priority queue and add source
-	pq := make(PriorityQueue, 0)
-	heap.Init(&pq)
-
-	// Track items in priority queue for updates
-	items := make([]*PQItem, g.vertices)
+	// Create priority queue holding every vertex
+	pq := NewIndexedMinHeap[float64](g.vertices)
 	for i := 0; i < g.vertices; i++ {
-		item := &PQItem{
-			vertex:   i,
-			distance: distances[i],
-		}
-		items[i] = item
-		heap.Push(&pq, item)
+		pq.Push(i, distances[i])
 	}
 
-	fmt.Printf("Initial state:\n")
-	fmt.Printf("Distances: %v\n", formatDistances(distances))
-	fmt.Printf("Previous:  %v\n\n", previous)
+	fmt.Fprintf(w, "Initial state:\n")
+	fmt.Fprintf(w, "Distances: %v\n", formatDistances(distances))
+	fmt.Fprintf(w, "Previous:  %v\n\n", previous)
 
 	step := 1
 
 	// Main algorithm loop
 	for pq.Len() > 0 {
 		// Extract vertex with minimum distance
-		current := heap.Pop(&pq).(*PQItem)
-		u := current.vertex
-
-		if visited[u] {
-			continue
-		}
-
+		u, _, _ := pq.Pop()
 		visited[u] = true
-		fmt.Printf("Step %d: Process vertex %d (distance %.1f)\n", step, u, distances[u])
+		fmt.Fprintf(w, "Step %d: Process vertex %d (distance %.1f)\n", step, u, distances[u])
 
 		// If distance is infinity, remaining vertices are unreachable
 		if distances[u] == math.Inf(1) {
-			fmt.Printf("  All remaining vertices are unreachable\n")
+			fmt.Fprintf(w, "  All remaining vertices are unreachable\n")
 			break
 		}
 
 		// Update distances to all adjacent vertices
-		fmt.Printf("  Checking neighbors: ")
+		fmt.Fprintf(w, "  Checking neighbors: ")
 		hasNeighbors := false
 		for _, edge := range g.adjList[u] {
 			v := edge.to
@@ -181,28 +183,26 @@ func (g *WeightedGraph) Dijkstra(source int) *DijkstraResult {
 			if !visited[v] {
 				hasNeighbors = true
 				newDistance := distances[u] + weight
-				fmt.Printf("%d(%.1f) ", v, weight)
+				fmt.Fprintf(w, "%d(%.1f) ", v, weight)
 
 				if newDistance < distances[v] {
-					fmt.Printf("[UPDATED: %.1f->%.1f] ", distances[v], newDistance)
+					fmt.Fprintf(w, "[UPDATED: %.1f->%.1f] ", distances[v], newDistance)
 					distances[v] = newDistance
 					previous[v] = u
 
 					// Update priority queue
-					if items[v].index >= 0 {
-						pq.update(items[v], newDistance)
-					}
+					pq.DecreaseKey(v, newDistance)
 				}
 			}
 		}
 
 		if !hasNeighbors {
-			fmt.Printf("none")
+			fmt.Fprintf(w, "none")
 		}
-		fmt.Println()
+		fmt.Fprintln(w)
 
-		fmt.Printf("  Updated distances: %v\n", formatDistances(distances))
-		fmt.Printf("  Updated previous:  %v\n\n", previous)
+		fmt.Fprintf(w, "  Updated distances: %v\n", formatDistances(distances))
+		fmt.Fprintf(w, "  Updated previous:  %v\n\n", previous)
 		step++
 	}
 
@@ -220,6 +220,8 @@ func formatDistances(distances []float64) []string {
 	for i, d := range distances {
 		if d == math.Inf(1) {
 			result[i] = "∞"
+		} else if d == math.Inf(-1) {
+			result[i] = "-∞"
 		} else {
 			result[i] = fmt.Sprintf("%.1f", d)
 		}
@@ -227,21 +229,20 @@ func formatDistances(distances []float64) []string {
 	return result
 }
 
-// GetPath reconstructs the shortest path from source to target
+// GetPath reconstructs the shortest path from source to target.
+// The walk back from target appends, then reverses once at the end;
+// prepending at every step would copy the path each time, O(n²) overall.
+// Time Complexity: O(path length)
 func (result *DijkstraResult) GetPath(target int) []int {
-	if result.distances[target] == math.Inf(1) {
-		return nil // No path exists
+	if math.IsInf(result.distances[target], 0) {
+		return nil // No path exists (or it is unbounded by a negative cycle)
 	}
 
 	path := []int{}
-	current := target
-
-	// Trace back from target to source
-	for current != -1 {
-		path = append([]int{current}, path...) // Prepend to path
-		current = result.previous[current]
+	for current := target; current != -1; current = result.previous[current] {
+		path = append(path, current)
 	}
-
+	slices.Reverse(path)
 	return path
 }
 
@@ -258,6 +259,8 @@ func (result *DijkstraResult) PrintResults() {
 	for i := 0; i < len(result.distances); i++ {
 		if result.distances[i] == math.Inf(1) {
 			fmt.Printf("  To vertex %d: unreachable\n", i)
+		} else if result.distances[i] == math.Inf(-1) {
+			fmt.Printf("  To vertex %d: -∞ (negative cycle)\n", i)
 		} else {
 			fmt.Printf("  To vertex %d: %.1f\n", i, result.distances[i])
 		}
@@ -282,46 +285,78 @@ func (result *DijkstraResult) PrintResults() {
 // PRACTICAL APPLICATIONS
 // ================================
 
+// Errors returned by CityMap.Route and NetworkRouter.Route; test with errors.Is
+var (
+	ErrUnknownLocation = errors.New("unknown location")
+	ErrNoRoute         = errors.New("no route")
+)
+
+// routeEnds looks up the vertex ids of two named locations, reporting
+// unknown names as ErrUnknownLocation
+func routeEnds(g *NamedGraph[string], from, to string) (source, target int, err error) {
+	source, found := g.Index(from)
+	if !found {
+		return 0, 0, fmt.Errorf("%q: %w", from, ErrUnknownLocation)
+	}
+	target, found = g.Index(to)
+	if !found {
+		return 0, 0, fmt.Errorf("%q: %w", to, ErrUnknownLocation)
+	}
+	return source, target, nil
+}
+
+// routeBetween returns the shortest path between two named locations,
+// reporting unknown names and unreachable destinations as errors
+func routeBetween(g *NamedGraph[string], from, to string) ([]string, float64, error) {
+	if _, _, err := routeEnds(g, from, to); err != nil {
+		return nil, 0, err
+	}
+	path, distance, ok := g.ShortestPath(from, to)
+	if !ok {
+		return nil, 0, fmt.Errorf("%s to %s: %w", from, to, ErrNoRoute)
+	}
+	return path, distance, nil
+}
+
+// tracedRoute is routeBetween for vertex ids from routeEnds, writing the
+// step trace of its single Dijkstra search to trace
+func tracedRoute(g *NamedGraph[string], source, target int, trace io.Writer) ([]string, float64, error) {
+	result := g.Graph().DijkstraTrace(trace, source)
+	path := result.GetPath(target)
+	if path == nil {
+		return nil, 0, fmt.Errorf("%s to %s: %w", g.Key(source), g.Key(target), ErrNoRoute)
+	}
+	return g.keysOf(path), result.GetDistance(target), nil
+}
+
 // CityMap represents a city road network
 type CityMap struct {
-	graph     *WeightedGraph
-	cityNames []string
+	roads *NamedGraph[string]
 }
 
 // NewCityMap creates a new city map
 func NewCityMap(cities []string) *CityMap {
-	return &CityMap{
-		graph:     NewWeightedGraph(len(cities)),
-		cityNames: cities,
-	}
+	return &CityMap{roads: NewNamedGraph(cities)}
 }
 
-// AddRoad adds a bidirectional road between cities
+// AddRoad adds a bidirectional road between cities.
+// Roads to unknown cities are ignored.
 func (cm *CityMap) AddRoad(city1, city2 string, distance float64) {
-	from := cm.findCityIndex(city1)
-	to := cm.findCityIndex(city2)
-	if from >= 0 && to >= 0 {
-		cm.graph.AddUndirectedEdge(from, to, distance)
-	}
+	cm.roads.AddUndirectedEdge(city1, city2, distance)
 }
 
-// findCityIndex finds the index of a city
-func (cm *CityMap) findCityIndex(city string) int {
-	for i, name := range cm.cityNames {
-		if name == city {
-			return i
-		}
-	}
-	return -1
+// Route returns the shortest route between two cities and its length.
+// The error wraps ErrUnknownLocation or ErrNoRoute.
+// Time Complexity: O((V + E) log V)
+func (cm *CityMap) Route(from, to string) (path []string, distance float64, err error) {
+	return routeBetween(cm.roads, from, to)
 }
 
 // FindShortestRoute finds the shortest route between two cities
 func (cm *CityMap) FindShortestRoute(from, to string) {
-	fromIndex := cm.findCityIndex(from)
-	toIndex := cm.findCityIndex(to)
-
-	if fromIndex < 0 || toIndex < 0 {
-		fmt.Printf("City not found\n")
+	source, target, err := routeEnds(cm.roads, from, to)
+	if err != nil {
+		fmt.Printf("City not found: %v\n", err)
 		return
 	}
 
@@ -329,25 +364,18 @@ func (cm *CityMap) FindShortestRoute(from, to string) {
 
 	// Print city map
 	fmt.Println("City Network:")
-	for i, city := range cm.cityNames {
+	for i, city := range cm.roads.Keys() {
 		fmt.Printf("%d: %s\n", i, city)
 	}
 	fmt.Println()
 
-	result := cm.graph.Dijkstra(fromIndex)
-
-	path := result.GetPath(toIndex)
-	distance := result.GetDistance(toIndex)
+	// Step-by-step trace over the vertex ids listed above
+	path, distance, err := tracedRoute(cm.roads, source, target, os.Stdout)
 
-	if path != nil {
+	if err == nil {
 		fmt.Printf("Shortest route from %s to %s:\n", from, to)
-		for i, cityIndex := range path {
-			if i > 0 {
-				fmt.Printf(" -> ")
-			}
-			fmt.Printf("%s", cm.cityNames[cityIndex])
-		}
-		fmt.Printf("\nTotal distance: %.1f km\n\n", distance)
+		fmt.Println(strings.Join(path, " -> "))
+		fmt.Printf("Total distance: %.1f km\n\n", distance)
 	} else {
 		fmt.Printf("No route found from %s to %s\n\n", from, to)
 	}
@@ -355,63 +383,44 @@ func (cm *CityMap) FindShortestRoute(from, to string) {
 
 // NetworkRouter simulates network packet routing
 type NetworkRouter struct {
-	graph     *WeightedGraph
-	nodeNames []string
+	links *NamedGraph[string]
 }
 
 // NewNetworkRouter creates a new network router
 func NewNetworkRouter(nodes []string) *NetworkRouter {
-	return &NetworkRouter{
-		graph:     NewWeightedGraph(len(nodes)),
-		nodeNames: nodes,
-	}
+	return &NetworkRouter{links: NewNamedGraph(nodes)}
 }
 
-// AddConnection adds a network connection with latency
+// AddConnection adds a network connection with latency.
+// Connections to unknown nodes are ignored.
 func (nr *NetworkRouter) AddConnection(node1, node2 string, latency float64) {
-	from := nr.findNodeIndex(node1)
-	to := nr.findNodeIndex(node2)
-	if from >= 0 && to >= 0 {
-		nr.graph.AddUndirectedEdge(from, to, latency)
-	}
+	nr.links.AddUndirectedEdge(node1, node2, latency)
 }
 
-// findNodeIndex finds the index of a network node
-func (nr *NetworkRouter) findNodeIndex(node string) int {
-	for i, name := range nr.nodeNames {
-		if name == node {
-			return i
-		}
-	}
-	return -1
+// Route returns the minimum-latency route between two nodes and its latency.
+// The error wraps ErrUnknownLocation or ErrNoRoute.
+// Time Complexity: O((V + E) log V)
+func (nr *NetworkRouter) Route(source, destination string) (path []string, latency float64, err error) {
+	return routeBetween(nr.links, source, destination)
 }
 
 // FindOptimalRoute finds the route with minimum latency
 func (nr *NetworkRouter) FindOptimalRoute(source, destination string) {
-	sourceIndex := nr.findNodeIndex(source)
-	destIndex := nr.findNodeIndex(destination)
-
-	if sourceIndex < 0 || destIndex < 0 {
-		fmt.Printf("Network node not found\n")
+	sourceIndex, destinationIndex, err := routeEnds(nr.links, source, destination)
+	if err != nil {
+		fmt.Printf("Network node not found: %v\n", err)
 		return
 	}
 
 	fmt.Printf("=== NETWORK ROUTING: %s to %s ===\n\n", source, destination)
 
-	result := nr.graph.Dijkstra(sourceIndex)
-
-	path := result.GetPath(destIndex)
-	latency := result.GetDistance(destIndex)
+	// Step-by-step trace; vertex ids follow the order of the node list
+	path, latency, err := tracedRoute(nr.links, sourceIndex, destinationIndex, os.Stdout)
 
-	if path != nil {
+	if err == nil {
 		fmt.Printf("Optimal route (minimum latency):\n")
-		for i, nodeIndex := range path {
-			if i > 0 {
-				fmt.Printf(" -> ")
-			}
-			fmt.Printf("%s", nr.nodeNames[nodeIndex])
-		}
-		fmt.Printf("\nTotal latency: %.1f ms\n\n", latency)
+		fmt.Println(strings.Join(path, " -> "))
+		fmt.Printf("Total latency: %.1f ms\n\n", latency)
 	} else {
 		fmt.Printf("No route found from %s to %s\n\n", source, destination)
 	}
@@ -433,28 +442,22 @@ func (g *WeightedGraph) DijkstraWithPath(source, target int) (float64, []int) {
 	}
 	distances[source] = 0
 
-	pq := make(PriorityQueue, 0)
-	heap.Init(&pq)
-	heap.Push(&pq, &PQItem{vertex: source, distance: 0})
+	pq := NewIndexedMinHeap[float64](g.vertices)
+	pq.Push(source, 0)
 
 	for pq.Len() > 0 {
-		current := heap.Pop(&pq).(*PQItem)
-		u := current.vertex
+		u, _, _ := pq.Pop()
 
 		if u == target {
 			// Found target, reconstruct path
 			path := []int{}
-			curr := target
-			for curr != -1 {
-				path = append([]int{curr}, path...)
-				curr = previous[curr]
+			for curr := target; curr != -1; curr = previous[curr] {
+				path = append(path, curr)
 			}
+			slices.Reverse(path)
 			return distances[target], path
 		}
 
-		if visited[u] {
-			continue
-		}
 		visited[u] = true
 
 		for _, edge := range g.adjList[u] {
@@ -464,7 +467,7 @@ func (g *WeightedGraph) DijkstraWithPath(source, target int) (float64, []int) {
 				if newDistance < distances[v] {
 					distances[v] = newDistance
 					previous[v] = u
-					heap.Push(&pq, &PQItem{vertex: v, distance: newDistance})
+					pq.PushOrDecrease(v, newDistance)
 				}
 			}
 		}
@@ -473,6 +476,60 @@ func (g *WeightedGraph) DijkstraWithPath(source, target int) (float64, []int) {
 	return math.Inf(1), nil // No path found
 }
 
+// ZeroOneBFS finds shortest paths when every edge weighs 0 or 1, without a
+// priority queue: a deque stays sorted by distance if 0-edges push to the
+// front and 1-edges push to the back, since it only ever holds two distinct
+// distances, d and d+1. A vertex may be queued more than once; stale copies
+// are skipped when popped.
+// Time Complexity: O(V + E)
+// Space Complexity: O(V + E)
+func (g *WeightedGraph) ZeroOneBFS(source int) (*DijkstraResult, error) {
+	for u := 0; u < g.vertices; u++ {
+		for _, edge := range g.adjList[u] {
+			if edge.weight != 0 && edge.weight != 1 {
+				return nil, fmt.Errorf("edge %d -> %d has weight %v; 0-1 BFS needs weights 0 or 1", u, edge.to, edge.weight)
+			}
+		}
+	}
+
+	distances := make([]float64, g.vertices)
+	previous := make([]int, g.vertices)
+	visited := make([]bool, g.vertices)
+	for i := 0; i < g.vertices; i++ {
+		distances[i] = math.Inf(1)
+		previous[i] = -1
+	}
+	distances[source] = 0
+
+	deque := Deque[int]{}
+	deque.PushBack(source)
+	for deque.Len() > 0 {
+		u, _ := deque.PopFront()
+		if visited[u] {
+			continue
+		}
+		visited[u] = true
+		for _, edge := range g.adjList[u] {
+			if newDistance := distances[u] + edge.weight; newDistance < distances[edge.to] {
+				distances[edge.to] = newDistance
+				previous[edge.to] = u
+				if edge.weight == 0 {
+					deque.PushFront(edge.to)
+				} else {
+					deque.PushBack(edge.to)
+				}
+			}
+		}
+	}
+
+	return &DijkstraResult{
+		distances: distances,
+		previous:  previous,
+		source:    source,
+		visited:   visited,
+	}, nil
+}
+
 // AllPairsShortestPath computes shortest paths between all pairs of vertices
 func (g *WeightedGraph) AllPairsShortestPath() [][]float64 {
 	distances := make([][]float64, g.vertices)
@@ -492,7 +549,8 @@ func (g *WeightedGraph) AllPairsShortestPath() [][]float64 {
 
 // DemoDijkstra demonstrates Dijkstra's algorithm with examples
 func DemoDijkstra() {
-	fmt.Println("=== DIJKSTRA'S SHORTEST PATH ALGORITHM ===\n")
+	fmt.Println("=== DIJKSTRA'S SHORTEST PATH ALGORITHM ===")
+	fmt.Println()
 
 	fmt.Println("Dijkstra's algorithm finds the shortest path from a source vertex")
 	fmt.Println("to all other vertices in a weighted graph with non-negative edge weights.")
@@ -538,7 +596,8 @@ func DemoDijkstra() {
 
 // DemoDijkstraApplications shows practical applications
 func DemoDijkstraApplications() {
-	fmt.Println("=== PRACTICAL APPLICATIONS ===\n")
+	fmt.Println("=== PRACTICAL APPLICATIONS ===")
+	fmt.Println()
 
 	// Application 1: GPS Navigation
 	fmt.Println("1. GPS NAVIGATION SYSTEM")
@@ -555,6 +614,7 @@ func DemoDijkstraApplications() {
 	cityMap.AddRoad("New York", "Washington DC", 225)
 
 	cityMap.FindShortestRoute("New York", "Miami")
+	cityMap.FindOptimalTour("New York")
 
 	// Application 2: Network Routing
 	fmt.Println("2. NETWORK PACKET ROUTING")
@@ -605,11 +665,27 @@ func DemoDijkstraApplications() {
 
 	fmt.Println("Finding most cost-effective supply route:")
 	supplyChain.FindShortestRoute("Factory", "Retail-Store")
+
+	// Application 5: Using routes programmatically
+	fmt.Println("5. PROGRAMMATIC ROUTING")
+	if route, cost, err := supplyChain.Route("Factory", "Retail-Store"); err == nil {
+		fmt.Printf("Route Factory -> Retail-Store: %v (%d legs, cost %.0f)\n", route, len(route)-1, cost)
+	}
+	if _, _, err := network.Route("Client", "Router-Z"); err != nil {
+		fmt.Printf("Route Client -> Router-Z: %v (unknown: %v)\n", err, errors.Is(err, ErrUnknownLocation))
+	}
+	islands := NewCityMap([]string{"Seattle", "Portland", "Honolulu"})
+	islands.AddRoad("Seattle", "Portland", 280)
+	if _, _, err := islands.Route("Seattle", "Honolulu"); err != nil {
+		fmt.Printf("Route Seattle -> Honolulu: %v (unreachable: %v)\n", err, errors.Is(err, ErrNoRoute))
+	}
+	fmt.Println()
 }
 
 // DemoComplexityAnalysis demonstrates algorithm performance characteristics
 func DemoComplexityAnalysis() {
-	fmt.Println("=== COMPLEXITY ANALYSIS ===\n")
+	fmt.Println("=== COMPLEXITY ANALYSIS ===")
+	fmt.Println()
 
 	fmt.Println("Time Complexity:")
 	fmt.Println("- Using Binary Heap (Priority Queue): O((V + E) log V)")
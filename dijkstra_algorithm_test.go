@@ -0,0 +1,38 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestTracedRouteMatchesRoute(t *testing.T) {
+	cityMap := NewCityMap([]string{"A", "B", "C", "D", "E"})
+	cityMap.AddRoad("A", "B", 4)
+	cityMap.AddRoad("A", "C", 1)
+	cityMap.AddRoad("C", "B", 2)
+	cityMap.AddRoad("B", "D", 5)
+
+	source, target, err := routeEnds(cityMap.roads, "A", "D")
+	if err != nil {
+		t.Fatalf("routeEnds: %v", err)
+	}
+	var trace strings.Builder
+	path, distance, err := tracedRoute(cityMap.roads, source, target, &trace)
+	wantPath, wantDistance, _ := cityMap.Route("A", "D")
+	if err != nil || fmt.Sprint(path) != fmt.Sprint(wantPath) || distance != wantDistance {
+		t.Errorf("tracedRoute = %v, %v, %v; want %v, %v", path, distance, err, wantPath, wantDistance)
+	}
+	if runs := strings.Count(trace.String(), "=== DIJKSTRA'S ALGORITHM"); runs != 1 {
+		t.Errorf("trace holds %d searches, want 1", runs)
+	}
+
+	if _, _, err := routeEnds(cityMap.roads, "A", "Z"); !errors.Is(err, ErrUnknownLocation) {
+		t.Errorf("routeEnds(A, Z) err = %v, want ErrUnknownLocation", err)
+	}
+	if _, _, err := tracedRoute(cityMap.roads, source, 4, io.Discard); !errors.Is(err, ErrNoRoute) {
+		t.Errorf("tracedRoute(A, E) err = %v, want ErrNoRoute", err)
+	}
+}
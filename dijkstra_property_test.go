@@ -0,0 +1,232 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// ================================
+// SHORTEST-PATH PROPERTY CHECKS
+// ================================
+
+// shortestPathSolver is an implementation under test: it returns the
+// distance to every vertex and a previous-vertex array for the paths, or
+// nil previous if it only computes distances
+type shortestPathSolver struct {
+	name  string
+	solve func(g *WeightedGraph, source int) (distances []float64, previous []int)
+}
+
+// shortestPathSolvers lists every Dijkstra variant in the repo
+var shortestPathSolvers = []shortestPathSolver{
+	{"Dijkstra (tracing)", func(g *WeightedGraph, source int) ([]float64, []int) {
+		result := g.DijkstraTrace(io.Discard, source)
+		return result.distances, result.previous
+	}},
+	{"DijkstraHeap", func(g *WeightedGraph, source int) ([]float64, []int) {
+		result := DijkstraHeap(g, source)
+		return result.distances, result.previous
+	}},
+	{"DijkstraArray", func(g *WeightedGraph, source int) ([]float64, []int) {
+		result := DijkstraArray(g, source)
+		return result.distances, result.previous
+	}},
+	{"DijkstraWithPath", func(g *WeightedGraph, source int) ([]float64, []int) {
+		distances := make([]float64, g.vertices)
+		for v := range distances {
+			distances[v], _ = g.DijkstraWithPath(source, v)
+		}
+		return distances, nil
+	}},
+	{"DeltaStepping", func(g *WeightedGraph, source int) ([]float64, []int) {
+		distances, _ := DeltaStepping(g, source, 3, 4)
+		return distances, nil
+	}},
+}
+
+// bruteForceDistances tries every simple path from source, so it is only
+// usable on tiny graphs, but it is too simple to share a bug with Dijkstra
+// Time Complexity: O(V!) in the worst case
+func bruteForceDistances(g *WeightedGraph, source int) []float64 {
+	distances := make([]float64, g.vertices)
+	for v := range distances {
+		distances[v] = math.Inf(1)
+	}
+	onPath := make([]bool, g.vertices)
+	var walk func(u int, length float64)
+	walk = func(u int, length float64) {
+		distances[u] = min(distances[u], length)
+		onPath[u] = true
+		for _, edge := range g.adjList[u] {
+			if !onPath[edge.to] {
+				walk(edge.to, length+edge.weight)
+			}
+		}
+		onPath[u] = false
+	}
+	walk(source, 0)
+	return distances
+}
+
+// checkShortestPaths compares distances and previous (which may be nil)
+// against Bellman-Ford and brute-force enumeration, and checks that every
+// previous chain leads back to source along real edges whose weights add up
+// to the claimed distance. It returns the first violation found.
+func checkShortestPaths(g *WeightedGraph, source int, distances []float64, previous []int) error {
+	bellmanFord := g.BellmanFord(source).distances
+	brute := bruteForceDistances(g, source)
+	for v := 0; v < g.vertices; v++ {
+		if distances[v] != bellmanFord[v] || distances[v] != brute[v] {
+			return fmt.Errorf("vertex %d: distance %v, Bellman-Ford %v, brute force %v", v, distances[v], bellmanFord[v], brute[v])
+		}
+	}
+	if previous == nil {
+		return nil
+	}
+	for v := 0; v < g.vertices; v++ {
+		if v == source || math.IsInf(distances[v], 1) {
+			if v != source && previous[v] != -1 {
+				return fmt.Errorf("vertex %d is unreachable but has previous %d", v, previous[v])
+			}
+			continue
+		}
+		length, steps := 0.0, 0
+		for at := v; at != source; at = previous[at] {
+			if steps++; previous[at] < 0 || steps > g.vertices {
+				return fmt.Errorf("vertex %d: previous chain does not lead back to %d", v, source)
+			}
+			best := math.Inf(1)
+			for _, edge := range g.adjList[previous[at]] {
+				if edge.to == at {
+					best = min(best, edge.weight)
+				}
+			}
+			length += best
+		}
+		if length != distances[v] {
+			return fmt.Errorf("vertex %d: path from previous has length %v, distance says %v", v, length, distances[v])
+		}
+	}
+	return nil
+}
+
+// propertyGraphKinds are the shapes of random graphs the checks run on;
+// weights are small integers so sums are exact in float64
+var propertyGraphKinds = []struct {
+	name     string
+	generate func(n int, rng *rand.Rand) *WeightedGraph
+}{
+	{"dense", func(n int, rng *rand.Rand) *WeightedGraph {
+		g := NewWeightedGraph(n)
+		for e := 0; e < n*n/2; e++ {
+			g.AddEdge(rng.Intn(n), rng.Intn(n), float64(1+rng.Intn(9)))
+		}
+		return g
+	}},
+	{"disconnected", func(n int, rng *rand.Rand) *WeightedGraph {
+		// Two halves with edges only inside each half
+		g := NewWeightedGraph(n)
+		half := (n + 1) / 2
+		for e := 0; e < n; e++ {
+			if u, v := rng.Intn(n), rng.Intn(n); (u < half) == (v < half) {
+				g.AddEdge(u, v, float64(1+rng.Intn(9)))
+			}
+		}
+		return g
+	}},
+	{"zero weights", func(n int, rng *rand.Rand) *WeightedGraph {
+		g := NewWeightedGraph(n)
+		for e := 0; e < 2*n; e++ {
+			g.AddEdge(rng.Intn(n), rng.Intn(n), float64(rng.Intn(3)*rng.Intn(2)))
+		}
+		return g
+	}},
+	{"parallel edges", func(n int, rng *rand.Rand) *WeightedGraph {
+		g := NewWeightedGraph(n)
+		for e := 0; e < n; e++ {
+			u, v := rng.Intn(n), rng.Intn(n)
+			for copies := 1 + rng.Intn(3); copies > 0; copies-- {
+				g.AddEdge(u, v, float64(rng.Intn(9)))
+			}
+		}
+		return g
+	}},
+}
+
+// settleOnDiscovery is Dijkstra with a classic mistake: a vertex is marked
+// done when it is first discovered, so a later, shorter route through a
+// farther vertex can never improve it
+func settleOnDiscovery(g *WeightedGraph, source int) ([]float64, []int) {
+	result := newDijkstraResult(g.vertices, source)
+	pq := NewIndexedMinHeap[float64](g.vertices)
+	pq.Push(source, 0)
+	result.visited[source] = true
+	for pq.Len() > 0 {
+		u, _, _ := pq.Pop()
+		for _, edge := range g.adjList[u] {
+			if !result.visited[edge.to] {
+				result.visited[edge.to] = true
+				result.distances[edge.to] = result.distances[u] + edge.weight
+				result.previous[edge.to] = u
+				pq.Push(edge.to, result.distances[edge.to])
+			}
+		}
+	}
+	return result.distances, result.previous
+}
+
+// describeGraph lists a graph's edges on one line
+func describeGraph(g *WeightedGraph) string {
+	edges := []string{}
+	for u := 0; u < g.vertices; u++ {
+		for _, edge := range g.adjList[u] {
+			edges = append(edges, fmt.Sprintf("%d->%d (%v)", u, edge.to, edge.weight))
+		}
+	}
+	return strings.Join(edges, ", ")
+}
+
+// graphsPerKind is how many random graphs of 1-7 vertices each test tries
+const graphsPerKind = 150
+
+// TestDijkstraVariantsMatchReferences runs every implementation on every
+// kind of random graph. Graphs grow from 1 vertex, so the first failure
+// reported is already a small counterexample.
+func TestDijkstraVariantsMatchReferences(t *testing.T) {
+	for _, solver := range shortestPathSolvers {
+		for k, kind := range propertyGraphKinds {
+			t.Run(solver.name+"/"+kind.name, func(t *testing.T) {
+				rng := rand.New(rand.NewSource(int64(1044 + k)))
+				for trial := 0; trial < graphsPerKind; trial++ {
+					g := kind.generate(1+trial%7, rng)
+					source := rng.Intn(g.vertices)
+					distances, previous := solver.solve(g, source)
+					if err := checkShortestPaths(g, source, distances, previous); err != nil {
+						t.Fatalf("graph %s, source %d: %v", describeGraph(g), source, err)
+					}
+				}
+			})
+		}
+	}
+}
+
+// TestShortestPathChecksCatchBug makes sure the checks are not vacuous:
+// they must reject a Dijkstra that settles vertices when first discovered
+func TestShortestPathChecksCatchBug(t *testing.T) {
+	rng := rand.New(rand.NewSource(1044))
+	for n := 1; n <= 7; n++ {
+		for trial := 0; trial < 200; trial++ {
+			g := propertyGraphKinds[0].generate(n, rng)
+			distances, previous := settleOnDiscovery(g, 0)
+			if err := checkShortestPaths(g, 0, distances, previous); err != nil {
+				t.Logf("counterexample with %d vertices: %s: %v", n, describeGraph(g), err)
+				return
+			}
+		}
+	}
+	t.Fatal("no counterexample found for the buggy variant")
+}
@@ -0,0 +1,406 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ================================
+// FRAME-BY-FRAME DOT EXPORT
+// ================================
+
+// Vertex and edge roles a frame can show
+const (
+	frameSettled  = "settled"  // finished: popped, dequeued or fully explored
+	frameFrontier = "frontier" // discovered but not finished
+	frameCurrent  = "current"  // being processed in this step
+	frameTree     = "tree"     // part of the tree or path built so far
+	frameRelaxed  = "relaxed"  // changed in this step
+	frameRejected = "rejected" // examined and discarded
+)
+
+var frameVertexStyles = map[string]string{
+	frameSettled:  `style=filled, fillcolor="#c8e6c9"`,
+	frameFrontier: `style=filled, fillcolor="#fff59d"`,
+	frameCurrent:  `style=filled, fillcolor="#ef9a9a", penwidth=2`,
+}
+
+var frameEdgeStyles = map[string]string{
+	frameTree:     `color="#2e7d32", penwidth=2.5`,
+	frameRelaxed:  `color="#1565c0", penwidth=2.5`,
+	frameRejected: `color=gray, style=dashed`,
+}
+
+// frameState is what one frame shows on top of the plain graph
+type frameState struct {
+	caption  string
+	vertices map[int]string    // vertex -> role
+	notes    map[int]string    // vertex -> extra label, e.g. its distance
+	edges    map[[2]int]string // edge -> role
+}
+
+func newFrameState(caption string) frameState {
+	return frameState{caption: caption, vertices: map[int]string{}, notes: map[int]string{}, edges: map[[2]int]string{}}
+}
+
+// FrameRecorder writes one DOT file per algorithm step into a directory:
+// prefix_000.dot, prefix_001.dot, ... Render them with
+//
+//	for f in dir/*.dot; do dot -Tpng "$f" -o "${f%.dot}.png"; done
+//
+// and assemble the images into an animation with any tool.
+type FrameRecorder struct {
+	dir      string
+	prefix   string
+	directed bool
+	vertices []int
+	edges    []dotEdge
+	frames   int
+	err      error // first write error; later frames are skipped
+}
+
+// newFrameRecorder creates dir if needed
+func newFrameRecorder(dir, prefix string, vertices []int, edges []dotEdge, directed bool) (*FrameRecorder, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("creating frame directory: %w", err)
+	}
+	return &FrameRecorder{dir: dir, prefix: prefix, directed: directed, vertices: vertices, edges: edges}, nil
+}
+
+// edgeKey identifies an edge the way the recorder's graph draws it
+func (r *FrameRecorder) edgeKey(u, v int) [2]int {
+	if !r.directed && v < u {
+		u, v = v, u
+	}
+	return [2]int{u, v}
+}
+
+// mark sets the role of edge u-v in a frame
+func (r *FrameRecorder) mark(state frameState, u, v int, role string) {
+	state.edges[r.edgeKey(u, v)] = role
+}
+
+// record renders one frame
+func (r *FrameRecorder) record(state frameState) {
+	if r.err != nil {
+		return
+	}
+	keyword, arrow := "graph", "--"
+	if r.directed {
+		keyword, arrow = "digraph", "->"
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s %s {\n", keyword, r.prefix)
+	fmt.Fprintf(&sb, "  label=%q;\n  labelloc=t;\n", fmt.Sprintf("Step %d: %s", r.frames, state.caption))
+	for _, v := range r.vertices {
+		attributes := []string{}
+		if note, ok := state.notes[v]; ok {
+			attributes = append(attributes, fmt.Sprintf("label=%q", fmt.Sprintf("%d\n%s", v, note)))
+		}
+		if style, ok := frameVertexStyles[state.vertices[v]]; ok {
+			attributes = append(attributes, style)
+		}
+		if len(attributes) > 0 {
+			fmt.Fprintf(&sb, "  %d [%s];\n", v, strings.Join(attributes, ", "))
+		} else {
+			fmt.Fprintf(&sb, "  %d;\n", v)
+		}
+	}
+	for _, edge := range r.edges {
+		attributes := []string{}
+		if edge.label != "" {
+			attributes = append(attributes, fmt.Sprintf("label=%q", edge.label))
+		}
+		if style, ok := frameEdgeStyles[state.edges[r.edgeKey(edge.from, edge.to)]]; ok {
+			attributes = append(attributes, style)
+		}
+		fmt.Fprintf(&sb, "  %d %s %d", edge.from, arrow, edge.to)
+		if len(attributes) > 0 {
+			fmt.Fprintf(&sb, " [%s]", strings.Join(attributes, ", "))
+		}
+		sb.WriteString(";\n")
+	}
+	sb.WriteString("}\n")
+
+	name := filepath.Join(r.dir, fmt.Sprintf("%s_%03d.dot", r.prefix, r.frames))
+	if err := os.WriteFile(name, []byte(sb.String()), 0o644); err != nil {
+		r.err = fmt.Errorf("writing frame: %w", err)
+		return
+	}
+	r.frames++
+}
+
+// done returns the number of frames written, or the first write error
+func (r *FrameRecorder) done() (int, error) {
+	return r.frames, r.err
+}
+
+// ================================
+// TRACED ALGORITHMS
+// ================================
+
+// BFSFrames runs breadth-first search from start and writes a frame for
+// every dequeued vertex: visited vertices, the queue as frontier, and the
+// BFS tree so far
+func BFSFrames(g *Graph, start int, dir string) (int, error) {
+	edges, directed := g.dotEdges()
+	r, err := newFrameRecorder(dir, "bfs", g.Vertices(), edges, directed)
+	if err != nil {
+		return 0, err
+	}
+	parent := map[int]int{start: -1}
+	finished := map[int]bool{}
+	queue := Queue[int]{}
+	queue.Enqueue(start)
+	snapshot := func(caption string, current int) frameState {
+		state := newFrameState(caption)
+		for v, p := range parent {
+			state.vertices[v] = frameFrontier
+			if finished[v] {
+				state.vertices[v] = frameSettled
+			}
+			if p >= 0 {
+				r.mark(state, p, v, frameTree)
+			}
+		}
+		if current >= 0 {
+			state.vertices[current] = frameCurrent
+		}
+		return state
+	}
+	r.record(snapshot(fmt.Sprintf("start at %d", start), -1))
+
+	for queue.Len() > 0 {
+		vertex, _ := queue.Dequeue()
+		discovered := []int{}
+		for _, neighbor := range g.adjList[vertex] {
+			if _, seen := parent[neighbor]; !seen {
+				parent[neighbor] = vertex
+				queue.Enqueue(neighbor)
+				discovered = append(discovered, neighbor)
+			}
+		}
+		r.record(snapshot(fmt.Sprintf("dequeue %d, discover %v", vertex, discovered), vertex))
+		finished[vertex] = true
+	}
+	r.record(snapshot("done", -1))
+	return r.done()
+}
+
+// DFSFrames runs depth-first search from start and writes a frame when a
+// vertex is entered and when it is finished, so backtracking is visible
+func DFSFrames(g *Graph, start int, dir string) (int, error) {
+	edges, directed := g.dotEdges()
+	r, err := newFrameRecorder(dir, "dfs", g.Vertices(), edges, directed)
+	if err != nil {
+		return 0, err
+	}
+	parent := map[int]int{}
+	finished := map[int]bool{}
+	snapshot := func(caption string, current int) frameState {
+		state := newFrameState(caption)
+		for v, p := range parent {
+			state.vertices[v] = frameFrontier // on the recursion stack
+			if finished[v] {
+				state.vertices[v] = frameSettled
+			}
+			if p >= 0 {
+				r.mark(state, p, v, frameTree)
+			}
+		}
+		if current >= 0 {
+			state.vertices[current] = frameCurrent
+		}
+		return state
+	}
+
+	var visit func(vertex, from int)
+	visit = func(vertex, from int) {
+		parent[vertex] = from
+		r.record(snapshot(fmt.Sprintf("enter %d", vertex), vertex))
+		for _, neighbor := range g.adjList[vertex] {
+			if _, seen := parent[neighbor]; !seen {
+				visit(neighbor, vertex)
+			}
+		}
+		finished[vertex] = true
+		r.record(snapshot(fmt.Sprintf("finish %d", vertex), vertex))
+	}
+	visit(start, -1)
+	r.record(snapshot("done", -1))
+	return r.done()
+}
+
+// DijkstraFrames runs Dijkstra's algorithm from source and writes a frame
+// per settled vertex: distances as vertex notes, the shortest-path tree so
+// far, and the edges relaxed in that step
+func DijkstraFrames(g *WeightedGraph, source int, dir string) (*DijkstraResult, int, error) {
+	edges, directed := g.dotEdges()
+	r, err := newFrameRecorder(dir, "dijkstra", g.Vertices(), edges, directed)
+	if err != nil {
+		return nil, 0, err
+	}
+	result := newDijkstraResult(g.vertices, source)
+	snapshot := func(caption string, current int, relaxed []int) frameState {
+		state := newFrameState(caption)
+		for v, d := range result.distances {
+			if math.IsInf(d, 1) {
+				state.notes[v] = "∞"
+				continue
+			}
+			state.notes[v] = fmt.Sprintf("%g", d)
+			state.vertices[v] = frameFrontier
+			if result.visited[v] {
+				state.vertices[v] = frameSettled
+			}
+			if p := result.previous[v]; p >= 0 {
+				r.mark(state, p, v, frameTree)
+			}
+		}
+		for _, v := range relaxed {
+			r.mark(state, current, v, frameRelaxed)
+		}
+		if current >= 0 {
+			state.vertices[current] = frameCurrent
+		}
+		return state
+	}
+	r.record(snapshot(fmt.Sprintf("source %d at distance 0", source), -1, nil))
+
+	pq := NewIndexedMinHeap[float64](g.vertices)
+	pq.Push(source, 0)
+	for pq.Len() > 0 {
+		u, _, _ := pq.Pop()
+		result.visited[u] = true
+		relaxed := []int{}
+		for _, edge := range g.adjList[u] {
+			if newDistance := result.distances[u] + edge.weight; newDistance < result.distances[edge.to] {
+				result.distances[edge.to] = newDistance
+				result.previous[edge.to] = u
+				pq.PushOrDecrease(edge.to, newDistance)
+				relaxed = append(relaxed, edge.to)
+			}
+		}
+		r.record(snapshot(fmt.Sprintf("settle %d at %g, relax %v", u, result.distances[u], relaxed), u, relaxed))
+	}
+	r.record(snapshot("done", -1, nil))
+	frames, err := r.done()
+	return result, frames, err
+}
+
+// KruskalFrames runs Kruskal's algorithm on an undirected edge list and
+// writes a frame per edge considered: accepted edges join the tree,
+// edges that would close a cycle are shown dashed
+func KruskalFrames(n int, edges []Edge, dir string) ([]Edge, int, int, error) {
+	drawn := make([]dotEdge, len(edges))
+	for i, edge := range edges {
+		drawn[i] = dotEdge{edge.From, edge.To, fmt.Sprint(edge.Weight)}
+	}
+	r, err := newFrameRecorder(dir, "kruskal", vertexRange(n), drawn, false)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	sorted := append([]Edge(nil), edges...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Weight < sorted[j].Weight })
+
+	uf := NewUnionFind(n)
+	mst, rejected := []Edge{}, []Edge{}
+	total := 0
+	snapshot := func(caption string, considered *Edge, accepted bool) frameState {
+		state := newFrameState(caption)
+		for _, edge := range rejected {
+			r.mark(state, edge.From, edge.To, frameRejected)
+		}
+		for _, edge := range mst {
+			r.mark(state, edge.From, edge.To, frameTree)
+			state.vertices[edge.From], state.vertices[edge.To] = frameSettled, frameSettled
+		}
+		if considered != nil {
+			state.vertices[considered.From], state.vertices[considered.To] = frameCurrent, frameCurrent
+			if accepted {
+				r.mark(state, considered.From, considered.To, frameRelaxed)
+			}
+		}
+		return state
+	}
+	r.record(snapshot(fmt.Sprintf("%d edges sorted by weight", len(sorted)), nil, false))
+
+	for i := range sorted {
+		edge := sorted[i]
+		if len(mst) == n-1 {
+			break
+		}
+		if uf.Union(edge.From, edge.To) {
+			mst = append(mst, edge)
+			total += edge.Weight
+			r.record(snapshot(fmt.Sprintf("take %d-%d (weight %d)", edge.From, edge.To, edge.Weight), &edge, true))
+		} else {
+			rejected = append(rejected, edge)
+			r.record(snapshot(fmt.Sprintf("skip %d-%d: would close a cycle", edge.From, edge.To), &edge, false))
+		}
+	}
+	r.record(snapshot(fmt.Sprintf("done: total weight %d", total), nil, false))
+	frames, err := r.done()
+	return mst, total, frames, err
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoDOTFrames writes animation frames for four algorithms
+func DemoDOTFrames() {
+	fmt.Println("=== ALGORITHM ANIMATION FRAMES (DOT) ===")
+	fmt.Println()
+	dir, err := os.MkdirTemp("", "dsa-frames-")
+	if err != nil {
+		fmt.Printf("Cannot create a temporary directory: %v\n", err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	graph := NewGraph(6)
+	for _, edge := range [][2]int{{0, 1}, {0, 2}, {1, 3}, {2, 3}, {3, 4}, {4, 5}, {2, 5}} {
+		graph.AddEdge(edge[0], edge[1])
+	}
+	edges := []Edge{{0, 1, 4}, {0, 2, 1}, {1, 2, 2}, {1, 3, 5}, {2, 3, 8}, {3, 4, 3}, {2, 4, 9}, {4, 5, 2}, {3, 5, 6}}
+	weighted := NewWeightedGraph(6)
+	for _, edge := range edges {
+		weighted.AddUndirectedEdge(edge.From, edge.To, float64(edge.Weight))
+	}
+
+	fmt.Println("=== EXAMPLE 1: Frames per Algorithm ===")
+	bfsFrames, bfsErr := BFSFrames(graph, 0, filepath.Join(dir, "bfs"))
+	dfsFrames, dfsErr := DFSFrames(graph, 0, filepath.Join(dir, "dfs"))
+	result, dijkstraFrames, dijkstraErr := DijkstraFrames(weighted, 0, filepath.Join(dir, "dijkstra"))
+	mst, total, kruskalFrames, kruskalErr := KruskalFrames(6, edges, filepath.Join(dir, "kruskal"))
+	if err := errors.Join(bfsErr, dfsErr, dijkstraErr, kruskalErr); err != nil {
+		fmt.Printf("Writing frames failed: %v\n", err)
+		return
+	}
+	_, expectedTotal := KruskalMST(6, append([]Edge(nil), edges...))
+	fmt.Printf("BFS:      %2d frames\n", bfsFrames)
+	fmt.Printf("DFS:      %2d frames\n", dfsFrames)
+	fmt.Printf("Dijkstra: %2d frames, distances match DijkstraHeap: %v\n", dijkstraFrames,
+		fmt.Sprint(result.distances) == fmt.Sprint(DijkstraHeap(weighted, 0).distances))
+	fmt.Printf("Kruskal:  %2d frames, %d edges of total weight %d (KruskalMST: %d)\n", kruskalFrames, len(mst), total, expectedTotal)
+	fmt.Println()
+
+	fmt.Println("=== EXAMPLE 2: One Dijkstra Frame ===")
+	files, _ := filepath.Glob(filepath.Join(dir, "dijkstra", "*.dot"))
+	fmt.Printf("dijkstra/%s:\n", filepath.Base(files[2]))
+	frame, _ := os.ReadFile(files[2])
+	fmt.Print(string(frame))
+	fmt.Println()
+
+	fmt.Println("Settled vertices are green, the frontier yellow and the vertex being")
+	fmt.Println("processed red; tree edges are green and edges changed in that step blue.")
+	fmt.Printf("Render a directory with: %s\n", `for f in *.dot; do dot -Tpng "$f" -o "${f%.dot}.png"; done`)
+	fmt.Println()
+}
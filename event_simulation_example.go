@@ -0,0 +1,269 @@
+package main
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// ================================
+// DISCRETE-EVENT SIMULATION
+// ================================
+
+// SimEvent is an action scheduled to run at a point in simulated time
+type SimEvent struct {
+	time   float64 // when the event fires
+	seq    int     // insertion order, breaks ties between simultaneous events
+	action func()
+	index  int // index in the heap
+}
+
+// EventQueue implements a min-heap of events ordered by time
+type EventQueue []*SimEvent
+
+func (eq EventQueue) Len() int { return len(eq) }
+
+func (eq EventQueue) Less(i, j int) bool {
+	if eq[i].time != eq[j].time {
+		return eq[i].time < eq[j].time
+	}
+	return eq[i].seq < eq[j].seq
+}
+
+func (eq EventQueue) Swap(i, j int) {
+	eq[i], eq[j] = eq[j], eq[i]
+	eq[i].index = i
+	eq[j].index = j
+}
+
+func (eq *EventQueue) Push(x interface{}) {
+	event := x.(*SimEvent)
+	event.index = len(*eq)
+	*eq = append(*eq, event)
+}
+
+func (eq *EventQueue) Pop() interface{} {
+	old := *eq
+	n := len(old)
+	event := old[n-1]
+	old[n-1] = nil
+	event.index = -1
+	*eq = old[0 : n-1]
+	return event
+}
+
+// Simulator runs events in time order; events may schedule further events
+type Simulator struct {
+	now       float64
+	queue     EventQueue
+	nextSeq   int
+	processed int // events executed so far
+	peakQueue int // largest number of pending events seen
+}
+
+// NewSimulator creates a simulator with the clock at zero
+func NewSimulator() *Simulator {
+	return &Simulator{queue: make(EventQueue, 0)}
+}
+
+// Now returns the current simulated time
+func (s *Simulator) Now() float64 {
+	return s.now
+}
+
+// Schedule runs action after delay units of simulated time
+func (s *Simulator) Schedule(delay float64, action func()) {
+	heap.Push(&s.queue, &SimEvent{time: s.now + delay, seq: s.nextSeq, action: action})
+	s.nextSeq++
+	if s.queue.Len() > s.peakQueue {
+		s.peakQueue = s.queue.Len()
+	}
+}
+
+// Run processes events until the queue is empty
+// Time Complexity: O(N log N) for N events
+func (s *Simulator) Run() {
+	for s.queue.Len() > 0 {
+		event := heap.Pop(&s.queue).(*SimEvent)
+		s.now = event.time
+		s.processed++
+		event.action()
+	}
+}
+
+// ================================
+// NETWORK PACKET SIMULATION
+// ================================
+
+// TrafficFlow describes packets sent from Source to Destination.
+// Packets leave the source as a Poisson process with the given mean interval.
+type TrafficFlow struct {
+	Source       string
+	Destination  string
+	Packets      int
+	MeanInterval float64 // mean time between packets in ms
+}
+
+// FlowStats summarizes the simulated latency of one flow
+type FlowStats struct {
+	Flow          TrafficFlow
+	Route         []string
+	StaticLatency float64 // Dijkstra latency plus transmit time, no queuing
+	AvgLatency    float64
+	MaxLatency    float64
+	AvgQueueDelay float64
+}
+
+// linkLatency returns the propagation latency of the link u -> v
+func (nr *NetworkRouter) linkLatency(u, v int) float64 {
+	for _, edge := range nr.links.Graph().adjList[u] {
+		if edge.to == v {
+			return edge.weight
+		}
+	}
+	return 0
+}
+
+// SimulateTraffic sends every flow along its Dijkstra route. Each link sends
+// one packet at a time taking transmitTime ms, so packets that arrive while
+// a link is busy wait in a FIFO queue before being sent.
+func (nr *NetworkRouter) SimulateTraffic(flows []TrafficFlow, transmitTime float64, rng *rand.Rand) ([]FlowStats, *Simulator, error) {
+	sim := NewSimulator()
+	linkFreeAt := make(map[[2]int]float64)
+	stats := make([]FlowStats, len(flows))
+	totalLatency := make([]float64, len(flows))
+	totalQueueDelay := make([]float64, len(flows))
+
+	for i, flow := range flows {
+		source, sourceFound := nr.links.Index(flow.Source)
+		destination, destFound := nr.links.Index(flow.Destination)
+		if !sourceFound || !destFound {
+			return nil, nil, fmt.Errorf("flow %s -> %s: network node not found", flow.Source, flow.Destination)
+		}
+
+		latency, path := nr.links.Graph().DijkstraWithPath(source, destination)
+		if path == nil {
+			return nil, nil, fmt.Errorf("flow %s -> %s: no route", flow.Source, flow.Destination)
+		}
+
+		stat := &stats[i]
+		stat.Flow = flow
+		stat.StaticLatency = latency + float64(len(path)-1)*transmitTime
+		for _, v := range path {
+			stat.Route = append(stat.Route, nr.links.Key(v))
+		}
+
+		flowIndex := i
+
+		// arrive handles a packet reaching hop number hop of the path
+		var arrive func(hop int, sentAt, queued float64)
+		arrive = func(hop int, sentAt, queued float64) {
+			if hop == len(path)-1 {
+				elapsed := sim.Now() - sentAt
+				totalLatency[flowIndex] += elapsed
+				totalQueueDelay[flowIndex] += queued
+				stat.MaxLatency = math.Max(stat.MaxLatency, elapsed)
+				return
+			}
+
+			u, v := path[hop], path[hop+1]
+			link := [2]int{u, v}
+			start := math.Max(sim.Now(), linkFreeAt[link])
+			linkFreeAt[link] = start + transmitTime
+			wait := start - sim.Now()
+
+			sim.Schedule(wait+transmitTime+nr.linkLatency(u, v), func() {
+				arrive(hop+1, sentAt, queued+wait)
+			})
+		}
+
+		// Inject packets at their departure times
+		departure := 0.0
+		for p := 0; p < flow.Packets; p++ {
+			departure += rng.ExpFloat64() * flow.MeanInterval
+			sentAt := departure
+			sim.Schedule(sentAt, func() { arrive(0, sentAt, 0) })
+		}
+	}
+
+	sim.Run()
+
+	for i := range stats {
+		if packets := stats[i].Flow.Packets; packets > 0 {
+			stats[i].AvgLatency = totalLatency[i] / float64(packets)
+			stats[i].AvgQueueDelay = totalQueueDelay[i] / float64(packets)
+		}
+	}
+	return stats, sim, nil
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoEventSimulation compares simulated packet latency with static Dijkstra latency
+func DemoEventSimulation() {
+	fmt.Println("=== DISCRETE-EVENT NETWORK SIMULATION ===")
+	fmt.Println()
+
+	// Example 1: Events run in time order regardless of scheduling order
+	fmt.Println("=== EXAMPLE 1: Event Ordering ===")
+	sim := NewSimulator()
+	for _, delay := range []float64{3, 1, 2, 1} {
+		d := delay
+		sim.Schedule(d, func() { fmt.Printf("  t=%.0f: event scheduled with delay %.0f\n", sim.Now(), d) })
+	}
+	sim.Run()
+	fmt.Println()
+
+	// Example 2: Queuing delay on the router network
+	fmt.Println("=== EXAMPLE 2: Packet Latency Under Load ===")
+	nodes := []string{"Router-A", "Router-B", "Router-C", "Router-D", "Server", "Client", "Backup"}
+	network := NewNetworkRouter(nodes)
+	network.AddConnection("Client", "Router-A", 5.0)
+	network.AddConnection("Router-A", "Router-B", 10.0)
+	network.AddConnection("Router-A", "Router-C", 15.0)
+	network.AddConnection("Router-B", "Router-D", 12.0)
+	network.AddConnection("Router-C", "Router-D", 8.0)
+	network.AddConnection("Router-D", "Server", 6.0)
+	network.AddConnection("Router-B", "Server", 20.0)
+	network.AddConnection("Backup", "Router-B", 4.0)
+
+	const transmitTime = 1.0 // ms to put one packet on a link
+
+	for _, interval := range []float64{10, 2, 1.1} {
+		flows := []TrafficFlow{
+			{"Client", "Server", 2000, interval},
+			{"Backup", "Server", 2000, interval},
+		}
+
+		stats, run, err := network.SimulateTraffic(flows, transmitTime, rand.New(rand.NewSource(7)))
+		if err != nil {
+			fmt.Printf("Simulation error: %v\n", err)
+			return
+		}
+
+		if interval == 10 {
+			for _, s := range stats {
+				fmt.Printf("Route %s -> %s: %v\n", s.Flow.Source, s.Flow.Destination, s.Route)
+			}
+		}
+		fmt.Printf("Mean interval %.1f ms per flow (%d events, peak queue %d):\n",
+			interval, run.processed, run.peakQueue)
+		for _, s := range stats {
+			fmt.Printf("  %-6s -> %s: static %.1f ms, avg %.1f ms, max %.1f ms, avg queuing %.2f ms\n",
+				s.Flow.Source, s.Flow.Destination, s.StaticLatency, s.AvgLatency, s.MaxLatency, s.AvgQueueDelay)
+		}
+		fmt.Println()
+	}
+
+	fmt.Println("Observations:")
+	fmt.Println("- At light load the simulated latency matches the static Dijkstra latency")
+	fmt.Println("- Both flows share Router-B -> Router-D -> Server, so as load rises the")
+	fmt.Println("  links approach saturation and queuing delay dominates")
+	fmt.Println("- At 1.1 ms per flow the shared links receive more than one packet")
+	fmt.Println("  per transmit time, so the queue grows for the whole run")
+	fmt.Println("- Dijkstra alone cannot see this: edge weights ignore congestion")
+	fmt.Println()
+}
@@ -0,0 +1,354 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ================================
+// TOKENIZER
+// ================================
+
+// negToken is unary minus; it gets its own token so postfix stays unambiguous
+const negToken = "neg"
+
+// exprOperator describes a binary or unary operator
+type exprOperator struct {
+	precedence int
+	rightAssoc bool
+	unary      bool
+}
+
+var exprOperators = map[string]exprOperator{
+	"+":      {precedence: 1},
+	"-":      {precedence: 1},
+	"*":      {precedence: 2},
+	"/":      {precedence: 2},
+	negToken: {precedence: 3, rightAssoc: true, unary: true},
+	"^":      {precedence: 4, rightAssoc: true}, // -2^2 = -(2^2)
+}
+
+// tokenizeExpression splits an infix expression into numbers, operators
+// and parentheses. A '-' at the start, after an operator or after '(' is
+// unary minus.
+func tokenizeExpression(expr string) ([]string, error) {
+	tokens := []string{}
+	expectOperand := true
+	for i := 0; i < len(expr); {
+		char := rune(expr[i])
+		switch {
+		case unicode.IsSpace(char):
+			i++
+		case unicode.IsDigit(char) || char == '.':
+			start := i
+			for i < len(expr) && (unicode.IsDigit(rune(expr[i])) || expr[i] == '.') {
+				i++
+			}
+			if !expectOperand {
+				return nil, fmt.Errorf("unexpected number %q at position %d", expr[start:i], start)
+			}
+			tokens = append(tokens, expr[start:i])
+			expectOperand = false
+		case char == '-' && expectOperand:
+			tokens = append(tokens, negToken)
+			i++
+		case strings.ContainsRune("+-*/^", char):
+			if expectOperand {
+				return nil, fmt.Errorf("unexpected operator %q at position %d", char, i)
+			}
+			tokens = append(tokens, string(char))
+			expectOperand = true
+			i++
+		case char == '(':
+			tokens = append(tokens, "(")
+			i++
+		case char == ')':
+			tokens = append(tokens, ")")
+			expectOperand = false
+			i++
+		default:
+			return nil, fmt.Errorf("unexpected character %q at position %d", char, i)
+		}
+	}
+	return tokens, nil
+}
+
+// ================================
+// SHUNTING-YARD: INFIX TO POSTFIX
+// ================================
+
+// InfixToPostfix converts an infix expression to postfix (reverse Polish)
+// tokens with Dijkstra's shunting-yard algorithm: operands go straight to
+// the output, operators wait on a stack until an operator of lower
+// precedence (or a closing parenthesis) forces them out.
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+func InfixToPostfix(expr string) ([]string, error) {
+	tokens, err := tokenizeExpression(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	output := []string{}
+	stack := []string{}
+	for _, token := range tokens {
+		switch {
+		case token == "(":
+			stack = append(stack, token)
+		case token == ")":
+			for len(stack) > 0 && stack[len(stack)-1] != "(" {
+				output = append(output, stack[len(stack)-1])
+				stack = stack[:len(stack)-1]
+			}
+			if len(stack) == 0 {
+				return nil, fmt.Errorf("unmatched ')' in %q", expr)
+			}
+			stack = stack[:len(stack)-1] // discard "("
+		case exprOperators[token].precedence > 0:
+			op := exprOperators[token]
+			for len(stack) > 0 {
+				top, isOperator := exprOperators[stack[len(stack)-1]]
+				// A unary operator has no left operand, so it never pops anything
+				if !isOperator || op.unary || top.precedence < op.precedence ||
+					(top.precedence == op.precedence && op.rightAssoc) {
+					break
+				}
+				output = append(output, stack[len(stack)-1])
+				stack = stack[:len(stack)-1]
+			}
+			stack = append(stack, token)
+		default:
+			output = append(output, token)
+		}
+	}
+
+	for len(stack) > 0 {
+		top := stack[len(stack)-1]
+		if top == "(" {
+			return nil, fmt.Errorf("unmatched '(' in %q", expr)
+		}
+		output = append(output, top)
+		stack = stack[:len(stack)-1]
+	}
+	return output, nil
+}
+
+// applyOperator computes a op b (or op b for unary operators)
+func applyOperator(op string, a, b float64) (float64, error) {
+	switch op {
+	case "+":
+		return a + b, nil
+	case "-":
+		return a - b, nil
+	case "*":
+		return a * b, nil
+	case "/":
+		if b == 0 {
+			return 0, fmt.Errorf("division by zero")
+		}
+		return a / b, nil
+	case "^":
+		return math.Pow(a, b), nil
+	case negToken:
+		return -b, nil
+	}
+	return 0, fmt.Errorf("unknown operator %q", op)
+}
+
+// EvaluatePostfix evaluates postfix tokens with an operand stack
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+func EvaluatePostfix(postfix []string) (float64, error) {
+	stack := []float64{}
+	for _, token := range postfix {
+		op, isOperator := exprOperators[token]
+		if !isOperator {
+			value, err := strconv.ParseFloat(token, 64)
+			if err != nil {
+				return 0, fmt.Errorf("invalid number %q", token)
+			}
+			stack = append(stack, value)
+			continue
+		}
+
+		arity := 2
+		if op.unary {
+			arity = 1
+		}
+		if len(stack) < arity {
+			return 0, fmt.Errorf("operator %q is missing an operand", token)
+		}
+		a, b := 0.0, stack[len(stack)-1]
+		if arity == 2 {
+			a = stack[len(stack)-2]
+		}
+		stack = stack[:len(stack)-arity]
+
+		result, err := applyOperator(token, a, b)
+		if err != nil {
+			return 0, err
+		}
+		stack = append(stack, result)
+	}
+	if len(stack) != 1 {
+		return 0, fmt.Errorf("malformed expression: %d values left on the stack", len(stack))
+	}
+	return stack[0], nil
+}
+
+// ================================
+// EXPRESSION TREE
+// ================================
+
+// ExprNode is a node of an expression tree: leaves are numbers, inner
+// nodes are operators. Unary minus has only a Right child.
+type ExprNode struct {
+	Token string
+	Left  *ExprNode
+	Right *ExprNode
+}
+
+// BuildExpressionTree builds a tree from postfix tokens: an operand becomes
+// a leaf, an operator pops its operands off the stack as children
+// Time Complexity: O(n)
+func BuildExpressionTree(postfix []string) (*ExprNode, error) {
+	stack := []*ExprNode{}
+	for _, token := range postfix {
+		node := &ExprNode{Token: token}
+		if op, isOperator := exprOperators[token]; isOperator {
+			arity := 2
+			if op.unary {
+				arity = 1
+			}
+			if len(stack) < arity {
+				return nil, fmt.Errorf("operator %q is missing an operand", token)
+			}
+			node.Right = stack[len(stack)-1]
+			if arity == 2 {
+				node.Left = stack[len(stack)-2]
+			}
+			stack = stack[:len(stack)-arity]
+		}
+		stack = append(stack, node)
+	}
+	if len(stack) != 1 {
+		return nil, fmt.Errorf("malformed expression: %d subtrees left on the stack", len(stack))
+	}
+	return stack[0], nil
+}
+
+// Infix returns the expression by inorder traversal, parenthesizing every
+// operator so the tree's structure is explicit
+func (n *ExprNode) Infix() string {
+	if n.Left == nil && n.Right == nil {
+		return n.Token
+	}
+	if n.Token == negToken {
+		return "(-" + n.Right.Infix() + ")"
+	}
+	return "(" + n.Left.Infix() + " " + n.Token + " " + n.Right.Infix() + ")"
+}
+
+// Evaluate computes the value of the tree by postorder traversal
+func (n *ExprNode) Evaluate() (float64, error) {
+	if n.Left == nil && n.Right == nil {
+		return strconv.ParseFloat(n.Token, 64)
+	}
+	var a float64
+	if n.Left != nil {
+		left, err := n.Left.Evaluate()
+		if err != nil {
+			return 0, err
+		}
+		a = left
+	}
+	b, err := n.Right.Evaluate()
+	if err != nil {
+		return 0, err
+	}
+	return applyOperator(n.Token, a, b)
+}
+
+// ToMorrisTree copies the tree into MorrisTreeNodes so the generic tree
+// traversals can walk it. Each Val is an index into the returned tokens.
+func (n *ExprNode) ToMorrisTree() (*MorrisTreeNode, []string) {
+	tokens := []string{}
+	var convert func(node *ExprNode) *MorrisTreeNode
+	convert = func(node *ExprNode) *MorrisTreeNode {
+		if node == nil {
+			return nil
+		}
+		copied := NewMorrisTreeNode(len(tokens))
+		tokens = append(tokens, node.Token)
+		copied.Left = convert(node.Left)
+		copied.Right = convert(node.Right)
+		return copied
+	}
+	return convert(n), tokens
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoExpressionEvaluation demonstrates shunting-yard, postfix evaluation
+// and expression trees
+func DemoExpressionEvaluation() {
+	fmt.Println("=== EXPRESSION EVALUATION ===")
+	fmt.Println()
+
+	// Example 1: Infix to postfix and evaluation
+	fmt.Println("=== EXAMPLE 1: Shunting-Yard and Postfix Evaluation ===")
+	for _, expr := range []string{
+		"3 + 4 * 2 / (1 - 5) ^ 2 ^ 3",
+		"(1 + 2) * (3 + 4)",
+		"2 ^ 3 ^ 2",
+		"-2 ^ 2 + -(3 - 5)",
+		"10 / 4 - 1.5",
+	} {
+		postfix, _ := InfixToPostfix(expr)
+		value, _ := EvaluatePostfix(postfix)
+		fmt.Printf("%-28s postfix: %-36s = %g\n", expr, strings.Join(postfix, " "), value)
+	}
+	fmt.Println()
+
+	// Example 2: Expression tree
+	fmt.Println("=== EXAMPLE 2: Expression Tree ===")
+	postfix, _ := InfixToPostfix("(8 - 3) * (2 + 4 / 2)")
+	tree, _ := BuildExpressionTree(postfix)
+	value, _ := tree.Evaluate()
+	fmt.Printf("Inorder with parentheses: %s = %g\n", tree.Infix(), value)
+
+	morrisTree, tokens := tree.ToMorrisTree()
+	var inorder, preorder []string
+	MorrisInorderVisit(morrisTree, func(node *MorrisTreeNode) bool {
+		inorder = append(inorder, tokens[node.Val])
+		return true
+	})
+	MorrisPreorderVisit(morrisTree, func(node *MorrisTreeNode) bool {
+		preorder = append(preorder, tokens[node.Val])
+		return true
+	})
+	fmt.Printf("Morris inorder (infix, no parentheses): %s\n", strings.Join(inorder, " "))
+	fmt.Printf("Morris preorder (prefix/Polish):        %s\n", strings.Join(preorder, " "))
+	fmt.Printf("Postfix (the tree's postorder):         %s\n", strings.Join(postfix, " "))
+	fmt.Println()
+
+	// Example 3: Errors
+	fmt.Println("=== EXAMPLE 3: Malformed Input ===")
+	for _, expr := range []string{"(1 + 2", "1 + 2)", "1 + * 2", "4 / (2 - 2)", "2 $ 3", "3 4"} {
+		postfix, err := InfixToPostfix(expr)
+		if err == nil {
+			_, err = EvaluatePostfix(postfix)
+		}
+		fmt.Printf("%-12s -> %v\n", expr, err)
+	}
+	fmt.Println()
+
+	fmt.Println("Inorder needs parentheses to be unambiguous; preorder and postorder")
+	fmt.Println("never do, which is why stack machines and compilers use postfix.")
+	fmt.Println()
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"fmt"
+)
+
+// ================================
+// FLOOD FILL AND COMPONENT LABELING
+// ================================
+
+// Connectivity selects which neighboring cells count as adjacent on a grid
+type Connectivity int
+
+const (
+	FourConnected  Connectivity = 4 // up, down, left, right
+	EightConnected Connectivity = 8 // also the four diagonals
+)
+
+// offsets returns the neighbor directions for the connectivity
+func (conn Connectivity) offsets() [][]int {
+	if conn == EightConnected {
+		return [][]int{{-1, 0}, {1, 0}, {0, -1}, {0, 1}, {-1, -1}, {-1, 1}, {1, -1}, {1, 1}}
+	}
+	return [][]int{{-1, 0}, {1, 0}, {0, -1}, {0, 1}}
+}
+
+// FloodFill recolors the 4-connected region of equal cells containing (r, c)
+// and returns how many cells changed
+// Time Complexity: O(rows * cols)
+// Space Complexity: O(rows * cols) for the queue
+func FloodFill(grid [][]int, r, c, newColor int) int {
+	return FloodFillWith(grid, r, c, newColor, FourConnected)
+}
+
+// FloodFillWith is FloodFill with a selectable connectivity
+func FloodFillWith(grid [][]int, r, c, newColor int, conn Connectivity) int {
+	if r < 0 || r >= len(grid) || c < 0 || c >= len(grid[r]) {
+		return 0
+	}
+
+	oldColor := grid[r][c]
+	if oldColor == newColor {
+		return 0
+	}
+
+	// Iterative BFS: recursion would overflow on large regions
+	grid[r][c] = newColor
+	queue := Queue[[2]int]{}
+	queue.Enqueue([2]int{r, c})
+	changed := 1
+
+	for queue.Len() > 0 {
+		cell, _ := queue.Dequeue()
+
+		for _, dir := range conn.offsets() {
+			nr, nc := cell[0]+dir[0], cell[1]+dir[1]
+			if nr >= 0 && nr < len(grid) && nc >= 0 && nc < len(grid[nr]) && grid[nr][nc] == oldColor {
+				grid[nr][nc] = newColor
+				changed++
+				queue.Enqueue([2]int{nr, nc})
+			}
+		}
+	}
+
+	return changed
+}
+
+// LabelComponents assigns the labels 1..k to connected regions of equal
+// non-zero cells; zero cells are background and keep label 0.
+// Returns the label grid and the number of components k.
+// Time Complexity: O(rows * cols)
+// Space Complexity: O(rows * cols)
+func LabelComponents(grid [][]int, conn Connectivity) ([][]int, int) {
+	labels := make([][]int, len(grid))
+	for r := range grid {
+		labels[r] = make([]int, len(grid[r]))
+	}
+
+	count := 0
+	for r := range grid {
+		for c := range grid[r] {
+			if grid[r][c] == 0 || labels[r][c] != 0 {
+				continue
+			}
+
+			count++
+			labels[r][c] = count
+			queue := Queue[[2]int]{}
+			queue.Enqueue([2]int{r, c})
+
+			for queue.Len() > 0 {
+				cell, _ := queue.Dequeue()
+
+				for _, dir := range conn.offsets() {
+					nr, nc := cell[0]+dir[0], cell[1]+dir[1]
+					if nr >= 0 && nr < len(grid) && nc >= 0 && nc < len(grid[nr]) &&
+						labels[nr][nc] == 0 && grid[nr][nc] == grid[r][c] {
+						labels[nr][nc] = count
+						queue.Enqueue([2]int{nr, nc})
+					}
+				}
+			}
+		}
+	}
+
+	return labels, count
+}
+
+// byteGridToInts converts a '0'/'1' grid (as used by NumberOfIslands) to ints
+func byteGridToInts(grid [][]byte) [][]int {
+	result := make([][]int, len(grid))
+	for r, row := range grid {
+		result[r] = make([]int, len(row))
+		for c, cell := range row {
+			if cell == '1' {
+				result[r][c] = 1
+			}
+		}
+	}
+	return result
+}
+
+// printIntGrid prints a grid with one character per cell
+func printIntGrid(grid [][]int) {
+	for _, row := range grid {
+		for _, cell := range row {
+			if cell == 0 {
+				fmt.Print(". ")
+			} else {
+				fmt.Printf("%d ", cell)
+			}
+		}
+		fmt.Println()
+	}
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoFloodFill demonstrates flood fill and component labeling
+func DemoFloodFill() {
+	fmt.Println("=== FLOOD FILL AND COMPONENT LABELING ===")
+	fmt.Println()
+
+	// Example 1: Paint bucket
+	fmt.Println("=== EXAMPLE 1: Paint Bucket ===")
+	image := [][]int{
+		{1, 1, 1, 0},
+		{1, 1, 0, 0},
+		{1, 0, 1, 1},
+		{0, 0, 1, 2},
+	}
+	fmt.Println("Image:")
+	printIntGrid(image)
+
+	changed := FloodFill(image, 0, 0, 5)
+	fmt.Printf("\nFloodFill(0, 0, 5) changed %d cells:\n", changed)
+	printIntGrid(image)
+	fmt.Println()
+
+	// Example 2: Islands with 4- vs 8-connectivity
+	fmt.Println("=== EXAMPLE 2: Labeling Islands ===")
+	islands := [][]byte{
+		{'1', '1', '0', '0', '1'},
+		{'1', '0', '0', '1', '0'},
+		{'0', '0', '1', '0', '0'},
+		{'0', '1', '0', '0', '1'},
+	}
+	grid := byteGridToInts(islands)
+	fmt.Println("Grid:")
+	printIntGrid(grid)
+
+	labels4, count4 := LabelComponents(grid, FourConnected)
+	fmt.Printf("\n4-connected: %d components (NumberOfIslands agrees: %d)\n", count4, NumberOfIslands(islands))
+	printIntGrid(labels4)
+
+	labels8, count8 := LabelComponents(grid, EightConnected)
+	fmt.Printf("\n8-connected: %d components (diagonal neighbors merge)\n", count8)
+	printIntGrid(labels8)
+	fmt.Println()
+}
@@ -0,0 +1,402 @@
+package main
+
+import (
+	"fmt"
+)
+
+// ================================
+// UNIFIED GRAPH INTERFACE
+// ================================
+
+// AdjacencyGraph is the common view shared by Graph, DirectedGraph and
+// WeightedGraph so traversal algorithms can run on any of them
+type AdjacencyGraph interface {
+	Vertices() []int       // all vertex ids in ascending order
+	Neighbors(v int) []int // vertices reachable from v by one edge
+}
+
+// DirectedAdjacencyGraph is implemented by graphs that know whether their
+// edges are directed (needed to pick the right cycle check)
+type DirectedAdjacencyGraph interface {
+	AdjacencyGraph
+	IsDirected() bool
+}
+
+// WeightedAdjacencyGraph is implemented by graphs that carry edge weights
+type WeightedAdjacencyGraph interface {
+	AdjacencyGraph
+	WeightedNeighbors(v int) []WeightedEdge
+}
+
+// vertexRange returns the ids 0..n-1
+func vertexRange(n int) []int {
+	vertices := make([]int, n)
+	for i := range vertices {
+		vertices[i] = i
+	}
+	return vertices
+}
+
+// Vertices returns all vertex ids of the graph
+func (g *Graph) Vertices() []int {
+	return vertexRange(g.vertices)
+}
+
+// Neighbors returns the adjacency list of v
+func (g *Graph) Neighbors(v int) []int {
+	return g.adjList[v]
+}
+
+// IsDirected reports whether the graph was built with AddDirectedEdge
+func (g *Graph) IsDirected() bool {
+	return g.kind == graphDirected
+}
+
+// Vertices returns all vertex ids of the graph
+func (g *DirectedGraph) Vertices() []int {
+	return vertexRange(g.vertices)
+}
+
+// Neighbors returns the adjacency list of v
+func (g *DirectedGraph) Neighbors(v int) []int {
+	return g.adjList[v]
+}
+
+// IsDirected always returns true for a DirectedGraph
+func (g *DirectedGraph) IsDirected() bool {
+	return true
+}
+
+// Vertices returns all vertex ids of the graph
+func (g *WeightedGraph) Vertices() []int {
+	return vertexRange(g.vertices)
+}
+
+// Neighbors returns the destinations of all edges leaving v (weights ignored)
+func (g *WeightedGraph) Neighbors(v int) []int {
+	neighbors := make([]int, len(g.adjList[v]))
+	for i, edge := range g.adjList[v] {
+		neighbors[i] = edge.to
+	}
+	return neighbors
+}
+
+// WeightedNeighbors returns all edges leaving v
+func (g *WeightedGraph) WeightedNeighbors(v int) []WeightedEdge {
+	return g.adjList[v]
+}
+
+// IsDirected reports whether any edge was added with AddEdge. A graph
+// built only with AddUndirectedEdge is undirected, even though each of its
+// edges is stored as two arcs.
+func (g *WeightedGraph) IsDirected() bool {
+	return !g.undirected
+}
+
+// isDirectedGraph reports whether g should be treated as directed
+func isDirectedGraph(g AdjacencyGraph) bool {
+	if dg, ok := g.(DirectedAdjacencyGraph); ok {
+		return dg.IsDirected()
+	}
+	return false
+}
+
+// ================================
+// GENERIC TRAVERSALS
+// ================================
+
+// TraverseDFS returns the depth-first visiting order starting from start
+// Time Complexity: O(V + E)
+// Space Complexity: O(V)
+func TraverseDFS(g AdjacencyGraph, start int) []int {
+	visited := make(map[int]bool)
+	order := []int{}
+	traverseDFSUtil(g, start, visited, &order)
+	return order
+}
+
+// traverseDFSUtil is a recursive utility function for TraverseDFS
+func traverseDFSUtil(g AdjacencyGraph, vertex int, visited map[int]bool, order *[]int) {
+	visited[vertex] = true
+	*order = append(*order, vertex)
+
+	for _, neighbor := range g.Neighbors(vertex) {
+		if !visited[neighbor] {
+			traverseDFSUtil(g, neighbor, visited, order)
+		}
+	}
+}
+
+// TraverseBFS returns the breadth-first visiting order starting from start
+// Time Complexity: O(V + E)
+// Space Complexity: O(V)
+func TraverseBFS(g AdjacencyGraph, start int) []int {
+	visited := map[int]bool{start: true}
+	queue := Queue[int]{}
+	queue.Enqueue(start)
+	order := []int{}
+
+	for queue.Len() > 0 {
+		vertex, _ := queue.Dequeue()
+		order = append(order, vertex)
+
+		for _, neighbor := range g.Neighbors(vertex) {
+			if !visited[neighbor] {
+				visited[neighbor] = true
+				queue.Enqueue(neighbor)
+			}
+		}
+	}
+
+	return order
+}
+
+// ================================
+// GENERIC CYCLE DETECTION
+// ================================
+
+// GraphHasCycle detects a cycle in any graph. Directed graphs use the
+// recursion-stack check; undirected graphs track the parent edge instead,
+// so a single undirected edge is not mistaken for a cycle.
+func GraphHasCycle(g AdjacencyGraph) bool {
+	visited := make(map[int]bool)
+
+	if isDirectedGraph(g) {
+		recStack := make(map[int]bool)
+		for _, vertex := range g.Vertices() {
+			if !visited[vertex] && directedCycleUtil(g, vertex, visited, recStack) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, vertex := range g.Vertices() {
+		if !visited[vertex] && undirectedCycleUtil(g, vertex, -1, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+func directedCycleUtil(g AdjacencyGraph, vertex int, visited, recStack map[int]bool) bool {
+	visited[vertex] = true
+	recStack[vertex] = true
+
+	for _, neighbor := range g.Neighbors(vertex) {
+		if !visited[neighbor] && directedCycleUtil(g, neighbor, visited, recStack) {
+			return true
+		} else if recStack[neighbor] {
+			return true
+		}
+	}
+
+	recStack[vertex] = false
+	return false
+}
+
+func undirectedCycleUtil(g AdjacencyGraph, vertex, parent int, visited map[int]bool) bool {
+	visited[vertex] = true
+	skippedParent := false
+
+	for _, neighbor := range g.Neighbors(vertex) {
+		// Skip the edge we arrived on exactly once (parallel edges still count)
+		if neighbor == parent && !skippedParent {
+			skippedParent = true
+			continue
+		}
+		if visited[neighbor] {
+			return true
+		}
+		if undirectedCycleUtil(g, neighbor, vertex, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// ================================
+// GENERIC TOPOLOGICAL SORT
+// ================================
+
+// TopologicalOrder returns a topological ordering of g using Kahn's algorithm,
+// or an error if the graph is undirected or contains a cycle
+// Time Complexity: O(V + E)
+// Space Complexity: O(V)
+func TopologicalOrder(g AdjacencyGraph) ([]int, error) {
+	vertices := g.Vertices()
+	if !isDirectedGraph(g) {
+		// Every undirected edge would look like a two-vertex cycle
+		for _, vertex := range vertices {
+			if len(g.Neighbors(vertex)) > 0 {
+				return nil, fmt.Errorf("graph is undirected: topological sort not possible")
+			}
+		}
+	}
+	inDegree := make(map[int]int, len(vertices))
+	for _, vertex := range vertices {
+		for _, neighbor := range g.Neighbors(vertex) {
+			inDegree[neighbor]++
+		}
+	}
+
+	queue := Queue[int]{}
+	for _, vertex := range vertices {
+		if inDegree[vertex] == 0 {
+			queue.Enqueue(vertex)
+		}
+	}
+
+	result := []int{}
+	for queue.Len() > 0 {
+		vertex, _ := queue.Dequeue()
+		result = append(result, vertex)
+
+		for _, neighbor := range g.Neighbors(vertex) {
+			inDegree[neighbor]--
+			if inDegree[neighbor] == 0 {
+				queue.Enqueue(neighbor)
+			}
+		}
+	}
+
+	if len(result) != len(vertices) {
+		return nil, fmt.Errorf("graph contains a cycle: topological sort not possible")
+	}
+	return result, nil
+}
+
+// ================================
+// ADAPTERS BETWEEN GRAPH TYPES
+// ================================
+
+// ToWeightedGraph copies any graph into a WeightedGraph, asking weight for the
+// weight of every arc u -> v. The source graph's vertex ids must be 0..n-1.
+// The copy is undirected if g is.
+func ToWeightedGraph(g AdjacencyGraph, weight func(u, v int) float64) *WeightedGraph {
+	vertices := g.Vertices()
+	weighted := NewWeightedGraph(len(vertices))
+	for _, u := range vertices {
+		for _, v := range g.Neighbors(u) {
+			weighted.addArc(u, v, weight(u, v))
+		}
+	}
+	weighted.undirected = !isDirectedGraph(g)
+	return weighted
+}
+
+// WithUnitWeights returns a WeightedGraph where every edge costs 1, so the
+// graph can be fed to Dijkstra and compared against BFS on the same topology
+func (g *Graph) WithUnitWeights() *WeightedGraph {
+	return ToWeightedGraph(g, func(u, v int) float64 { return 1 })
+}
+
+// Unweighted returns a Graph with the same arcs and the weights dropped.
+// A directed source gives a directed Graph with every arc copied as is, so
+// arcs 0->1 and 1->0 stay a 2-cycle. An undirected source (built only with
+// AddUndirectedEdge) already stores each edge as two arcs, which become
+// one undirected edge of the result.
+func (g *WeightedGraph) Unweighted() *Graph {
+	graph := NewGraph(g.vertices)
+	for u := 0; u < g.vertices; u++ {
+		for _, edge := range g.adjList[u] {
+			if g.undirected {
+				graph.setEdgeKind(graphUndirected)
+				graph.adjList[u] = append(graph.adjList[u], edge.to)
+			} else {
+				graph.AddDirectedEdge(u, edge.to)
+			}
+		}
+	}
+	return graph
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoUnifiedGraphInterface runs the same algorithms on all three graph types
+func DemoUnifiedGraphInterface() {
+	fmt.Println("=== UNIFIED GRAPH INTERFACE ===")
+	fmt.Println()
+
+	undirected := NewGraph(5)
+	undirected.AddEdge(0, 1)
+	undirected.AddEdge(0, 2)
+	undirected.AddEdge(1, 3)
+	undirected.AddEdge(2, 4)
+
+	directed := NewDirectedGraph(5)
+	directed.AddEdge(0, 1)
+	directed.AddEdge(0, 2)
+	directed.AddEdge(1, 3)
+	directed.AddEdge(2, 4)
+
+	weighted := NewWeightedGraph(5)
+	weighted.AddEdge(0, 1, 4.0)
+	weighted.AddEdge(0, 2, 1.0)
+	weighted.AddEdge(1, 3, 2.0)
+	weighted.AddEdge(2, 4, 7.0)
+
+	graphs := []struct {
+		name  string
+		graph AdjacencyGraph
+	}{
+		{"Graph (undirected)", undirected},
+		{"DirectedGraph", directed},
+		{"WeightedGraph", weighted},
+	}
+
+	for _, entry := range graphs {
+		fmt.Printf("%s:\n", entry.name)
+		fmt.Printf("  DFS from 0: %v\n", TraverseDFS(entry.graph, 0))
+		fmt.Printf("  BFS from 0: %v\n", TraverseBFS(entry.graph, 0))
+		fmt.Printf("  Has cycle:  %v\n", GraphHasCycle(entry.graph))
+
+		if order, err := TopologicalOrder(entry.graph); err != nil {
+			fmt.Printf("  Topological order: %v\n", err)
+		} else {
+			fmt.Printf("  Topological order: %v\n", order)
+		}
+		fmt.Println()
+	}
+}
+
+// DemoGraphAdapters runs unweighted and weighted algorithms on the same topology
+func DemoGraphAdapters() {
+	fmt.Println("=== GRAPH ADAPTERS ===")
+	fmt.Println()
+
+	// Unweighted graph -> Dijkstra with unit weights
+	fmt.Println("1. UNWEIGHTED GRAPH THROUGH DIJKSTRA")
+	graph := NewGraph(6)
+	graph.AddEdge(0, 1)
+	graph.AddEdge(0, 2)
+	graph.AddEdge(1, 3)
+	graph.AddEdge(2, 3)
+	graph.AddEdge(3, 4)
+	graph.AddEdge(4, 5)
+
+	weighted := graph.WithUnitWeights()
+	for target := 1; target < 6; target++ {
+		bfsHops := graph.BFSShortestPath(0, target)
+		dijkstraDistance, path := weighted.DijkstraWithPath(0, target)
+		fmt.Printf("  0 -> %d: BFS %d hops, Dijkstra %.0f via %v\n", target, bfsHops, dijkstraDistance, path)
+	}
+	fmt.Println()
+
+	// Weighted graph -> DFS/BFS ignoring weights
+	fmt.Println("2. WEIGHTED GRAPH THROUGH DFS/BFS")
+	roads := NewWeightedGraph(5)
+	roads.AddUndirectedEdge(0, 1, 7.5)
+	roads.AddUndirectedEdge(0, 2, 2.0)
+	roads.AddUndirectedEdge(2, 3, 4.0)
+	roads.AddUndirectedEdge(3, 4, 1.5)
+
+	plain := roads.Unweighted()
+	fmt.Printf("  Undirected after conversion: %v\n", !plain.IsDirected())
+	plain.DFS(0)
+	plain.BFS(0)
+	fmt.Printf("  Components: %v\n", plain.ConnectedComponents())
+	fmt.Printf("  Has cycle: %v\n\n", plain.HasCycleDFS())
+}
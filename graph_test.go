@@ -0,0 +1,277 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+)
+
+// graphCycleCase builds a graph and states whether it has a cycle
+type graphCycleCase struct {
+	name  string
+	build func() AdjacencyGraph
+	want  bool
+}
+
+func TestHasCycleUndirected(t *testing.T) {
+	for _, test := range []graphCycleCase{
+		{"single edge", func() AdjacencyGraph {
+			g := NewGraph(2)
+			g.AddEdge(0, 1)
+			return g
+		}, false},
+		{"tree", func() AdjacencyGraph {
+			g := NewGraph(5)
+			g.AddEdge(0, 1)
+			g.AddEdge(0, 2)
+			g.AddEdge(1, 3)
+			g.AddEdge(1, 4)
+			return g
+		}, false},
+		{"forest", func() AdjacencyGraph {
+			g := NewGraph(4)
+			g.AddEdge(0, 1)
+			g.AddEdge(2, 3)
+			return g
+		}, false},
+		{"triangle", func() AdjacencyGraph {
+			g := NewGraph(3)
+			g.AddEdge(0, 1)
+			g.AddEdge(1, 2)
+			g.AddEdge(2, 0)
+			return g
+		}, true},
+		{"cycle in second component", func() AdjacencyGraph {
+			g := NewGraph(6)
+			g.AddEdge(0, 1)
+			g.AddEdge(2, 3)
+			g.AddEdge(3, 4)
+			g.AddEdge(4, 5)
+			g.AddEdge(5, 2)
+			return g
+		}, true},
+		{"parallel edges", func() AdjacencyGraph {
+			g := NewGraph(2)
+			g.AddEdge(0, 1)
+			g.AddEdge(0, 1)
+			return g
+		}, true},
+		{"self-loop", func() AdjacencyGraph {
+			g := NewGraph(1)
+			g.AddEdge(0, 0)
+			return g
+		}, true},
+		{"no edges", func() AdjacencyGraph { return NewGraph(3) }, false},
+	} {
+		g := test.build().(*Graph)
+		if got := g.HasCycleDFS(); got != test.want {
+			t.Errorf("%s: HasCycleDFS() = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestHasCycleDirected(t *testing.T) {
+	for _, test := range []graphCycleCase{
+		{"one arc", func() AdjacencyGraph {
+			g := NewGraph(2)
+			g.AddDirectedEdge(0, 1)
+			return g
+		}, false},
+		{"two arcs forming a 2-cycle", func() AdjacencyGraph {
+			g := NewGraph(2)
+			g.AddDirectedEdge(0, 1)
+			g.AddDirectedEdge(1, 0)
+			return g
+		}, true},
+		{"diamond DAG", func() AdjacencyGraph {
+			// An undirected check would call this a cycle
+			g := NewGraph(4)
+			g.AddDirectedEdge(0, 1)
+			g.AddDirectedEdge(0, 2)
+			g.AddDirectedEdge(1, 3)
+			g.AddDirectedEdge(2, 3)
+			return g
+		}, false},
+		{"directed triangle", func() AdjacencyGraph {
+			g := NewGraph(3)
+			g.AddDirectedEdge(0, 1)
+			g.AddDirectedEdge(1, 2)
+			g.AddDirectedEdge(2, 0)
+			return g
+		}, true},
+		{"DirectedGraph DAG", func() AdjacencyGraph {
+			g := NewDirectedGraph(3)
+			g.AddEdge(0, 1)
+			g.AddEdge(0, 2)
+			g.AddEdge(1, 2)
+			return g
+		}, false},
+		{"DirectedGraph cycle", func() AdjacencyGraph {
+			g := NewDirectedGraph(3)
+			g.AddEdge(0, 1)
+			g.AddEdge(1, 2)
+			g.AddEdge(2, 1)
+			return g
+		}, true},
+	} {
+		if got := GraphHasCycle(test.build()); got != test.want {
+			t.Errorf("%s: GraphHasCycle() = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+// panics reports whether f panicked
+func panics(f func()) (panicked bool) {
+	defer func() { panicked = recover() != nil }()
+	f()
+	return false
+}
+
+func TestGraphRejectsMixedEdgeKinds(t *testing.T) {
+	undirected := NewGraph(3)
+	undirected.AddEdge(0, 1)
+	if !panics(func() { undirected.AddDirectedEdge(1, 2) }) {
+		t.Error("AddDirectedEdge on an undirected graph succeeded")
+	}
+	directed := NewGraph(3)
+	directed.AddDirectedEdge(0, 1)
+	if !panics(func() { directed.AddEdge(1, 2) }) {
+		t.Error("AddEdge on a directed graph succeeded")
+	}
+	// The rejected edges were not added
+	if len(undirected.Neighbors(1)) != 1 || len(directed.Neighbors(1)) != 0 {
+		t.Errorf("rejected edges were stored: %v, %v", undirected.Neighbors(1), directed.Neighbors(1))
+	}
+
+	// Repeating the same kind is fine
+	if panics(func() { directed.AddDirectedEdge(1, 2) }) || !directed.IsDirected() {
+		t.Errorf("second AddDirectedEdge panicked or IsDirected = %v", directed.IsDirected())
+	}
+}
+
+func TestHasCycleWeighted(t *testing.T) {
+	for _, test := range []graphCycleCase{
+		{"undirected weighted edge", func() AdjacencyGraph {
+			g := NewWeightedGraph(2)
+			g.AddUndirectedEdge(0, 1, 5)
+			return g
+		}, false},
+		{"undirected weighted triangle", func() AdjacencyGraph {
+			g := NewWeightedGraph(3)
+			g.AddUndirectedEdge(0, 1, 1)
+			g.AddUndirectedEdge(1, 2, 1)
+			g.AddUndirectedEdge(2, 0, 1)
+			return g
+		}, true},
+		{"directed weighted path", func() AdjacencyGraph {
+			g := NewWeightedGraph(3)
+			g.AddEdge(0, 1, 1)
+			g.AddEdge(1, 2, 1)
+			return g
+		}, false},
+		{"mixed weighted edges count as directed", func() AdjacencyGraph {
+			g := NewWeightedGraph(3)
+			g.AddUndirectedEdge(0, 1, 1)
+			g.AddEdge(1, 2, 1)
+			return g
+		}, true},
+		{"undirected matrix edge", func() AdjacencyGraph {
+			g := NewMatrixGraph(2)
+			g.AddUndirectedEdge(0, 1, 5)
+			return g
+		}, false},
+		{"undirected matrix converted from weighted", func() AdjacencyGraph {
+			g := NewWeightedGraph(3)
+			g.AddUndirectedEdge(0, 1, 1)
+			g.AddUndirectedEdge(1, 2, 1)
+			return g.ToMatrix()
+		}, false},
+		{"directed matrix 2-cycle", func() AdjacencyGraph {
+			g := NewMatrixGraph(2)
+			g.AddEdge(0, 1, 1)
+			g.AddEdge(1, 0, 1)
+			return g
+		}, true},
+		{"undirected Graph with unit weights", func() AdjacencyGraph {
+			g := NewGraph(3)
+			g.AddEdge(0, 1)
+			g.AddEdge(1, 2)
+			return g.WithUnitWeights()
+		}, false},
+		{"directed weighted 2-cycle made unweighted", func() AdjacencyGraph {
+			g := NewWeightedGraph(2)
+			g.AddEdge(0, 1, 1)
+			g.AddEdge(1, 0, 1)
+			return g.Unweighted()
+		}, true},
+		{"undirected weighted path made unweighted", func() AdjacencyGraph {
+			g := NewWeightedGraph(3)
+			g.AddUndirectedEdge(0, 1, 1)
+			g.AddUndirectedEdge(1, 2, 1)
+			return g.Unweighted()
+		}, false},
+	} {
+		if got := GraphHasCycle(test.build()); got != test.want {
+			t.Errorf("%s: GraphHasCycle() = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestTopologicalOrderRejectsUndirectedGraph(t *testing.T) {
+	undirected := NewGraph(3)
+	undirected.AddEdge(0, 1)
+	undirected.AddEdge(1, 2)
+	if _, err := TopologicalOrder(undirected); err == nil || err.Error() != "graph is undirected: topological sort not possible" {
+		t.Errorf("TopologicalOrder(undirected) error = %v", err)
+	}
+
+	if order, err := TopologicalOrder(NewGraph(3)); err != nil || len(order) != 3 {
+		t.Errorf("TopologicalOrder(no edges) = %v, %v; want all 3 vertices", order, err)
+	}
+
+	directed := NewGraph(3)
+	directed.AddDirectedEdge(2, 0)
+	directed.AddDirectedEdge(0, 1)
+	if order, err := TopologicalOrder(directed); err != nil || fmt.Sprint(order) != "[2 0 1]" {
+		t.Errorf("TopologicalOrder(directed) = %v, %v; want [2 0 1]", order, err)
+	}
+	directed.AddDirectedEdge(1, 2)
+	if _, err := TopologicalOrder(directed); err == nil || err.Error() != "graph contains a cycle: topological sort not possible" {
+		t.Errorf("TopologicalOrder(cycle) error = %v", err)
+	}
+}
+
+func TestAllShortestPathsParallelEdges(t *testing.T) {
+	// Two routes 0-1-3 and 0-2-3, with the edges 0-1 and 2-3 doubled
+	g := NewGraph(4)
+	for _, edge := range [][2]int{{0, 1}, {0, 1}, {0, 2}, {1, 3}, {2, 3}, {2, 3}} {
+		g.AddEdge(edge[0], edge[1])
+	}
+	paths, err := g.AllShortestPaths(0, 3)
+	if err != nil {
+		t.Fatalf("AllShortestPaths: %v", err)
+	}
+	if got := fmt.Sprint(paths); got != "[[0 1 3] [0 2 3]]" {
+		t.Errorf("AllShortestPaths(0, 3) = %s, want [[0 1 3] [0 2 3]]", got)
+	}
+}
+
+func TestConnectedComponentsDirected(t *testing.T) {
+	// 1 -> 0 and 2 -> 0 only reach 0 going forwards; 3 -> 4 <- 5
+	g := NewGraph(7)
+	for _, arc := range [][2]int{{1, 0}, {2, 0}, {3, 4}, {5, 4}} {
+		g.AddDirectedEdge(arc[0], arc[1])
+	}
+	want := "[[0 1 2] [3 4 5] [6]]"
+	if got := fmt.Sprint(g.ConnectedComponents()); got != want {
+		t.Errorf("ConnectedComponents() = %s, want %s", got, want)
+	}
+	if got := g.CountConnectedComponents(); got != 3 {
+		t.Errorf("CountConnectedComponents() = %d, want 3", got)
+	}
+
+	undirected := NewGraph(4)
+	undirected.AddEdge(0, 2)
+	if got := fmt.Sprint(undirected.ConnectedComponents()); got != "[[0 2] [1] [3]]" {
+		t.Errorf("undirected ConnectedComponents() = %s, want [[0 2] [1] [3]]", got)
+	}
+}
@@ -0,0 +1,253 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ================================
+// GRAPHVIZ DOT EXPORT
+// ================================
+
+// DOTHighlight marks part of a graph to draw in red
+type DOTHighlight struct {
+	Path   []int    // consecutive vertices, e.g. a shortest path
+	Edges  []Edge   // individual edges, e.g. an MST from KruskalMST
+	Labels []string // optional vertex names, indexed by vertex id
+}
+
+// dotEdge is one line of DOT output before styling
+type dotEdge struct {
+	from, to int
+	label    string
+}
+
+// highlighted reports whether the edge u-v is part of the highlight
+func (h DOTHighlight) highlighted(u, v int, directed bool) bool {
+	matches := func(a, b int) bool {
+		return (a == u && b == v) || (!directed && a == v && b == u)
+	}
+	for i := 0; i+1 < len(h.Path); i++ {
+		if matches(h.Path[i], h.Path[i+1]) {
+			return true
+		}
+	}
+	for _, edge := range h.Edges {
+		if matches(edge.From, edge.To) {
+			return true
+		}
+	}
+	return false
+}
+
+// writeDOT renders vertices and edges in Graphviz syntax. Pass no highlight
+// or one; only the first is used.
+func writeDOT(name string, directed bool, vertices []int, edges []dotEdge, highlight []DOTHighlight) string {
+	h := DOTHighlight{}
+	if len(highlight) > 0 {
+		h = highlight[0]
+	}
+
+	onPath := make(map[int]bool)
+	for _, v := range h.Path {
+		onPath[v] = true
+	}
+	for _, edge := range h.Edges {
+		onPath[edge.From] = true
+		onPath[edge.To] = true
+	}
+
+	keyword, arrow := "graph", "--"
+	if directed {
+		keyword, arrow = "digraph", "->"
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s %s {\n", keyword, name)
+	for _, v := range vertices {
+		attributes := []string{}
+		if v < len(h.Labels) {
+			attributes = append(attributes, fmt.Sprintf("label=%q", h.Labels[v]))
+		}
+		if onPath[v] {
+			attributes = append(attributes, "color=red", "fontcolor=red")
+		}
+		if len(attributes) > 0 {
+			fmt.Fprintf(&sb, "  %d [%s];\n", v, strings.Join(attributes, ", "))
+		} else {
+			fmt.Fprintf(&sb, "  %d;\n", v)
+		}
+	}
+
+	for _, edge := range edges {
+		attributes := []string{}
+		if edge.label != "" {
+			attributes = append(attributes, fmt.Sprintf("label=%q", edge.label))
+		}
+		if h.highlighted(edge.from, edge.to, directed) {
+			attributes = append(attributes, "color=red", "penwidth=2.5")
+		}
+		fmt.Fprintf(&sb, "  %d %s %d", edge.from, arrow, edge.to)
+		if len(attributes) > 0 {
+			fmt.Fprintf(&sb, " [%s]", strings.Join(attributes, ", "))
+		}
+		sb.WriteString(";\n")
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// undirectedEdges keeps one copy of each undirected edge from adjacency lists
+// that store both directions. A self-loop appears twice in its own list, so
+// only every second copy is kept.
+func undirectedEdges(vertices int, neighbors func(u int) ([]int, []string)) []dotEdge {
+	edges := []dotEdge{}
+	for u := 0; u < vertices; u++ {
+		targets, labels := neighbors(u)
+		selfLoops := 0
+		for i, v := range targets {
+			if v == u {
+				selfLoops++
+			}
+			if v > u || (v == u && selfLoops%2 == 0) {
+				edges = append(edges, dotEdge{u, v, labels[i]})
+			}
+		}
+	}
+	return edges
+}
+
+// dotEdges returns the edges to draw and whether the graph is directed
+func (g *Graph) dotEdges() ([]dotEdge, bool) {
+	if !g.IsDirected() {
+		return undirectedEdges(g.vertices, func(u int) ([]int, []string) {
+			return g.adjList[u], make([]string, len(g.adjList[u]))
+		}), false
+	}
+	edges := []dotEdge{}
+	for u := 0; u < g.vertices; u++ {
+		for _, v := range g.adjList[u] {
+			edges = append(edges, dotEdge{from: u, to: v})
+		}
+	}
+	return edges, true
+}
+
+// ToDOT renders the graph in Graphviz DOT format, e.g. for `dot -Tpng`
+func (g *Graph) ToDOT(highlight ...DOTHighlight) string {
+	edges, directed := g.dotEdges()
+	return writeDOT("G", directed, g.Vertices(), edges, highlight)
+}
+
+// ToDOT renders the directed graph in Graphviz DOT format
+func (g *DirectedGraph) ToDOT(highlight ...DOTHighlight) string {
+	edges := []dotEdge{}
+	for u := 0; u < g.vertices; u++ {
+		for _, v := range g.adjList[u] {
+			edges = append(edges, dotEdge{from: u, to: v})
+		}
+	}
+	return writeDOT("G", true, g.Vertices(), edges, highlight)
+}
+
+// dotEdges returns the edges to draw, labeled with their weights, and
+// whether the graph is directed. A graph built only with AddUndirectedEdge
+// is drawn undirected.
+func (g *WeightedGraph) dotEdges() ([]dotEdge, bool) {
+	neighbors := func(u int) ([]int, []string) {
+		targets := make([]int, len(g.adjList[u]))
+		labels := make([]string, len(g.adjList[u]))
+		for i, edge := range g.adjList[u] {
+			targets[i] = edge.to
+			labels[i] = fmt.Sprintf("%g", edge.weight)
+		}
+		return targets, labels
+	}
+
+	if g.isSymmetric() {
+		return undirectedEdges(g.vertices, neighbors), false
+	}
+
+	edges := []dotEdge{}
+	for u := 0; u < g.vertices; u++ {
+		targets, labels := neighbors(u)
+		for i, v := range targets {
+			edges = append(edges, dotEdge{u, v, labels[i]})
+		}
+	}
+	return edges, true
+}
+
+// ToDOT renders the weighted graph in Graphviz DOT format with weights as
+// edge labels. A graph built only with AddUndirectedEdge is drawn undirected.
+func (g *WeightedGraph) ToDOT(highlight ...DOTHighlight) string {
+	edges, directed := g.dotEdges()
+	return writeDOT("G", directed, g.Vertices(), edges, highlight)
+}
+
+// isSymmetric reports whether every edge u -> v has a matching v -> u with
+// the same weight
+func (g *WeightedGraph) isSymmetric() bool {
+	type arc struct {
+		from, to int
+		weight   float64
+	}
+	counts := make(map[arc]int)
+	for u := 0; u < g.vertices; u++ {
+		for _, edge := range g.adjList[u] {
+			counts[arc{u, edge.to, edge.weight}]++
+		}
+	}
+	for a, count := range counts {
+		if counts[arc{a.to, a.from, a.weight}] != count {
+			return false
+		}
+	}
+	return true
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoGraphviz prints DOT output for each graph type
+func DemoGraphviz() {
+	fmt.Println("=== GRAPHVIZ DOT EXPORT ===")
+	fmt.Println()
+	fmt.Println("Save any output below to a file and run: dot -Tpng graph.dot -o graph.png")
+	fmt.Println()
+
+	// Undirected graph with a highlighted shortest path
+	fmt.Println("1. Graph with BFS shortest path 0 -> 4 highlighted:")
+	graph := NewGraph(5)
+	graph.AddEdge(0, 1)
+	graph.AddEdge(0, 2)
+	graph.AddEdge(1, 3)
+	graph.AddEdge(2, 3)
+	graph.AddEdge(3, 4)
+	path, _ := graph.ShortestPath(0, 4)
+	fmt.Print(graph.ToDOT(DOTHighlight{Path: path}))
+	fmt.Println()
+
+	// DAG with named vertices
+	fmt.Println("2. DirectedGraph (task dependencies) with labels:")
+	tasks := []string{"Setup", "Design", "Code", "Test"}
+	dag := NewDirectedGraph(len(tasks))
+	dag.AddEdge(0, 1)
+	dag.AddEdge(1, 2)
+	dag.AddEdge(2, 3)
+	dag.AddEdge(1, 3)
+	fmt.Print(dag.ToDOT(DOTHighlight{Labels: tasks}))
+	fmt.Println()
+
+	// Weighted graph with its MST highlighted
+	fmt.Println("3. WeightedGraph with Kruskal MST highlighted:")
+	edges := []Edge{{0, 1, 4}, {0, 2, 3}, {1, 2, 1}, {1, 3, 2}, {2, 3, 4}}
+	weighted := NewWeightedGraph(4)
+	for _, edge := range edges {
+		weighted.AddUndirectedEdge(edge.From, edge.To, float64(edge.Weight))
+	}
+	mst, _ := KruskalMST(4, append([]Edge(nil), edges...))
+	fmt.Print(weighted.ToDOT(DOTHighlight{Edges: mst}))
+	fmt.Println()
+}
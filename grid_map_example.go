@@ -0,0 +1,440 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// ================================
+// GRID MAP
+// ================================
+
+// GridPoint is a cell position on a GridMap
+type GridPoint struct {
+	Row, Col int
+}
+
+// GridMap is a rectangular grid for pathfinding. Each open cell has a cost
+// for entering it (1 = normal terrain); walls cannot be entered. With
+// EightConnected movement a diagonal step costs √2 times the cell cost and
+// may not cut the corner of a wall.
+type GridMap struct {
+	rows, cols int
+	costs      []float64 // entry cost of each cell, +Inf for walls
+	Movement   Connectivity
+	Start      GridPoint // set by ParseGridMap from 'S'
+	Goal       GridPoint // set by ParseGridMap from 'G'
+}
+
+// NewGridMap creates an open grid where every cell costs 1 to enter
+func NewGridMap(rows, cols int, movement Connectivity) *GridMap {
+	costs := make([]float64, rows*cols)
+	for i := range costs {
+		costs[i] = 1
+	}
+	return &GridMap{rows: rows, cols: cols, costs: costs, Movement: movement}
+}
+
+// ParseGridMap reads a grid from text: '#' is a wall, '.' or ' ' costs 1,
+// a digit '1'-'9' is terrain costing that much, 'S' and 'G' mark the start
+// and goal (both cost 1). Short rows are padded with walls.
+func ParseGridMap(text string, movement Connectivity) (*GridMap, error) {
+	lines := strings.Split(strings.Trim(text, "\n"), "\n")
+	cols := 0
+	for _, line := range lines {
+		cols = max(cols, len(line))
+	}
+	if cols == 0 {
+		return nil, fmt.Errorf("grid is empty")
+	}
+
+	grid := NewGridMap(len(lines), cols, movement)
+	foundStart, foundGoal := false, false
+	for r, line := range lines {
+		for c := 0; c < cols; c++ {
+			p := GridPoint{r, c}
+			if c >= len(line) {
+				grid.SetWall(p)
+				continue
+			}
+			switch ch := line[c]; {
+			case ch == '#':
+				grid.SetWall(p)
+			case ch == '.' || ch == ' ':
+			case ch >= '1' && ch <= '9':
+				grid.costs[grid.index(p)] = float64(ch - '0')
+			case ch == 'S' && !foundStart:
+				grid.Start, foundStart = p, true
+			case ch == 'G' && !foundGoal:
+				grid.Goal, foundGoal = p, true
+			default:
+				return nil, fmt.Errorf("unexpected character %q at row %d, column %d", ch, r, c)
+			}
+		}
+	}
+	if !foundStart || !foundGoal {
+		return nil, fmt.Errorf("grid needs exactly one 'S' and one 'G'")
+	}
+	return grid, nil
+}
+
+// ToGridMap converts a parsed Maze, keeping its start and goal
+func (m *Maze) ToGridMap() *GridMap {
+	grid := NewGridMap(m.rows, m.cols, FourConnected)
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			if m.grid[r][c] == mazeWall {
+				grid.SetWall(GridPoint{r, c})
+			}
+		}
+	}
+	sr, sc := m.cell(m.start)
+	gr, gc := m.cell(m.goal)
+	grid.Start, grid.Goal = GridPoint{sr, sc}, GridPoint{gr, gc}
+	return grid
+}
+
+func (g *GridMap) index(p GridPoint) int {
+	return p.Row*g.cols + p.Col
+}
+
+func (g *GridMap) point(i int) GridPoint {
+	return GridPoint{i / g.cols, i % g.cols}
+}
+
+// InBounds reports whether p lies on the grid
+func (g *GridMap) InBounds(p GridPoint) bool {
+	return p.Row >= 0 && p.Row < g.rows && p.Col >= 0 && p.Col < g.cols
+}
+
+// IsOpen reports whether p is on the grid and not a wall
+func (g *GridMap) IsOpen(p GridPoint) bool {
+	return g.InBounds(p) && !math.IsInf(g.costs[g.index(p)], 1)
+}
+
+// SetWall turns p into a wall
+func (g *GridMap) SetWall(p GridPoint) {
+	g.costs[g.index(p)] = math.Inf(1)
+}
+
+// SetCost sets the cost of entering p, which also clears a wall
+func (g *GridMap) SetCost(p GridPoint, cost float64) error {
+	if !g.InBounds(p) {
+		return fmt.Errorf("cell %v is outside the %dx%d grid", p, g.rows, g.cols)
+	}
+	if cost <= 0 {
+		return fmt.Errorf("cell cost must be positive, got %v", cost)
+	}
+	g.costs[g.index(p)] = cost
+	return nil
+}
+
+// gridStep is a move to a neighboring cell and its cost
+type gridStep struct {
+	to   int
+	cost float64
+}
+
+// steps returns the moves out of cell i. Diagonal moves need both adjacent
+// orthogonal cells open, so paths never squeeze between two walls.
+func (g *GridMap) steps(i int) []gridStep {
+	p := g.point(i)
+	steps := make([]gridStep, 0, 8)
+	for _, offset := range g.Movement.offsets() {
+		next := GridPoint{p.Row + offset[0], p.Col + offset[1]}
+		if !g.IsOpen(next) {
+			continue
+		}
+		cost := g.costs[g.index(next)]
+		if offset[0] != 0 && offset[1] != 0 {
+			if !g.IsOpen(GridPoint{p.Row + offset[0], p.Col}) || !g.IsOpen(GridPoint{p.Row, p.Col + offset[1]}) {
+				continue
+			}
+			cost *= math.Sqrt2
+		}
+		steps = append(steps, gridStep{to: g.index(next), cost: cost})
+	}
+	return steps
+}
+
+// minCost returns the cheapest cell cost, used to keep heuristics admissible
+func (g *GridMap) minCost() float64 {
+	lowest := math.Inf(1)
+	for _, cost := range g.costs {
+		lowest = math.Min(lowest, cost)
+	}
+	return lowest
+}
+
+// heuristic estimates the cost from a to b ignoring walls: Manhattan
+// distance for 4-way movement, octile distance for 8-way movement
+func (g *GridMap) heuristic(a, b GridPoint, scale float64) float64 {
+	dr, dc := float64(abs(a.Row-b.Row)), float64(abs(a.Col-b.Col))
+	if g.Movement == EightConnected {
+		return scale * (math.Max(dr, dc) + (math.Sqrt2-1)*math.Min(dr, dc))
+	}
+	return scale * (dr + dc)
+}
+
+// ================================
+// SOLVERS
+// ================================
+
+// GridSolver selects the search used by GridMap.FindPath
+type GridSolver int
+
+const (
+	GridBFS      GridSolver = iota // fewest steps, ignores cell costs
+	GridDijkstra                   // cheapest path, explores uniformly
+	GridAStar                      // cheapest path, guided by a heuristic
+	GridJPS                        // A* that jumps along straight lines; uniform-cost grids only
+)
+
+// String returns the solver name
+func (s GridSolver) String() string {
+	switch s {
+	case GridBFS:
+		return "BFS"
+	case GridDijkstra:
+		return "Dijkstra"
+	case GridAStar:
+		return "A*"
+	case GridJPS:
+		return "JPS"
+	}
+	return "unknown"
+}
+
+// GridPath is a path found on a GridMap and how much work the search did
+type GridPath struct {
+	Solver   GridSolver
+	Points   []GridPoint // from start to goal
+	Cost     float64     // sum of the step costs along Points
+	Expanded int         // cells taken off the frontier
+}
+
+// FindPath searches for a path from start to goal with the chosen solver
+func (g *GridMap) FindPath(start, goal GridPoint, solver GridSolver) (*GridPath, error) {
+	if !g.IsOpen(start) || !g.IsOpen(goal) {
+		return nil, fmt.Errorf("start %v and goal %v must be open cells", start, goal)
+	}
+
+	var previous []int
+	var expanded int
+	switch solver {
+	case GridBFS:
+		previous, expanded = g.searchBFS(start, goal)
+	case GridDijkstra:
+		previous, expanded = g.searchBestFirst(start, goal, 0)
+	case GridAStar:
+		previous, expanded = g.searchBestFirst(start, goal, g.minCost())
+	case GridJPS:
+		unit, uniform := g.uniformCost()
+		if !uniform {
+			return nil, fmt.Errorf("jump point search needs a uniform-cost grid")
+		}
+		previous, expanded = g.jumpPointSearch(start, goal, unit)
+	default:
+		return nil, fmt.Errorf("unknown grid solver %d", solver)
+	}
+
+	if previous[g.index(goal)] == -1 && start != goal {
+		return nil, fmt.Errorf("goal %v is unreachable from %v", goal, start)
+	}
+	path := &GridPath{Solver: solver, Expanded: expanded}
+	for i := g.index(goal); i != -1; i = previous[i] {
+		path.Points = append(path.Points, g.point(i))
+	}
+	for i, j := 0, len(path.Points)-1; i < j; i, j = i+1, j-1 {
+		path.Points[i], path.Points[j] = path.Points[j], path.Points[i]
+	}
+	if solver == GridJPS {
+		path.Points = fillJumps(path.Points)
+	}
+	path.Cost = g.pathCost(path.Points)
+	return path, nil
+}
+
+// pathCost sums the cost of each step along adjacent points
+func (g *GridMap) pathCost(points []GridPoint) float64 {
+	total := 0.0
+	for i := 1; i < len(points); i++ {
+		cost := g.costs[g.index(points[i])]
+		if points[i].Row != points[i-1].Row && points[i].Col != points[i-1].Col {
+			cost *= math.Sqrt2
+		}
+		total += cost
+	}
+	return total
+}
+
+// newPrevious returns a predecessor table with every cell unvisited (-1)
+func (g *GridMap) newPrevious() []int {
+	previous := make([]int, len(g.costs))
+	for i := range previous {
+		previous[i] = -1
+	}
+	return previous
+}
+
+// searchBFS explores cells in order of step count
+func (g *GridMap) searchBFS(start, goal GridPoint) ([]int, int) {
+	previous := g.newPrevious()
+	seen := make([]bool, len(g.costs))
+	source, target := g.index(start), g.index(goal)
+	seen[source] = true
+	queue := Queue[int]{}
+	queue.Enqueue(source)
+	expanded := 0
+
+	for queue.Len() > 0 {
+		u, _ := queue.Dequeue()
+		expanded++
+		if u == target {
+			break
+		}
+		for _, step := range g.steps(u) {
+			if !seen[step.to] {
+				seen[step.to] = true
+				previous[step.to] = u
+				queue.Enqueue(step.to)
+			}
+		}
+	}
+	return previous, expanded
+}
+
+// searchBestFirst is Dijkstra when heuristicScale is 0 and A* otherwise
+func (g *GridMap) searchBestFirst(start, goal GridPoint, heuristicScale float64) ([]int, int) {
+	previous := g.newPrevious()
+	distance := make([]float64, len(g.costs))
+	for i := range distance {
+		distance[i] = math.Inf(1)
+	}
+	closed := make([]bool, len(g.costs))
+	source, target := g.index(start), g.index(goal)
+	distance[source] = 0
+	expanded := 0
+
+	pq := NewIndexedMinHeap[float64](len(g.costs))
+	pq.Push(source, g.heuristic(start, goal, heuristicScale))
+	for pq.Len() > 0 {
+		u, _, _ := pq.Pop()
+		closed[u] = true
+		expanded++
+		if u == target {
+			break
+		}
+		for _, step := range g.steps(u) {
+			if newDistance := distance[u] + step.cost; !closed[step.to] && newDistance < distance[step.to] {
+				distance[step.to] = newDistance
+				previous[step.to] = u
+				pq.PushOrDecrease(step.to, newDistance+g.heuristic(g.point(step.to), goal, heuristicScale))
+			}
+		}
+	}
+	return previous, expanded
+}
+
+// ================================
+// RENDERING
+// ================================
+
+// Render draws the grid as ASCII: '#' walls, digits for costly terrain,
+// 'S' and 'G' for the ends of the path and '*' for the cells in between
+func (g *GridMap) Render(path *GridPath) string {
+	canvas := make([][]byte, g.rows)
+	for r := range canvas {
+		canvas[r] = make([]byte, g.cols)
+		for c := range canvas[r] {
+			switch cost := g.costs[g.index(GridPoint{r, c})]; {
+			case math.IsInf(cost, 1):
+				canvas[r][c] = '#'
+			case cost > 1 && cost <= 9:
+				canvas[r][c] = '0' + byte(cost)
+			default:
+				canvas[r][c] = '.'
+			}
+		}
+	}
+	if path != nil && len(path.Points) > 0 {
+		for _, p := range path.Points {
+			canvas[p.Row][p.Col] = '*'
+		}
+		first, last := path.Points[0], path.Points[len(path.Points)-1]
+		canvas[first.Row][first.Col] = 'S'
+		canvas[last.Row][last.Col] = 'G'
+	}
+
+	var sb strings.Builder
+	for _, row := range canvas {
+		sb.Write(row)
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoGridMap demonstrates the grid solvers on weighted terrain
+func DemoGridMap() {
+	fmt.Println("=== GRID PATHFINDING ===")
+	fmt.Println()
+
+	terrain := `
+S.....#.........
+.####.#.99999...
+.#....#.9...9...
+.#.####.9.G.....
+.#......9...9...
+.########9999...
+................`
+
+	// Example 1: Solvers on weighted terrain
+	fmt.Println("=== EXAMPLE 1: Weighted Terrain (4-way) ===")
+	grid, err := ParseGridMap(terrain, FourConnected)
+	if err != nil {
+		fmt.Printf("Parse error: %v\n", err)
+		return
+	}
+	for _, solver := range []GridSolver{GridBFS, GridDijkstra, GridAStar} {
+		path, err := grid.FindPath(grid.Start, grid.Goal, solver)
+		if err != nil {
+			fmt.Printf("%v: %v\n", solver, err)
+			continue
+		}
+		fmt.Printf("%-8v steps %2d, cost %5.1f, expanded %3d cells\n", solver, len(path.Points)-1, path.Cost, path.Expanded)
+		if solver != GridDijkstra {
+			fmt.Print(grid.Render(path))
+		}
+	}
+	fmt.Println("BFS wades through the swamp (9s) in the fewest steps; Dijkstra and A* take\nthe longer dry route, and A* expands fewer cells doing so.")
+	fmt.Println()
+
+	// Example 2: Diagonal movement
+	fmt.Println("=== EXAMPLE 2: Diagonal Movement (8-way) ===")
+	diagonal, _ := ParseGridMap(terrain, EightConnected)
+	path, _ := diagonal.FindPath(diagonal.Start, diagonal.Goal, GridAStar)
+	fmt.Printf("A* steps %d, cost %.2f, expanded %d cells\n", len(path.Points)-1, path.Cost, path.Expanded)
+	fmt.Print(diagonal.Render(path))
+	fmt.Println()
+
+	// Example 3: From an existing Maze
+	fmt.Println("=== EXAMPLE 3: Converting a Maze ===")
+	maze, _ := ParseMaze(`
+#########
+#S..#...#
+#.#.#.#.#
+#.#...#G#
+#########`)
+	mazeGrid := maze.ToGridMap()
+	path, _ = mazeGrid.FindPath(mazeGrid.Start, mazeGrid.Goal, GridAStar)
+	fmt.Print(mazeGrid.Render(path))
+	if _, err := mazeGrid.FindPath(mazeGrid.Start, GridPoint{0, 0}, GridBFS); err != nil {
+		fmt.Printf("Path to (0, 0): %v\n", err)
+	}
+	fmt.Println()
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+)
+
+// ================================
+// HASH MAP PATTERNS
+// ================================
+// Each problem below has an obvious O(n²) or O(n log n) solution. A hash
+// set or map answers "have I seen X?" in O(1) on average, which brings
+// each one down to a single O(n) pass.
+
+// LongestConsecutiveSequence returns the length and first value of the
+// longest run of consecutive integers in nums, in any order
+// (e.g. [100 4 200 1 3 2] -> 4 starting at 1).
+// Only values with no predecessor in the set start a run, so every value is
+// visited at most twice in total.
+// Time Complexity: O(n) average
+// Space Complexity: O(n)
+func LongestConsecutiveSequence(nums []int) (length, start int) {
+	set := make(map[int]bool, len(nums))
+	for _, num := range nums {
+		set[num] = true
+	}
+
+	for num := range set {
+		if set[num-1] {
+			continue // not the start of a run
+		}
+		end := num
+		for set[end+1] {
+			end++
+		}
+		if runLength := end - num + 1; runLength > length || (runLength == length && num < start) {
+			length, start = runLength, num
+		}
+	}
+	return length, start
+}
+
+// longestConsecutiveSorted sorts first
+// Time Complexity: O(n log n)
+func longestConsecutiveSorted(nums []int) int {
+	sorted := append([]int(nil), nums...)
+	sort.Ints(sorted)
+	best, current := 0, 0
+	for i, num := range sorted {
+		switch {
+		case i > 0 && num == sorted[i-1]:
+			// duplicate: run unchanged
+		case i > 0 && num == sorted[i-1]+1:
+			current++
+		default:
+			current = 1
+		}
+		best = max(best, current)
+	}
+	return best
+}
+
+// TwoSum returns indices i < j with nums[i] + nums[j] == target.
+// The map stores each value's index, so the complement of nums[j] is
+// looked up among the elements before it.
+// Time Complexity: O(n) average
+// Space Complexity: O(n)
+func TwoSum(nums []int, target int) (int, int, bool) {
+	seen := make(map[int]int, len(nums))
+	for j, num := range nums {
+		if i, found := seen[target-num]; found {
+			return i, j, true
+		}
+		seen[num] = j
+	}
+	return -1, -1, false
+}
+
+// SubarraySumEqualsK counts contiguous subarrays summing to k. With prefix
+// sums P, the subarray (i, j] sums to k exactly when P[i] = P[j] - k, so the
+// map counts how often each prefix sum has occurred so far. Unlike a sliding
+// window this works with negative numbers.
+// Time Complexity: O(n) average
+// Space Complexity: O(n)
+func SubarraySumEqualsK(nums []int, k int) int {
+	prefixCounts := map[int]int{0: 1} // the empty prefix
+	count, prefix := 0, 0
+	for _, num := range nums {
+		prefix += num
+		count += prefixCounts[prefix-k]
+		prefixCounts[prefix]++
+	}
+	return count
+}
+
+// subarraySumBrute tries every start and end
+// Time Complexity: O(n²)
+func subarraySumBrute(nums []int, k int) int {
+	count := 0
+	for i := range nums {
+		sum := 0
+		for j := i; j < len(nums); j++ {
+			sum += nums[j]
+			if sum == k {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoHashingPatterns demonstrates the hash set and prefix-sum map patterns
+func DemoHashingPatterns() {
+	fmt.Println("=== HASH MAP PATTERNS ===")
+	fmt.Println()
+
+	// Example 1: Longest consecutive sequence
+	fmt.Println("=== EXAMPLE 1: Longest Consecutive Sequence ===")
+	for _, nums := range [][]int{
+		{100, 4, 200, 1, 3, 2},
+		{0, 3, 7, 2, 5, 8, 4, 6, 0, 1},
+		{9, -1, -3, -2, 10},
+		{},
+	} {
+		length, start := LongestConsecutiveSequence(nums)
+		if length == 0 {
+			fmt.Printf("%v -> empty\n", nums)
+			continue
+		}
+		fmt.Printf("%v -> length %d: %d..%d\n", nums, length, start, start+length-1)
+	}
+	fmt.Println()
+
+	// Example 2: Two sum
+	fmt.Println("=== EXAMPLE 2: Two Sum ===")
+	prices := []int{15, 42, 7, 23, 11, 30}
+	for _, budget := range []int{34, 53, 100} {
+		if i, j, ok := TwoSum(prices, budget); ok {
+			fmt.Printf("Prices %v, budget %d: items %d and %d (%d + %d)\n", prices, budget, i, j, prices[i], prices[j])
+		} else {
+			fmt.Printf("Prices %v, budget %d: no pair\n", prices, budget)
+		}
+	}
+	fmt.Println()
+
+	// Example 3: Subarray sum equals k
+	fmt.Println("=== EXAMPLE 3: Subarray Sum Equals K ===")
+	balance := []int{3, 4, -7, 1, 3, 3, 1, -4}
+	fmt.Printf("Daily changes %v: %d stretches sum to 7\n", balance, SubarraySumEqualsK(balance, 7))
+	fmt.Printf("Stretches with net change 0: %d\n", SubarraySumEqualsK(balance, 0))
+	fmt.Println("Negative values break the sliding-window approach; prefix sums do not care.")
+	fmt.Println()
+
+	// Example 4: Against the non-hashing solutions
+	fmt.Println("=== EXAMPLE 4: Hashing vs Sorting / Brute Force ===")
+	rng := rand.New(rand.NewSource(23))
+	nums := make([]int, 20000)
+	for i := range nums {
+		nums[i] = rng.Intn(40000) - 20000
+	}
+
+	start := time.Now()
+	length, _ := LongestConsecutiveSequence(nums)
+	hashTime := time.Since(start)
+	start = time.Now()
+	sortedLength := longestConsecutiveSorted(nums)
+	sortTime := time.Since(start)
+	fmt.Printf("Longest run (n=%d): hash set %d in %v, sorting %d in %v\n",
+		len(nums), length, hashTime, sortedLength, sortTime)
+
+	small := nums[:5000]
+	start = time.Now()
+	hashCount := SubarraySumEqualsK(small, 100)
+	hashTime = time.Since(start)
+	start = time.Now()
+	bruteCount := subarraySumBrute(small, 100)
+	bruteTime := time.Since(start)
+	fmt.Printf("Subarrays summing to 100 (n=%d): prefix map %d in %v, brute force %d in %v\n",
+		len(small), hashCount, hashTime, bruteCount, bruteTime)
+	fmt.Println("Map operations have a large constant factor, so O(n) hashing only ties")
+	fmt.Println("O(n log n) sorting at this size; against O(n²) it wins easily.")
+	fmt.Println()
+
+	fmt.Println("Pattern: replace a search over earlier elements with a lookup of the")
+	fmt.Println("exact value you need (predecessor, complement, or prefix sum - k).")
+	fmt.Println()
+}
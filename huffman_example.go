@@ -0,0 +1,250 @@
+package main
+
+import (
+	"container/heap"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ================================
+// HUFFMAN CODING
+// ================================
+
+// huffmanTree is a node of the tree built while merging frequencies; a
+// leaf carries a symbol, an internal node its two subtrees
+type huffmanTree struct {
+	weight      int
+	symbol      rune
+	first       rune // smallest symbol below, to break weight ties deterministically
+	left, right *huffmanTree
+}
+
+// huffmanHeap is a min-heap of trees by weight
+type huffmanHeap []*huffmanTree
+
+func (h huffmanHeap) Len() int { return len(h) }
+
+func (h huffmanHeap) Less(i, j int) bool {
+	if h[i].weight != h[j].weight {
+		return h[i].weight < h[j].weight
+	}
+	return h[i].first < h[j].first
+}
+
+func (h huffmanHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *huffmanHeap) Push(x interface{}) { *h = append(*h, x.(*huffmanTree)) }
+
+func (h *huffmanHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[0 : n-1]
+	return item
+}
+
+// BuildHuffmanCodes counts the symbols of text and returns an optimal
+// prefix-free code for them: repeatedly merge the two lightest trees, then
+// read each symbol's code off its root-to-leaf path (0 = left, 1 = right).
+// A text with a single distinct symbol gets the one-bit code "0".
+// Time Complexity: O(n + k log k) for k distinct symbols
+func BuildHuffmanCodes(text string) (map[rune]string, error) {
+	frequencies := map[rune]int{}
+	for _, symbol := range text {
+		frequencies[symbol]++
+	}
+	if len(frequencies) == 0 {
+		return nil, fmt.Errorf("cannot build a code for empty text")
+	}
+
+	h := &huffmanHeap{}
+	for _, symbol := range sortedKeys(frequencies) {
+		*h = append(*h, &huffmanTree{weight: frequencies[symbol], symbol: symbol, first: symbol})
+	}
+	heap.Init(h)
+	for h.Len() > 1 {
+		left := heap.Pop(h).(*huffmanTree)
+		right := heap.Pop(h).(*huffmanTree)
+		heap.Push(h, &huffmanTree{weight: left.weight + right.weight, first: min(left.first, right.first), left: left, right: right})
+	}
+
+	codes := map[rune]string{}
+	var assign func(node *huffmanTree, code string)
+	assign = func(node *huffmanTree, code string) {
+		if node.left == nil {
+			if code == "" {
+				code = "0"
+			}
+			codes[node.symbol] = code
+			return
+		}
+		assign(node.left, code+"0")
+		assign(node.right, code+"1")
+	}
+	assign((*h)[0], "")
+	return codes, nil
+}
+
+// HuffmanEncode replaces every symbol of text by its code
+func HuffmanEncode(text string, codes map[rune]string) (string, error) {
+	var bits strings.Builder
+	for _, symbol := range text {
+		code, ok := codes[symbol]
+		if !ok {
+			return "", fmt.Errorf("no code for symbol %q", symbol)
+		}
+		bits.WriteString(code)
+	}
+	return bits.String(), nil
+}
+
+// ================================
+// CODE TREE AS A BINARY TRIE
+// ================================
+
+// HuffmanDecoder stores a code in a Trie over the alphabet {'0', '1'}, so
+// the code tree is exactly the trie of the codewords. symbols maps each
+// codeword's end node back to the symbol it encodes.
+type HuffmanDecoder struct {
+	codes   *Trie
+	symbols map[*TrieNode]rune
+}
+
+// NewHuffmanDecoder loads codes into the binary trie and rejects any code
+// that is not prefix-free, naming the codeword that is a prefix of another.
+// In a prefix-free code every codeword ends at a leaf, which is what lets
+// the decoder emit a symbol the moment it reaches an end node.
+// Time Complexity: O(total code length)
+func NewHuffmanDecoder(codes map[rune]string) (*HuffmanDecoder, error) {
+	d := &HuffmanDecoder{codes: NewTrie(), symbols: map[*TrieNode]rune{}}
+	for _, symbol := range sortedKeys(codes) {
+		code := codes[symbol]
+		if code == "" || strings.Trim(code, "01") != "" {
+			return nil, fmt.Errorf("code %q for %q is not a non-empty bit string", code, symbol)
+		}
+		d.codes.InsertSimple(code)
+	}
+	for _, symbol := range sortedKeys(codes) {
+		node := d.codes.root
+		for _, bit := range codes[symbol] {
+			node = node.children[bit]
+		}
+		if len(node.children) > 0 || node.count > 1 {
+			for _, other := range sortedKeys(codes) {
+				if other != symbol && strings.HasPrefix(codes[other], codes[symbol]) {
+					return nil, fmt.Errorf("code is not prefix-free: %s (%q) is a prefix of %s (%q)",
+						codes[symbol], symbol, codes[other], other)
+				}
+			}
+		}
+		d.symbols[node] = symbol
+	}
+	return d, nil
+}
+
+// Decode walks the trie bit by bit and restarts at the root after every
+// symbol, so it needs no lookahead and no separators between codewords
+// Time Complexity: O(len(bits))
+func (d *HuffmanDecoder) Decode(bits string) (string, error) {
+	var text strings.Builder
+	node := d.codes.root
+	for i, bit := range bits {
+		if node = node.children[bit]; node == nil {
+			return "", fmt.Errorf("bit %d: %q leaves the code tree", i, bit)
+		}
+		if node.isEnd {
+			text.WriteRune(d.symbols[node])
+			node = d.codes.root
+		}
+	}
+	if node != d.codes.root {
+		return "", fmt.Errorf("input ends in the middle of a codeword")
+	}
+	return text.String(), nil
+}
+
+// PrintCodeTree draws the code tree with each leaf's symbol
+func (d *HuffmanDecoder) PrintCodeTree() {
+	fmt.Println("root")
+	var draw func(node *TrieNode, code, indent string)
+	draw = func(node *TrieNode, code, indent string) {
+		bits := sortedKeys(node.children)
+		for i, bit := range bits {
+			child := node.children[bit]
+			branch, next := "├── ", "│   "
+			if i == len(bits)-1 {
+				branch, next = "└── ", "    "
+			}
+			if child.isEnd {
+				fmt.Printf("%s%s%c  %q = %s\n", indent, branch, bit, d.symbols[child], code+string(bit))
+			} else {
+				fmt.Printf("%s%s%c\n", indent, branch, bit)
+			}
+			draw(child, code+string(bit), indent+next)
+		}
+	}
+	draw(d.codes.root, "", "")
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoHuffmanTrie builds Huffman codes, loads them into a binary trie and
+// decodes with it
+func DemoHuffmanTrie() {
+	fmt.Println("=== HUFFMAN CODES IN A BINARY TRIE ===")
+	fmt.Println()
+
+	// Example 1: Building the code
+	text := "abracadabra alakazam"
+	fmt.Printf("=== EXAMPLE 1: Huffman Code for %q ===\n", text)
+	codes, _ := BuildHuffmanCodes(text)
+	symbols := sortedKeys(codes)
+	sort.SliceStable(symbols, func(i, j int) bool { return len(codes[symbols[i]]) < len(codes[symbols[j]]) })
+	for _, symbol := range symbols {
+		fmt.Printf("  %q × %d -> %s\n", symbol, strings.Count(text, string(symbol)), codes[symbol])
+	}
+	bits, _ := HuffmanEncode(text, codes)
+	fmt.Printf("Encoded: %s\n", bits)
+	fmt.Printf("%d bits vs %d bits at 8 bits per symbol (%.1f%%)\n", len(bits), 8*len(text), 100*float64(len(bits))/float64(8*len(text)))
+	fmt.Println()
+
+	// Example 2: The code tree is the trie of the codewords
+	fmt.Println("=== EXAMPLE 2: Code Tree (Trie over {0, 1}) ===")
+	decoder, _ := NewHuffmanDecoder(codes)
+	decoder.PrintCodeTree()
+	decoded, err := decoder.Decode(bits)
+	fmt.Printf("Decoded: %q, round trip: %v, error: %v\n", decoded, decoded == text, err)
+	_, err = decoder.Decode(bits[:len(bits)-1])
+	fmt.Printf("Truncated input: %v\n", err)
+	fmt.Println()
+
+	// Example 3: Why prefix-freeness matters
+	fmt.Println("=== EXAMPLE 3: A Code That Is Not Prefix-Free ===")
+	ambiguous := map[rune]string{'a': "0", 'b': "01", 'c': "10"}
+	fmt.Println("Code: a=0, b=01, c=10")
+	if _, err := NewHuffmanDecoder(ambiguous); err != nil {
+		fmt.Printf("NewHuffmanDecoder: %v\n", err)
+	}
+	codewords := NewTrie()
+	for _, code := range ambiguous {
+		codewords.InsertSimple(code)
+	}
+	for _, message := range []string{"010", "01010"} {
+		parses := []string{}
+		for _, segmentation := range WordBreakAll(message, codewords, 0) {
+			parses = append(parses, strings.Join(segmentation, "|"))
+		}
+		fmt.Printf("%q splits into codewords %d ways: %s\n", message, len(parses), strings.Join(parses, ", "))
+	}
+	fmt.Println()
+
+	fmt.Println("Huffman's tree and the trie of its codewords are the same object: bits")
+	fmt.Println("choose children and symbols sit only at leaves. Because no codeword is a")
+	fmt.Println("prefix of another, reaching an end node always means a complete symbol,")
+	fmt.Println("and decoding is one walk down the trie per symbol. With 0 and 01 both")
+	fmt.Println("codewords, an end node can have children and the split is ambiguous.")
+	fmt.Println()
+}
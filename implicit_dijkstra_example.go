@@ -0,0 +1,232 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"strings"
+)
+
+// ================================
+// DIJKSTRA ON IMPLICIT GRAPHS
+// ================================
+
+// Arc is an outgoing edge of an implicit graph, produced on demand by a
+// neighbor callback. (Edge is the MST code's integer edge list.)
+type Arc[V comparable] struct {
+	To     V
+	Weight float64
+}
+
+// ImplicitPaths is the result of DijkstraFunc. States are numbered in the
+// order they are discovered; only discovered states take any memory.
+type ImplicitPaths[V comparable] struct {
+	ids       map[V]int
+	states    []V
+	distances []float64
+	previous  []int
+	settled   []bool
+	Settled   int  // states whose distance is final
+	Goal      V    // the settled state that stopped the search, if Found
+	Found     bool // whether a goal state was reached
+}
+
+// DijkstraFunc runs Dijkstra from source over a graph that is never
+// materialized: neighbors is called once per settled state to list its
+// outgoing arcs. The search stops at the first settled state for which
+// goal returns true, or explores everything reachable if goal is nil, so
+// it also works on infinite state spaces as long as a goal is reachable.
+// Negative weights are an error.
+// Time Complexity: O((S + A) log S) for S states discovered and A arcs listed
+func DijkstraFunc[V comparable](source V, neighbors func(V) []Arc[V], goal func(V) bool) (*ImplicitPaths[V], error) {
+	paths := &ImplicitPaths[V]{ids: map[V]int{}}
+	pq := NewIndexedMinHeap[float64](0)
+	discover := func(state V) int {
+		if id, seen := paths.ids[state]; seen {
+			return id
+		}
+		id := len(paths.states)
+		paths.ids[state] = id
+		paths.states = append(paths.states, state)
+		paths.distances = append(paths.distances, math.Inf(1))
+		paths.previous = append(paths.previous, -1)
+		paths.settled = append(paths.settled, false)
+		pq.Grow(id + 1)
+		return id
+	}
+
+	start := discover(source)
+	paths.distances[start] = 0
+	pq.Push(start, 0)
+	for pq.Len() > 0 {
+		u, distance, _ := pq.Pop()
+		paths.settled[u] = true
+		paths.Settled++
+		if goal != nil && goal(paths.states[u]) {
+			paths.Goal, paths.Found = paths.states[u], true
+			return paths, nil
+		}
+		for _, arc := range neighbors(paths.states[u]) {
+			if arc.Weight < 0 {
+				return nil, fmt.Errorf("arc %v -> %v has negative weight %v", paths.states[u], arc.To, arc.Weight)
+			}
+			v := discover(arc.To)
+			if !paths.settled[v] && distance+arc.Weight < paths.distances[v] {
+				paths.distances[v] = distance + arc.Weight
+				paths.previous[v] = u
+				pq.PushOrDecrease(v, paths.distances[v])
+			}
+		}
+	}
+	return paths, nil
+}
+
+// Distance returns the shortest distance to state, or false if the search
+// did not settle it
+func (p *ImplicitPaths[V]) Distance(state V) (float64, bool) {
+	id, seen := p.ids[state]
+	if !seen || !p.settled[id] {
+		return math.Inf(1), false
+	}
+	return p.distances[id], true
+}
+
+// PathTo returns the states of a shortest path from the source to state,
+// or nil if the search did not settle it
+func (p *ImplicitPaths[V]) PathTo(state V) []V {
+	id, seen := p.ids[state]
+	if !seen || !p.settled[id] {
+		return nil
+	}
+	path := []V{}
+	for ; id != -1; id = p.previous[id] {
+		path = append(path, p.states[id])
+	}
+	slices.Reverse(path)
+	return path
+}
+
+// Discovered returns how many states the search has seen, settled or not
+func (p *ImplicitPaths[V]) Discovered() int {
+	return len(p.states)
+}
+
+// ================================
+// STATE SPACES
+// ================================
+
+// slidingPuzzleMoves lists the boards reachable by sliding one tile into
+// the blank ('0') of a rows x cols board written row by row; every move
+// costs 1
+func slidingPuzzleMoves(rows, cols int) func(board string) []Arc[string] {
+	return func(board string) []Arc[string] {
+		blank := strings.IndexByte(board, '0')
+		r, c := blank/cols, blank%cols
+		moves := []Arc[string]{}
+		for _, offset := range FourConnected.offsets() {
+			nr, nc := r+offset[0], c+offset[1]
+			if nr < 0 || nr >= rows || nc < 0 || nc >= cols {
+				continue
+			}
+			next := []byte(board)
+			tile := nr*cols + nc
+			next[blank], next[tile] = next[tile], next[blank]
+			moves = append(moves, Arc[string]{string(next), 1})
+		}
+		return moves
+	}
+}
+
+// weightedLadderMoves lists the dictionary words that differ from word in
+// one letter; changing a to b costs the distance between them in the
+// alphabet, so small edits are cheap
+func weightedLadderMoves(dictionary map[string]bool) func(word string) []Arc[string] {
+	return func(word string) []Arc[string] {
+		moves := []Arc[string]{}
+		next := []byte(word)
+		for i := range next {
+			original := next[i]
+			for letter := byte('a'); letter <= 'z'; letter++ {
+				next[i] = letter
+				if letter != original && dictionary[string(next)] {
+					cost := float64(letter) - float64(original)
+					moves = append(moves, Arc[string]{string(next), math.Abs(cost)})
+				}
+			}
+			next[i] = original
+		}
+		return moves
+	}
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoImplicitDijkstra runs the same Dijkstra engine on an adjacency list,
+// a sliding puzzle and a weighted word ladder
+func DemoImplicitDijkstra() {
+	fmt.Println("=== DIJKSTRA ON IMPLICIT GRAPHS ===")
+	fmt.Println()
+
+	// Example 1: An adjacency list is just one kind of neighbor callback
+	fmt.Println("=== EXAMPLE 1: Same Distances as DijkstraHeap ===")
+	rng := rand.New(rand.NewSource(1049))
+	graph := randomSparseGraph(2_000, 8_000, rng)
+	implicit, _ := DijkstraFunc(0, func(v int) []Arc[int] {
+		arcs := []Arc[int]{}
+		for _, edge := range graph.WeightedNeighbors(v) {
+			arcs = append(arcs, Arc[int]{edge.to, edge.weight})
+		}
+		return arcs
+	}, nil)
+	explicit := DijkstraHeap(graph, 0)
+	mismatches := 0
+	for v := range explicit.distances {
+		if distance, _ := implicit.Distance(v); distance != explicit.distances[v] {
+			mismatches++
+		}
+	}
+	fmt.Printf("V=%d, E=%d: %d settled, %d distances differ\n", graph.vertices, 8_000, implicit.Settled, mismatches)
+	_, err := DijkstraFunc("a", func(string) []Arc[string] { return []Arc[string]{{"b", -1}} }, nil)
+	fmt.Printf("Negative arc: %v\n", err)
+	fmt.Println()
+
+	// Example 2: A 2x3 sliding puzzle, one state per board
+	fmt.Println("=== EXAMPLE 2: 2x3 Sliding Puzzle ===")
+	start, solved := "412503", "123450"
+	puzzle, _ := DijkstraFunc(start, slidingPuzzleMoves(2, 3), func(board string) bool { return board == solved })
+	moves, _ := puzzle.Distance(solved)
+	fmt.Printf("Solved in %.0f moves after settling %d of %d discovered boards:\n", moves, puzzle.Settled, puzzle.Discovered())
+	for _, board := range puzzle.PathTo(solved) {
+		fmt.Printf("  %s | %s\n", board[:3], board[3:])
+	}
+	everything, _ := DijkstraFunc(start, slidingPuzzleMoves(2, 3), nil)
+	fmt.Printf("Without a goal the search settles all %d reachable boards (of 720)\n", everything.Settled)
+	fmt.Println()
+
+	// Example 3: Word ladder where big letter jumps cost more
+	fmt.Println("=== EXAMPLE 3: Weighted Word Ladder (cost = letter distance) ===")
+	dictionary := map[string]bool{}
+	for _, word := range strings.Fields("cold cord card ward warm word worm wore core care ware bold bolt boat coat cost most mist wist wisp cole dole dale male mare mart wart") {
+		dictionary[word] = true
+	}
+	for _, pair := range [][2]string{{"cold", "warm"}, {"coat", "mist"}, {"cold", "boat"}} {
+		ladder, _ := DijkstraFunc(pair[0], weightedLadderMoves(dictionary), func(word string) bool { return word == pair[1] })
+		if !ladder.Found {
+			fmt.Printf("%s -> %s: unreachable after %d words\n", pair[0], pair[1], ladder.Settled)
+			continue
+		}
+		cost, _ := ladder.Distance(pair[1])
+		fmt.Printf("%s -> %s: %s (cost %.0f, %d words settled)\n",
+			pair[0], pair[1], strings.Join(ladder.PathTo(pair[1]), " -> "), cost, ladder.Settled)
+	}
+	fmt.Println()
+
+	fmt.Println("The engine only ever asks for the neighbors of the state it settles,")
+	fmt.Println("and numbers states as it meets them, so the heap and distance arrays")
+	fmt.Println("grow with the explored part of the space. With a goal it stops as soon")
+	fmt.Println("as the goal is settled, long before the space is exhausted.")
+	fmt.Println()
+}
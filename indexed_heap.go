@@ -0,0 +1,319 @@
+package main
+
+import (
+	"cmp"
+	"container/heap"
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// ================================
+// INDEXED MIN-HEAP
+// ================================
+
+// IndexedMinHeap is a binary min-heap of ids 0..n-1, each with a priority.
+// It records where every id sits in the heap, so Contains is O(1) and
+// DecreaseKey can sift an id up in place. Graph searches use it to keep one
+// entry per vertex instead of pushing duplicates and skipping stale ones.
+type IndexedMinHeap[P cmp.Ordered] struct {
+	heap       []int // heap[i] = id at heap position i
+	position   []int // position[id] = index in heap, -1 if absent
+	priorities []P
+}
+
+// NewIndexedMinHeap creates an empty heap for ids 0..n-1
+func NewIndexedMinHeap[P cmp.Ordered](n int) *IndexedMinHeap[P] {
+	position := make([]int, n)
+	for i := range position {
+		position[i] = -1
+	}
+	return &IndexedMinHeap[P]{position: position, priorities: make([]P, n)}
+}
+
+// Grow makes room for ids up to n-1, for searches that number their
+// vertices as they discover them
+func (h *IndexedMinHeap[P]) Grow(n int) {
+	for len(h.position) < n {
+		h.position = append(h.position, -1)
+		h.priorities = append(h.priorities, *new(P))
+	}
+}
+
+// Len returns the number of ids in the heap
+func (h *IndexedMinHeap[P]) Len() int {
+	return len(h.heap)
+}
+
+// Contains reports whether id is in the heap
+// Time Complexity: O(1)
+func (h *IndexedMinHeap[P]) Contains(id int) bool {
+	return id >= 0 && id < len(h.position) && h.position[id] != -1
+}
+
+// Priority returns the priority of id, or false if it is not in the heap
+func (h *IndexedMinHeap[P]) Priority(id int) (P, bool) {
+	if !h.Contains(id) {
+		var zero P
+		return zero, false
+	}
+	return h.priorities[id], true
+}
+
+// Push adds id with priority
+// Time Complexity: O(log n)
+func (h *IndexedMinHeap[P]) Push(id int, priority P) error {
+	if id < 0 || id >= len(h.position) {
+		return fmt.Errorf("id %d is outside 0..%d", id, len(h.position)-1)
+	}
+	if h.Contains(id) {
+		return fmt.Errorf("id %d is already in the heap", id)
+	}
+	h.priorities[id] = priority
+	h.position[id] = len(h.heap)
+	h.heap = append(h.heap, id)
+	h.up(len(h.heap) - 1)
+	return nil
+}
+
+// Peek returns the id with the smallest priority without removing it
+func (h *IndexedMinHeap[P]) Peek() (int, P, bool) {
+	if len(h.heap) == 0 {
+		var zero P
+		return -1, zero, false
+	}
+	id := h.heap[0]
+	return id, h.priorities[id], true
+}
+
+// Pop removes and returns the id with the smallest priority
+// Time Complexity: O(log n)
+func (h *IndexedMinHeap[P]) Pop() (int, P, bool) {
+	id, priority, ok := h.Peek()
+	if !ok {
+		return id, priority, false
+	}
+	last := len(h.heap) - 1
+	h.swap(0, last)
+	h.heap = h.heap[:last]
+	h.position[id] = -1
+	h.down(0)
+	return id, priority, true
+}
+
+// DecreaseKey lowers the priority of id, which must be in the heap
+// Time Complexity: O(log n)
+func (h *IndexedMinHeap[P]) DecreaseKey(id int, priority P) error {
+	if !h.Contains(id) {
+		return fmt.Errorf("id %d is not in the heap", id)
+	}
+	if priority > h.priorities[id] {
+		return fmt.Errorf("DecreaseKey(%d): new priority %v is above the current %v", id, priority, h.priorities[id])
+	}
+	h.priorities[id] = priority
+	h.up(h.position[id])
+	return nil
+}
+
+// Remove takes id out of the heap wherever it is, e.g. a cancelled task
+// Time Complexity: O(log n)
+func (h *IndexedMinHeap[P]) Remove(id int) error {
+	if !h.Contains(id) {
+		return fmt.Errorf("id %d is not in the heap", id)
+	}
+	i, last := h.position[id], len(h.heap)-1
+	h.swap(i, last)
+	h.heap = h.heap[:last]
+	h.position[id] = -1
+	if i < last {
+		// The entry moved into i came from a leaf; it may belong above or below
+		h.up(i)
+		h.down(h.position[h.heap[i]])
+	}
+	return nil
+}
+
+// PushOrDecrease adds id, or lowers its priority if it is already queued
+// and the new priority is smaller. This is the "relax" step of Dijkstra,
+// Prim and A*. Returns false if nothing changed.
+func (h *IndexedMinHeap[P]) PushOrDecrease(id int, priority P) bool {
+	if !h.Contains(id) {
+		return h.Push(id, priority) == nil
+	}
+	if priority >= h.priorities[id] {
+		return false
+	}
+	h.DecreaseKey(id, priority)
+	return true
+}
+
+func (h *IndexedMinHeap[P]) less(i, j int) bool {
+	return h.priorities[h.heap[i]] < h.priorities[h.heap[j]]
+}
+
+func (h *IndexedMinHeap[P]) swap(i, j int) {
+	h.heap[i], h.heap[j] = h.heap[j], h.heap[i]
+	h.position[h.heap[i]] = i
+	h.position[h.heap[j]] = j
+}
+
+// up moves the entry at i towards the root while it beats its parent
+func (h *IndexedMinHeap[P]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !h.less(i, parent) {
+			return
+		}
+		h.swap(i, parent)
+		i = parent
+	}
+}
+
+// down moves the entry at i towards the leaves while a child beats it
+func (h *IndexedMinHeap[P]) down(i int) {
+	for {
+		smallest, left, right := i, 2*i+1, 2*i+2
+		if left < len(h.heap) && h.less(left, smallest) {
+			smallest = left
+		}
+		if right < len(h.heap) && h.less(right, smallest) {
+			smallest = right
+		}
+		if smallest == i {
+			return
+		}
+		h.swap(i, smallest)
+		i = smallest
+	}
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoIndexedHeap demonstrates the indexed heap and checks it against a
+// brute-force reference
+func DemoIndexedHeap() {
+	fmt.Println("=== INDEXED MIN-HEAP ===")
+	fmt.Println()
+
+	// Example 1: Reprioritizing tasks
+	fmt.Println("=== EXAMPLE 1: Reprioritizing Tasks ===")
+	tasks := []string{"write report", "fix bug", "review PR", "deploy", "lunch"}
+	pq := NewIndexedMinHeap[int](len(tasks))
+	for id, priority := range []int{30, 20, 40, 50, 10} {
+		pq.Push(id, priority)
+	}
+	pq.DecreaseKey(3, 5) // deploy became urgent
+	pq.Remove(2)         // the PR was merged by someone else
+	fmt.Printf("Contains(deploy) = %v, Contains(review PR) = %v\n", pq.Contains(3), pq.Contains(2))
+	fmt.Println("DecreaseKey(deploy, 5), Remove(review PR); popping in priority order:")
+	for pq.Len() > 0 {
+		id, priority, _ := pq.Pop()
+		fmt.Printf("  %2d %s\n", priority, tasks[id])
+	}
+	fmt.Printf("Contains(deploy) after popping = %v\n", pq.Contains(3))
+
+	pq.Push(0, 30)
+	for _, err := range []error{pq.Push(0, 1), pq.DecreaseKey(0, 99), pq.DecreaseKey(1, 1), pq.Push(7, 1), pq.Remove(4)} {
+		fmt.Printf("  error: %v\n", err)
+	}
+	fmt.Println()
+
+	// Example 2: Random operations against a reference
+	fmt.Println("=== EXAMPLE 2: 100000 Random Operations vs Brute Force ===")
+	rng := rand.New(rand.NewSource(1029))
+	const ids = 500
+	checked := NewIndexedMinHeap[int](ids)
+	reference := map[int]int{} // id -> priority
+	mismatches := 0
+	for op := 0; op < 100000; op++ {
+		id := rng.Intn(ids)
+		switch rng.Intn(3) {
+		case 0:
+			if checked.Push(id, rng.Intn(1000)) == nil {
+				reference[id], _ = checked.Priority(id)
+			}
+		case 1:
+			if priority, ok := reference[id]; ok {
+				lower := priority - rng.Intn(100)
+				checked.DecreaseKey(id, lower)
+				reference[id] = lower
+			}
+		default:
+			id, priority, ok := checked.Pop()
+			lowest, found := math.MaxInt, false
+			for _, p := range reference {
+				lowest, found = min(lowest, p), true
+			}
+			if ok != found || (ok && (priority != lowest || reference[id] != priority)) {
+				mismatches++
+			}
+			delete(reference, id)
+		}
+		if checked.Len() != len(reference) {
+			mismatches++
+		}
+	}
+	fmt.Printf("Mismatches: %d\n", mismatches)
+	fmt.Println()
+
+	// Example 3: Queue size in Dijkstra
+	fmt.Println("=== EXAMPLE 3: Queue Traffic in Dijkstra (V=100000, E=500000) ===")
+	graph := randomSparseGraph(100_000, 500_000, rng)
+	newDistances := func() []float64 {
+		distances := make([]float64, graph.vertices)
+		for i := range distances {
+			distances[i] = math.Inf(1)
+		}
+		distances[0] = 0
+		return distances
+	}
+
+	distances := newDistances()
+	lazy := PriorityQueue{{vertex: 0, distance: 0}}
+	lazyPeak, pushes, stale := 1, 1, 0
+	for lazy.Len() > 0 {
+		item := heap.Pop(&lazy).(*PQItem)
+		if item.distance > distances[item.vertex] {
+			stale++
+			continue
+		}
+		for _, edge := range graph.adjList[item.vertex] {
+			if newDistance := distances[item.vertex] + edge.weight; newDistance < distances[edge.to] {
+				distances[edge.to] = newDistance
+				heap.Push(&lazy, &PQItem{vertex: edge.to, distance: newDistance})
+				pushes++
+				lazyPeak = max(lazyPeak, lazy.Len())
+			}
+		}
+	}
+	fmt.Printf("Lazy deletion: %6d pushes, %6d stale pops,    peak %d entries\n", pushes, stale, lazyPeak)
+
+	distances = newDistances()
+	indexed := NewIndexedMinHeap[float64](graph.vertices)
+	indexed.Push(0, 0)
+	indexedPeak, pushes, decreases := 1, 1, 0
+	for indexed.Len() > 0 {
+		u, _, _ := indexed.Pop()
+		for _, edge := range graph.adjList[u] {
+			if newDistance := distances[u] + edge.weight; newDistance < distances[edge.to] {
+				if indexed.Contains(edge.to) {
+					decreases++
+				} else {
+					pushes++
+				}
+				distances[edge.to] = newDistance
+				indexed.PushOrDecrease(edge.to, newDistance)
+				indexedPeak = max(indexedPeak, indexed.Len())
+			}
+		}
+	}
+	fmt.Printf("Indexed heap:  %6d pushes, %6d DecreaseKeys, peak %d entries\n", pushes, decreases, indexedPeak)
+	fmt.Println()
+
+	fmt.Println("The position table costs one int per id; in exchange every vertex is")
+	fmt.Println("queued once, and DecreaseKey replaces the duplicates a lazy heap must")
+	fmt.Println("store and later pop as stale.")
+	fmt.Println()
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestIndexedMinHeapPushPop(t *testing.T) {
+	h := NewIndexedMinHeap[int](6)
+	for id, priority := range []int{30, 20, 40, 50, 10, 20} {
+		if err := h.Push(id, priority); err != nil {
+			t.Fatalf("Push(%d, %d): %v", id, priority, err)
+		}
+	}
+	if h.Len() != 6 {
+		t.Fatalf("Len() = %d, want 6", h.Len())
+	}
+	if id, priority, ok := h.Peek(); !ok || id != 4 || priority != 10 {
+		t.Errorf("Peek() = %d, %d, %v; want 4, 10, true", id, priority, ok)
+	}
+
+	previous := math.MinInt
+	for h.Len() > 0 {
+		id, priority, ok := h.Pop()
+		if !ok || priority < previous {
+			t.Fatalf("Pop() = %d, %d, %v after priority %d", id, priority, ok, previous)
+		}
+		if h.Contains(id) {
+			t.Errorf("Contains(%d) after popping it", id)
+		}
+		previous = priority
+	}
+	if id, _, ok := h.Pop(); ok || id != -1 {
+		t.Errorf("Pop() on empty heap = %d, %v; want -1, false", id, ok)
+	}
+	if _, _, ok := h.Peek(); ok {
+		t.Error("Peek() on empty heap succeeded")
+	}
+}
+
+func TestIndexedMinHeapPushErrors(t *testing.T) {
+	h := NewIndexedMinHeap[float64](3)
+	if err := h.Push(1, 2.5); err != nil {
+		t.Fatalf("Push(1): %v", err)
+	}
+	for _, test := range []struct {
+		name string
+		id   int
+	}{{"duplicate", 1}, {"negative id", -1}, {"id past the end", 3}} {
+		if err := h.Push(test.id, 0); err == nil {
+			t.Errorf("Push %s (%d) succeeded", test.name, test.id)
+		}
+	}
+	if priority, ok := h.Priority(1); !ok || priority != 2.5 {
+		t.Errorf("Priority(1) = %v, %v after rejected pushes; want 2.5, true", priority, ok)
+	}
+	if h.Len() != 1 {
+		t.Errorf("Len() = %d after rejected pushes, want 1", h.Len())
+	}
+}
+
+func TestIndexedMinHeapContains(t *testing.T) {
+	h := NewIndexedMinHeap[int](2)
+	h.Push(0, 1)
+	for _, test := range []struct {
+		id   int
+		want bool
+	}{{0, true}, {1, false}, {-1, false}, {2, false}, {math.MaxInt, false}} {
+		if got := h.Contains(test.id); got != test.want {
+			t.Errorf("Contains(%d) = %v, want %v", test.id, got, test.want)
+		}
+	}
+	if _, ok := h.Priority(5); ok {
+		t.Error("Priority of an out-of-range id succeeded")
+	}
+	h.Grow(4)
+	if err := h.Push(3, 0); err != nil || !h.Contains(3) {
+		t.Errorf("Push(3) after Grow(4) = %v, Contains = %v", err, h.Contains(3))
+	}
+}
+
+func TestIndexedMinHeapDecreaseKey(t *testing.T) {
+	h := NewIndexedMinHeap[int](4)
+	for id, priority := range []int{10, 20, 30, 40} {
+		h.Push(id, priority)
+	}
+	if err := h.DecreaseKey(3, 5); err != nil {
+		t.Fatalf("DecreaseKey(3, 5): %v", err)
+	}
+	if id, priority, _ := h.Peek(); id != 3 || priority != 5 {
+		t.Errorf("Peek() = %d, %d after DecreaseKey; want 3, 5", id, priority)
+	}
+	if err := h.DecreaseKey(0, 10); err != nil {
+		t.Errorf("DecreaseKey to the same priority: %v", err)
+	}
+	if err := h.DecreaseKey(1, 25); err == nil {
+		t.Error("DecreaseKey to a higher priority succeeded")
+	}
+	if priority, _ := h.Priority(1); priority != 20 {
+		t.Errorf("Priority(1) = %d after rejected increase, want 20", priority)
+	}
+	h.Pop()
+	for _, id := range []int{3, -1, 4} {
+		if err := h.DecreaseKey(id, 0); err == nil {
+			t.Errorf("DecreaseKey(%d) of an id not in the heap succeeded", id)
+		}
+	}
+}
+
+func TestIndexedMinHeapPushOrDecrease(t *testing.T) {
+	h := NewIndexedMinHeap[int](2)
+	for _, test := range []struct {
+		id, priority int
+		want         bool
+	}{
+		{0, 10, true},  // new
+		{0, 5, true},   // lower
+		{0, 5, false},  // unchanged
+		{0, 7, false},  // higher is ignored
+		{2, 1, false},  // out of range
+		{-1, 1, false}, // out of range
+	} {
+		if got := h.PushOrDecrease(test.id, test.priority); got != test.want {
+			t.Errorf("PushOrDecrease(%d, %d) = %v, want %v", test.id, test.priority, got, test.want)
+		}
+	}
+	if priority, _ := h.Priority(0); priority != 5 {
+		t.Errorf("Priority(0) = %d, want 5", priority)
+	}
+}
+
+func TestIndexedMinHeapRemove(t *testing.T) {
+	h := NewIndexedMinHeap[int](7)
+	for id, priority := range []int{1, 5, 2, 6, 7, 3, 4} {
+		h.Push(id, priority)
+	}
+	for _, id := range []int{2, 0, 6} { // a middle entry, the root, the last leaf
+		if err := h.Remove(id); err != nil {
+			t.Fatalf("Remove(%d): %v", id, err)
+		}
+		if h.Contains(id) {
+			t.Errorf("Contains(%d) after Remove", id)
+		}
+	}
+	for _, id := range []int{2, -1, 7} {
+		if err := h.Remove(id); err == nil {
+			t.Errorf("Remove(%d) of an id not in the heap succeeded", id)
+		}
+	}
+	for _, id := range []int{5, 1, 3, 4} { // the remaining ids by priority 3, 5, 6, 7
+		if got, _, _ := h.Pop(); got != id {
+			t.Fatalf("Pop() = %d, want %d", got, id)
+		}
+	}
+}
+
+// TestIndexedMinHeapRandom compares random operations with a map
+func TestIndexedMinHeapRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1029))
+	const ids = 200
+	h := NewIndexedMinHeap[int](ids)
+	reference := map[int]int{} // id -> priority
+	for op := 0; op < 50_000; op++ {
+		id := rng.Intn(ids)
+		_, queued := reference[id]
+		switch rng.Intn(4) {
+		case 0:
+			priority := rng.Intn(1000)
+			if err := h.Push(id, priority); (err == nil) == queued {
+				t.Fatalf("op %d: Push(%d) = %v with id queued = %v", op, id, err, queued)
+			}
+			if !queued {
+				reference[id] = priority
+			}
+		case 1:
+			if !queued {
+				continue
+			}
+			lower := reference[id] - rng.Intn(100)
+			if err := h.DecreaseKey(id, lower); err != nil {
+				t.Fatalf("op %d: DecreaseKey(%d): %v", op, id, err)
+			}
+			reference[id] = lower
+		case 2:
+			if err := h.Remove(id); (err == nil) != queued {
+				t.Fatalf("op %d: Remove(%d) = %v with id queued = %v", op, id, err, queued)
+			}
+			delete(reference, id)
+		default:
+			id, priority, ok := h.Pop()
+			lowest, found := math.MaxInt, false
+			for _, p := range reference {
+				lowest, found = min(lowest, p), true
+			}
+			if ok != found || (ok && (priority != lowest || reference[id] != priority)) {
+				t.Fatalf("op %d: Pop() = %d, %d, %v; want priority %d, %v", op, id, priority, ok, lowest, found)
+			}
+			delete(reference, id)
+		}
+		if h.Len() != len(reference) {
+			t.Fatalf("op %d: Len() = %d, want %d", op, h.Len(), len(reference))
+		}
+	}
+}
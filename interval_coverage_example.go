@@ -0,0 +1,196 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"sort"
+)
+
+// ================================
+// ONLINE INTERVAL COVERAGE
+// ================================
+
+// coveredBlock is a maximal run of covered integers [lo, hi]
+type coveredBlock struct {
+	lo, hi int
+}
+
+// IntervalCoverage accepts integer ranges one at a time and keeps their
+// union as sorted, disjoint blocks. Ranges that overlap or touch ([1, 3]
+// and [4, 5]) merge into one block. Every range added is also an element
+// of a UnionFind, so merging blocks is a union and RangeBlock can still
+// answer, for the id Add returned, which block that range ended up in.
+type IntervalCoverage struct {
+	sets   *UnionFind
+	blocks map[int]coveredBlock // keyed by the set root
+	roots  []int                // set roots, ordered by their block's lo
+	total  int
+}
+
+// NewIntervalCoverage creates an empty coverage
+func NewIntervalCoverage() *IntervalCoverage {
+	return &IntervalCoverage{sets: NewUnionFind(0), blocks: map[int]coveredBlock{}}
+}
+
+// block returns the block at position i of roots
+func (c *IntervalCoverage) block(i int) coveredBlock {
+	return c.blocks[c.roots[i]]
+}
+
+// Add covers every integer in [l, r] and returns an id for the range.
+// The blocks overlapping or touching [l, r] are contiguous in roots, so two
+// binary searches find them; they are unioned with the new range and
+// replaced by a single block.
+// Time Complexity: O(log n + k) plus shifting roots, for k merged blocks
+func (c *IntervalCoverage) Add(l, r int) (int, error) {
+	if l > r {
+		return -1, fmt.Errorf("invalid range [%d, %d]: start after end", l, r)
+	}
+	id := c.sets.MakeSet()
+	first := sort.Search(len(c.roots), func(i int) bool { return c.block(i).hi >= l-1 })
+	last := sort.Search(len(c.roots), func(i int) bool { return c.block(i).lo > r+1 })
+
+	merged := coveredBlock{l, r}
+	for _, root := range c.roots[first:last] {
+		old := c.blocks[root]
+		merged.lo, merged.hi = min(merged.lo, old.lo), max(merged.hi, old.hi)
+		c.total -= old.hi - old.lo + 1
+		delete(c.blocks, root)
+		c.sets.Union(id, root)
+	}
+	root := c.sets.Find(id)
+	c.blocks[root] = merged
+	c.total += merged.hi - merged.lo + 1
+	c.roots = slices.Replace(c.roots, first, last, root)
+	return id, nil
+}
+
+// Covered reports whether x lies in some added range
+// Time Complexity: O(log n)
+func (c *IntervalCoverage) Covered(x int) bool {
+	i := sort.Search(len(c.roots), func(i int) bool { return c.block(i).hi >= x })
+	return i < len(c.roots) && c.block(i).lo <= x
+}
+
+// TotalCovered returns how many integers are covered
+// Time Complexity: O(1)
+func (c *IntervalCoverage) TotalCovered() int {
+	return c.total
+}
+
+// Blocks returns the merged ranges in increasing order
+func (c *IntervalCoverage) Blocks() [][2]int {
+	blocks := make([][2]int, len(c.roots))
+	for i := range c.roots {
+		blocks[i] = [2]int{c.block(i).lo, c.block(i).hi}
+	}
+	return blocks
+}
+
+// RangeBlock returns the merged block that now contains the range with the
+// given id, or false if no such range was added
+// Time Complexity: O(α(n))
+func (c *IntervalCoverage) RangeBlock(id int) ([2]int, bool) {
+	if id < 0 || id >= len(c.sets.parent) {
+		return [2]int{}, false
+	}
+	b := c.blocks[c.sets.Find(id)]
+	return [2]int{b.lo, b.hi}, true
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// formatIPv4 prints a 32-bit address in dotted notation
+func formatIPv4(address int) string {
+	return fmt.Sprintf("%d.%d.%d.%d", address>>24&255, address>>16&255, address>>8&255, address&255)
+}
+
+// parseIPv4 reads a dotted address; the demo only feeds it valid input
+func parseIPv4(text string) int {
+	var a, b, c, d int
+	fmt.Sscanf(text, "%d.%d.%d.%d", &a, &b, &c, &d)
+	return a<<24 | b<<16 | c<<8 | d
+}
+
+// DemoIntervalCoverage demonstrates merging ranges as they arrive
+func DemoIntervalCoverage() {
+	fmt.Println("=== ONLINE INTERVAL COVERAGE ===")
+	fmt.Println()
+
+	// Example 1: Firewall block list
+	fmt.Println("=== EXAMPLE 1: Blocked IP Ranges ===")
+	blocked := NewIntervalCoverage()
+	rules := [][2]string{
+		{"10.0.0.0", "10.0.0.255"},
+		{"10.0.2.0", "10.0.2.127"},
+		{"192.168.1.10", "192.168.1.20"},
+		{"10.0.1.0", "10.0.1.255"}, // bridges the first two
+		{"192.168.1.15", "192.168.1.40"},
+	}
+	ids := make([]int, len(rules))
+	for i, rule := range rules {
+		ids[i], _ = blocked.Add(parseIPv4(rule[0]), parseIPv4(rule[1]))
+		fmt.Printf("Add %-13s - %-13s -> %d blocks, %d addresses\n", rule[0], rule[1], len(blocked.Blocks()), blocked.TotalCovered())
+	}
+	for _, block := range blocked.Blocks() {
+		fmt.Printf("  Blocked: %s - %s\n", formatIPv4(block[0]), formatIPv4(block[1]))
+	}
+	for _, address := range []string{"10.0.1.77", "10.0.2.200", "192.168.1.30"} {
+		fmt.Printf("Covered(%s) = %v\n", address, blocked.Covered(parseIPv4(address)))
+	}
+	block, _ := blocked.RangeBlock(ids[0])
+	fmt.Printf("Rule 1 now belongs to block %s - %s\n", formatIPv4(block[0]), formatIPv4(block[1]))
+	fmt.Println()
+
+	// Example 2: Room bookings by day of the year
+	fmt.Println("=== EXAMPLE 2: Days a Room Is Booked ===")
+	bookings := NewIntervalCoverage()
+	for _, stay := range [][2]int{{10, 14}, {20, 22}, {15, 19}, {40, 45}, {43, 50}} {
+		bookings.Add(stay[0], stay[1])
+		fmt.Printf("Book days %d-%d: occupied %v, %d days\n", stay[0], stay[1], bookings.Blocks(), bookings.TotalCovered())
+	}
+	if _, err := bookings.Add(30, 25); err != nil {
+		fmt.Printf("Book days 30-25: %v\n", err)
+	}
+	fmt.Println()
+
+	// Example 3: Cross-check against marking every integer
+	fmt.Println("=== EXAMPLE 3: 500 Random Sequences vs a Bitmap ===")
+	rng := rand.New(rand.NewSource(1040))
+	mismatches := 0
+	for trial := 0; trial < 500; trial++ {
+		coverage := NewIntervalCoverage()
+		marked := make([]bool, 200)
+		for step := 0; step < 20; step++ {
+			l := rng.Intn(200)
+			r := min(199, l+rng.Intn(15))
+			coverage.Add(l, r)
+			for x := l; x <= r; x++ {
+				marked[x] = true
+			}
+		}
+		count := 0
+		for x, isMarked := range marked {
+			if isMarked {
+				count++
+			}
+			if coverage.Covered(x) != isMarked {
+				mismatches++
+			}
+		}
+		if coverage.TotalCovered() != count {
+			mismatches++
+		}
+	}
+	fmt.Printf("Mismatches in Covered and TotalCovered: %d\n", mismatches)
+	fmt.Println()
+
+	fmt.Println("Unlike merging a finished list, the coverage stays merged after every")
+	fmt.Println("Add, so lookups never wait for a batch. The sorted blocks answer Covered")
+	fmt.Println("by binary search; the union-find remembers which block each original")
+	fmt.Println("range was absorbed into, which the blocks alone would forget.")
+	fmt.Println()
+}
@@ -0,0 +1,202 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+)
+
+// ================================
+// FENWICK TREE (BINARY INDEXED TREE)
+// ================================
+
+// FenwickTree maintains prefix sums over positions 1..n under point updates.
+// tree[i] holds the sum of the range (i - lowbit(i), i], where lowbit(i) is
+// the lowest set bit of i, so any prefix splits into O(log n) such ranges.
+type FenwickTree struct {
+	tree []int
+}
+
+// NewFenwickTree creates a tree over positions 1..n, all zero
+func NewFenwickTree(n int) *FenwickTree {
+	return &FenwickTree{tree: make([]int, n+1)}
+}
+
+// Add adds delta at position i (1-based)
+// Time Complexity: O(log n)
+func (f *FenwickTree) Add(i, delta int) {
+	for ; i < len(f.tree); i += i & -i {
+		f.tree[i] += delta
+	}
+}
+
+// PrefixSum returns the sum of positions 1..i
+// Time Complexity: O(log n)
+func (f *FenwickTree) PrefixSum(i int) int {
+	sum := 0
+	for ; i > 0; i -= i & -i {
+		sum += f.tree[i]
+	}
+	return sum
+}
+
+// ================================
+// INVERSION COUNTING
+// ================================
+// An inversion is a pair i < j with arr[i] > arr[j]. The count measures how
+// far an array is from sorted: 0 when sorted, n(n-1)/2 when reversed.
+
+// CountInversions counts inversions with merge sort: when an element of the
+// right half is merged before the remaining elements of the left half, it
+// forms an inversion with every one of them.
+// Time Complexity: O(n log n)
+// Space Complexity: O(n)
+func CountInversions(arr []int) int {
+	values := append([]int(nil), arr...)
+	buffer := make([]int, len(arr))
+
+	var sortCount func(lo, hi int) int
+	sortCount = func(lo, hi int) int {
+		if hi-lo < 2 {
+			return 0
+		}
+		mid := (lo + hi) / 2
+		count := sortCount(lo, mid) + sortCount(mid, hi)
+
+		i, j, k := lo, mid, lo
+		for i < mid && j < hi {
+			if values[j] < values[i] {
+				count += mid - i // values[j] is smaller than all of values[i:mid]
+				buffer[k] = values[j]
+				j++
+			} else {
+				buffer[k] = values[i]
+				i++
+			}
+			k++
+		}
+		k += copy(buffer[k:], values[i:mid])
+		copy(buffer[k:], values[j:hi])
+		copy(values[lo:hi], buffer[lo:hi])
+		return count
+	}
+	return sortCount(0, len(values))
+}
+
+// CountInversionsFenwick counts inversions with a Fenwick tree over value
+// ranks: scanning left to right, the elements already seen that are greater
+// than arr[j] are the seen count minus those with rank <= rank(arr[j]).
+// Time Complexity: O(n log n)
+// Space Complexity: O(n)
+func CountInversionsFenwick(arr []int) int {
+	// Compress values to ranks 1..m so the tree size does not depend on magnitude
+	sorted := append([]int(nil), arr...)
+	sort.Ints(sorted)
+	ranks := make(map[int]int, len(sorted))
+	for _, v := range sorted {
+		if _, seen := ranks[v]; !seen {
+			ranks[v] = len(ranks) + 1
+		}
+	}
+
+	tree := NewFenwickTree(len(ranks))
+	count := 0
+	for seen, v := range arr {
+		count += seen - tree.PrefixSum(ranks[v])
+		tree.Add(ranks[v], 1)
+	}
+	return count
+}
+
+// countInversionsBrute checks every pair
+// Time Complexity: O(n²)
+func countInversionsBrute(arr []int) int {
+	count := 0
+	for i := range arr {
+		for j := i + 1; j < len(arr); j++ {
+			if arr[i] > arr[j] {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoInversionCount demonstrates and cross-checks both inversion counters
+func DemoInversionCount() {
+	fmt.Println("=== INVERSION COUNTING ===")
+	fmt.Println()
+
+	// Example 1: Small arrays
+	fmt.Println("=== EXAMPLE 1: Small Arrays ===")
+	for _, arr := range [][]int{
+		{2, 4, 1, 3, 5},
+		{1, 2, 3, 4, 5},
+		{5, 4, 3, 2, 1},
+		{3, 1, 3, 1},
+		{},
+	} {
+		fmt.Printf("%-13s merge sort: %2d, Fenwick: %2d\n", fmt.Sprint(arr), CountInversions(arr), CountInversionsFenwick(arr))
+	}
+	fmt.Println()
+
+	// Example 2: Randomized cross-check
+	fmt.Println("=== EXAMPLE 2: Cross-Check Against Brute Force ===")
+	rng := rand.New(rand.NewSource(22))
+	mismatches := 0
+	trials := 500
+	for t := 0; t < trials; t++ {
+		arr := make([]int, rng.Intn(60))
+		for i := range arr {
+			arr[i] = rng.Intn(20) - 10 // small range forces many duplicates
+		}
+		want := countInversionsBrute(arr)
+		if CountInversions(arr) != want || CountInversionsFenwick(arr) != want {
+			mismatches++
+		}
+	}
+	fmt.Printf("%d random arrays, mismatches: %d\n\n", trials, mismatches)
+
+	// Example 3: Ranking similarity
+	fmt.Println("=== EXAMPLE 3: Comparing Two Rankings ===")
+	judgeA := []string{"Ada", "Grace", "Alan", "Edsger", "Barbara"}
+	judgeB := []string{"Grace", "Ada", "Edsger", "Barbara", "Alan"}
+	position := map[string]int{}
+	for i, name := range judgeA {
+		position[name] = i
+	}
+	order := make([]int, len(judgeB))
+	for i, name := range judgeB {
+		order[i] = position[name]
+	}
+	disagreements := CountInversions(order)
+	fmt.Printf("Judge A: %v\nJudge B: %v\n", judgeA, judgeB)
+	fmt.Printf("Pairs ranked in opposite order: %d of %d\n\n", disagreements, len(order)*(len(order)-1)/2)
+
+	// Example 4: Timing
+	fmt.Println("=== EXAMPLE 4: Timing (n = 1,000,000) ===")
+	arr := make([]int, 1_000_000)
+	for i := range arr {
+		arr[i] = rng.Intn(1_000_000_000)
+	}
+	start := time.Now()
+	mergeCount := CountInversions(arr)
+	mergeTime := time.Since(start)
+	start = time.Now()
+	fenwickCount := CountInversionsFenwick(arr)
+	fenwickTime := time.Since(start)
+	fmt.Printf("Merge sort: %d in %v\n", mergeCount, mergeTime)
+	fmt.Printf("Fenwick:    %d in %v (includes sorting for rank compression)\n", fenwickCount, fenwickTime)
+	fmt.Println()
+
+	fmt.Println("Merge sort counts inversions as a by-product of divide and conquer;")
+	fmt.Println("the Fenwick tree answers \"how many earlier elements are larger\" online,")
+	fmt.Println("one element at a time, so it also works on a stream whose value range")
+	fmt.Println("is known in advance (no rank compression needed).")
+	fmt.Println()
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestCountInversionsKnownValues(t *testing.T) {
+	for _, test := range []struct {
+		arr  []int
+		want int
+	}{
+		{nil, 0},
+		{[]int{7}, 0},
+		{[]int{1, 2, 3, 4}, 0},
+		{[]int{4, 3, 2, 1}, 6},
+		{[]int{2, 4, 1, 3, 5}, 3},
+		{[]int{3, 3, 3}, 0}, // equal values are not inversions
+		{[]int{2, 1, 2, 1}, 3},
+		{[]int{-5, 10, -20, 0}, 3},
+	} {
+		if got := CountInversions(test.arr); got != test.want {
+			t.Errorf("CountInversions(%v) = %d, want %d", test.arr, got, test.want)
+		}
+		if got := CountInversionsFenwick(test.arr); got != test.want {
+			t.Errorf("CountInversionsFenwick(%v) = %d, want %d", test.arr, got, test.want)
+		}
+	}
+}
+
+// TestCountInversionsCrossCheck compares merge sort, Fenwick tree and the
+// O(n²) pair check on random arrays with many duplicates and wide values
+func TestCountInversionsCrossCheck(t *testing.T) {
+	rng := rand.New(rand.NewSource(1022))
+	for trial := 0; trial < 500; trial++ {
+		arr := make([]int, rng.Intn(60))
+		spread := 1 + rng.Intn(10)
+		if trial%2 == 1 {
+			spread = 1 << 40
+		}
+		for i := range arr {
+			arr[i] = rng.Intn(spread) - spread/2
+		}
+		original := slices.Clone(arr)
+		brute := countInversionsBrute(arr)
+		if merge := CountInversions(arr); merge != brute {
+			t.Fatalf("CountInversions(%v) = %d, brute force %d", arr, merge, brute)
+		}
+		if fenwick := CountInversionsFenwick(arr); fenwick != brute {
+			t.Fatalf("CountInversionsFenwick(%v) = %d, brute force %d", arr, fenwick, brute)
+		}
+		if !slices.Equal(arr, original) {
+			t.Fatalf("input modified: %v, was %v", arr, original)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"container/heap"
+	"fmt"
+	"sort"
+)
+
+// ================================
+// IPO: MAXIMIZE CAPITAL
+// ================================
+
+// Project needs Capital on hand to start and adds Profit when finished;
+// the capital is not spent
+type Project struct {
+	Name    string
+	Capital int
+	Profit  int
+}
+
+// profitHeap is a max-heap of projects by profit
+type profitHeap []Project
+
+func (h profitHeap) Len() int           { return len(h) }
+func (h profitHeap) Less(i, j int) bool { return h[i].Profit > h[j].Profit }
+func (h profitHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *profitHeap) Push(x interface{}) { *h = append(*h, x.(Project)) }
+
+func (h *profitHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[0 : n-1]
+	return item
+}
+
+// MaximizeCapital finishes at most k projects one after another, starting
+// with capital, and returns the final capital and the projects in order.
+// Greedy: move every project that has become affordable into a max-heap
+// by profit and do the most profitable one. Profits are never negative,
+// so doing the best affordable project never makes a later one unaffordable.
+// Time Complexity: O(n log n)
+// Space Complexity: O(n)
+func MaximizeCapital(projects []Project, capital, k int) (int, []Project) {
+	byCapital := append([]Project(nil), projects...)
+	sort.SliceStable(byCapital, func(i, j int) bool {
+		return byCapital[i].Capital < byCapital[j].Capital
+	})
+
+	affordable := &profitHeap{}
+	done := []Project{}
+	next := 0
+	for len(done) < k {
+		for next < len(byCapital) && byCapital[next].Capital <= capital {
+			heap.Push(affordable, byCapital[next])
+			next++
+		}
+		if affordable.Len() == 0 {
+			break // nothing left that we can afford
+		}
+		project := heap.Pop(affordable).(Project)
+		capital += project.Profit
+		done = append(done, project)
+	}
+	return capital, done
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoMaximizeCapital demonstrates picking projects with two-phase greed:
+// sort by requirement, then a heap by reward
+func DemoMaximizeCapital() {
+	fmt.Println("=== IPO: MAXIMIZE CAPITAL ===")
+	fmt.Println()
+
+	fmt.Println("A project can start once its required capital is on hand and adds its")
+	fmt.Println("profit when done. With at most k projects, end with the most capital.")
+	fmt.Println()
+
+	projects := []Project{
+		{"Website", 0, 1},
+		{"App", 1, 2},
+		{"Consulting", 1, 3},
+		{"Hardware", 4, 10},
+		{"Franchise", 20, 50},
+	}
+	fmt.Println("Projects (capital needed, profit):")
+	for _, project := range projects {
+		fmt.Printf("  %-11s %3d %3d\n", project.Name, project.Capital, project.Profit)
+	}
+
+	for _, k := range []int{1, 3, 5} {
+		final, done := MaximizeCapital(projects, 0, k)
+		names := []string{}
+		for _, project := range done {
+			names = append(names, project.Name)
+		}
+		fmt.Printf("k=%d: capital %d after %v\n", k, final, names)
+	}
+	fmt.Println("Franchise never becomes affordable, so k=5 stops after four projects.")
+	fmt.Println()
+
+	fmt.Println("Time Complexity: O(n log n) - each project enters and leaves the heap once")
+	fmt.Println()
+}
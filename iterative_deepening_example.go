@@ -0,0 +1,248 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// ================================
+// MEMORY-BOUNDED SEARCH
+// ================================
+
+// DeepeningStats counts the work of an iterative-deepening search
+type DeepeningStats struct {
+	Iterations int // depth or cost bounds tried
+	Expanded   int // states whose neighbors were listed, over all iterations
+	MaxDepth   int // longest path held in memory at once
+}
+
+// IterativeDeepeningDFS finds a path from source to a goal state with the
+// fewest arcs (weights are ignored) by running depth-limited DFS with
+// limits 0, 1, 2, ... up to maxDepth. Like BFS it finds a shallowest goal,
+// but like DFS it only keeps the current path, so memory is O(depth)
+// instead of the whole frontier. The shallow levels are searched again on
+// every iteration, which costs little when the branching factor is above 2.
+// It stops early with ok false once a limit cuts nothing off, since every
+// simple path from source has then been searched.
+// Time Complexity: O(b^d) for branching factor b and goal depth d
+func IterativeDeepeningDFS[V comparable](source V, neighbors func(V) []Arc[V], goal func(V) bool, maxDepth int) (path []V, stats DeepeningStats, ok bool) {
+	path = []V{source}
+	onPath := map[V]bool{source: true}
+	var search func(limit int) (found, cutoff bool)
+	search = func(limit int) (bool, bool) {
+		state := path[len(path)-1]
+		stats.MaxDepth = max(stats.MaxDepth, len(path)-1)
+		if goal(state) {
+			return true, false
+		}
+		if limit == 0 {
+			return false, true
+		}
+		stats.Expanded++
+		cutoff := false
+		for _, arc := range neighbors(state) {
+			if onPath[arc.To] {
+				continue // a cycle cannot be part of a shortest path
+			}
+			path = append(path, arc.To)
+			onPath[arc.To] = true
+			found, cut := search(limit - 1)
+			if found {
+				return true, false
+			}
+			cutoff = cutoff || cut
+			onPath[arc.To] = false
+			path = path[:len(path)-1]
+		}
+		return false, cutoff
+	}
+
+	for limit := 0; limit <= maxDepth; limit++ {
+		stats.Iterations++
+		found, cutoff := search(limit)
+		if found {
+			return path, stats, true
+		}
+		if !cutoff {
+			break
+		}
+	}
+	return nil, stats, false
+}
+
+// IDAStar is iterative deepening on f = cost so far + heuristic instead of
+// depth: each iteration is a DFS that prunes states whose f exceeds the
+// bound, and the next bound is the smallest f that was pruned. With an
+// admissible heuristic (one that never overestimates) the first goal found
+// is optimal, and memory stays O(depth) like IterativeDeepeningDFS.
+// Time Complexity: exponential in the worst case; a good heuristic cuts
+// the effective branching factor dramatically
+func IDAStar[V comparable](source V, neighbors func(V) []Arc[V], heuristic func(V) float64, goal func(V) bool) (path []V, cost float64, stats DeepeningStats, ok bool) {
+	path = []V{source}
+	onPath := map[V]bool{source: true}
+	var search func(g, bound float64) (found bool, nextBound float64)
+	search = func(g, bound float64) (bool, float64) {
+		state := path[len(path)-1]
+		stats.MaxDepth = max(stats.MaxDepth, len(path)-1)
+		if f := g + heuristic(state); f > bound {
+			return false, f
+		}
+		if goal(state) {
+			cost = g
+			return true, g
+		}
+		stats.Expanded++
+		next := math.Inf(1)
+		for _, arc := range neighbors(state) {
+			if onPath[arc.To] {
+				continue
+			}
+			path = append(path, arc.To)
+			onPath[arc.To] = true
+			found, t := search(g+arc.Weight, bound)
+			if found {
+				return true, t
+			}
+			next = math.Min(next, t)
+			onPath[arc.To] = false
+			path = path[:len(path)-1]
+		}
+		return false, next
+	}
+
+	for bound := heuristic(source); !math.IsInf(bound, 1); {
+		stats.Iterations++
+		found, next := search(0, bound)
+		if found {
+			return path, cost, stats, true
+		}
+		bound = next
+	}
+	return nil, math.Inf(1), stats, false
+}
+
+// ================================
+// 8-PUZZLE HEURISTICS
+// ================================
+
+// eightPuzzleGoal is the solved board, row by row, with 0 as the blank
+const eightPuzzleGoal = "123456780"
+
+// manhattanHeuristic sums how far each tile is from its goal cell; a move
+// shifts one tile by one cell, so it never overestimates
+func manhattanHeuristic(board string) float64 {
+	total := 0
+	for cell := 0; cell < len(board); cell++ {
+		if board[cell] == '0' {
+			continue
+		}
+		target := int(board[cell] - '1')
+		total += abs(cell/3-target/3) + abs(cell%3-target%3)
+	}
+	return float64(total)
+}
+
+// misplacedHeuristic counts tiles not on their goal cell: admissible, but
+// weaker than Manhattan distance
+func misplacedHeuristic(board string) float64 {
+	misplaced := 0
+	for cell := 0; cell < len(board); cell++ {
+		if board[cell] != '0' && board[cell] != eightPuzzleGoal[cell] {
+			misplaced++
+		}
+	}
+	return float64(misplaced)
+}
+
+// scrambledPuzzle walks randomly from the goal, so the board is always
+// solvable, and returns a board whose optimal solution is exactly depth
+// moves long (found with DijkstraFunc)
+func scrambledPuzzle(depth int, rng *rand.Rand) string {
+	moves := slidingPuzzleMoves(3, 3)
+	for {
+		board := eightPuzzleGoal
+		for i := 0; i < 4*depth; i++ {
+			options := moves(board)
+			board = options[rng.Intn(len(options))].To
+		}
+		paths, _ := DijkstraFunc(board, moves, func(b string) bool { return b == eightPuzzleGoal })
+		if distance, _ := paths.Distance(eightPuzzleGoal); int(distance) == depth {
+			return board
+		}
+	}
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoIterativeDeepening solves 8-puzzles with IDDFS and IDA* and compares
+// their memory with a search that stores every state
+func DemoIterativeDeepening() {
+	fmt.Println("=== ITERATIVE DEEPENING AND IDA* ===")
+	fmt.Println()
+	moves := slidingPuzzleMoves(3, 3)
+	isGoal := func(board string) bool { return board == eightPuzzleGoal }
+	printBoard := func(board string) {
+		for r := 0; r < 3; r++ {
+			fmt.Printf("  %s\n", board[3*r:3*r+3])
+		}
+	}
+
+	// Example 1: A shallow puzzle, every method
+	fmt.Println("=== EXAMPLE 1: A 12-Move Puzzle ===")
+	rng := rand.New(rand.NewSource(1050))
+	board := scrambledPuzzle(12, rng)
+	printBoard(board)
+	fmt.Printf("%-22s %6s %10s %12s %10s\n", "Method", "Moves", "Expanded", "States kept", "Time")
+	start := time.Now()
+	path, stats, _ := IterativeDeepeningDFS(board, moves, isGoal, 40)
+	fmt.Printf("%-22s %6d %10d %12d %10v\n", "IDDFS", len(path)-1, stats.Expanded, stats.MaxDepth+1, time.Since(start).Round(time.Microsecond))
+	for _, h := range []struct {
+		name      string
+		heuristic func(string) float64
+	}{{"IDA* (misplaced)", misplacedHeuristic}, {"IDA* (Manhattan)", manhattanHeuristic}} {
+		start = time.Now()
+		_, cost, stats, _ := IDAStar(board, moves, h.heuristic, isGoal)
+		fmt.Printf("%-22s %6.0f %10d %12d %10v\n", h.name, cost, stats.Expanded, stats.MaxDepth+1, time.Since(start).Round(time.Microsecond))
+	}
+	start = time.Now()
+	dijkstra, _ := DijkstraFunc(board, moves, isGoal)
+	distance, _ := dijkstra.Distance(eightPuzzleGoal)
+	fmt.Printf("%-22s %6.0f %10d %12d %10v\n", "DijkstraFunc", distance, dijkstra.Settled, dijkstra.Discovered(), time.Since(start).Round(time.Microsecond))
+	fmt.Println()
+
+	// Example 2: The hardest 8-puzzle
+	hardest := "867254301"
+	fmt.Println("=== EXAMPLE 2: The Hardest 8-Puzzle (31 Moves) ===")
+	printBoard(hardest)
+	start = time.Now()
+	path, cost, stats, _ := IDAStar(hardest, moves, manhattanHeuristic, isGoal)
+	fmt.Printf("IDA* (Manhattan): %.0f moves, %d bounds tried, %d expansions, %d states kept, %v\n",
+		cost, stats.Iterations, stats.Expanded, stats.MaxDepth+1, time.Since(start).Round(time.Millisecond))
+	start = time.Now()
+	dijkstra, _ = DijkstraFunc(hardest, moves, isGoal)
+	distance, _ = dijkstra.Distance(eightPuzzleGoal)
+	fmt.Printf("DijkstraFunc:     %.0f moves, %d settled, %d states kept, %v\n",
+		distance, dijkstra.Settled, dijkstra.Discovered(), time.Since(start).Round(time.Millisecond))
+	fmt.Printf("First moves of the solution: %v ...\n", path[:4])
+	fmt.Println()
+
+	// Example 3: An unsolvable board
+	fmt.Println("=== EXAMPLE 3: Unsolvable Board (Two Tiles Swapped) ===")
+	_, stats, ok := IterativeDeepeningDFS("213456780", moves, isGoal, 16)
+	fmt.Printf("IDDFS up to depth 16: found %v after %d limits and %d expansions; it cannot\n", ok, stats.Iterations, stats.Expanded)
+	fmt.Println("tell \"deeper\" from \"never\", since simple paths keep getting longer")
+	unreachable, _ := DijkstraFunc("213456780", moves, isGoal)
+	fmt.Printf("DijkstraFunc: found %v after exhausting all %d reachable boards\n", unreachable.Found, unreachable.Settled)
+	fmt.Println()
+
+	fmt.Println("Both iterative-deepening searches keep only the current path: here at")
+	fmt.Println("most 32 boards, against the tens of thousands a best-first search has to")
+	fmt.Println("remember. They pay by re-expanding states, and a better heuristic is")
+	fmt.Println("what keeps that affordable. That is the same trade Morris traversal")
+	fmt.Println("makes for trees: extra work in exchange for O(1) or O(depth) memory.")
+	fmt.Println()
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// bestProfitBrute tries every subset of jobs; a subset is feasible if its
+// k-th earliest deadline is at least k
+func bestProfitBrute(jobs []Job) int {
+	best := 0
+	for mask := 0; mask < 1<<len(jobs); mask++ {
+		deadlines, profit := []int{}, 0
+		for i, job := range jobs {
+			if mask&(1<<i) != 0 {
+				deadlines = append(deadlines, job.Deadline)
+				profit += job.Profit
+			}
+		}
+		sort.Ints(deadlines)
+		feasible := true
+		for k, deadline := range deadlines {
+			feasible = feasible && deadline >= k+1
+		}
+		if feasible && profit > best {
+			best = profit
+		}
+	}
+	return best
+}
+
+func TestJobSequencingMatchesBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(1012))
+	for trial := 0; trial < 300; trial++ {
+		jobs := make([]Job, rng.Intn(10))
+		for i := range jobs {
+			deadline := rng.Intn(12) - 1 // includes 0, -1 and deadlines past len(jobs)
+			jobs[i] = Job{string(rune('a' + i)), deadline, 1 + rng.Intn(50)}
+		}
+		want := bestProfitBrute(jobs)
+		for name, solve := range map[string]func([]Job) JobSchedule{"Greedy": JobSequencingGreedy, "DSU": JobSequencingDSU} {
+			if got := solve(jobs); got.Profit != want {
+				t.Fatalf("%s(%v) profit = %d, want %d", name, jobs, got.Profit, want)
+			}
+		}
+	}
+}
+
+// TestJobSequencingHugeDeadline would try to allocate math.MaxInt slots if
+// the slot count were not capped by the number of jobs
+func TestJobSequencingHugeDeadline(t *testing.T) {
+	jobs := []Job{{"a", math.MaxInt, 10}, {"b", 1, 5}, {"c", 1_000_000_000, 7}}
+	for name, solve := range map[string]func([]Job) JobSchedule{"Greedy": JobSequencingGreedy, "DSU": JobSequencingDSU} {
+		schedule := solve(jobs)
+		if schedule.Profit != 22 || len(schedule.Slots) != 3 || schedule.Slots[0].ID != "b" {
+			t.Errorf("%s = %+v, want all three jobs with b first", name, schedule)
+		}
+	}
+}
@@ -2,7 +2,11 @@ package main
 
 import (
 	"fmt"
+	"math/rand"
 	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
 )
 
 // ================================
@@ -175,10 +179,428 @@ func buildLPS(pattern string) []int {
 	return lps
 }
 
+// ================================
+// UNICODE AND CASE-INSENSITIVE MATCHING
+// ================================
+
+// MatchOptions selects how KMPSearchMatches compares text and pattern
+type MatchOptions struct {
+	Runes      bool // compare whole runes instead of bytes
+	IgnoreCase bool // compare case-insensitively (ASCII only in byte mode)
+}
+
+// Match is one occurrence of the pattern, located both ways: Byte for
+// slicing the string, Rune for counting characters
+type Match struct {
+	Byte int
+	Rune int
+}
+
+// KMPSearchMatches finds all occurrences of pattern in text. In rune mode
+// with IgnoreCase, runes are compared by simple Unicode case folding, so
+// 'é' matches 'É' and the Kelvin sign matches 'k'; foldings that change
+// length, like 'ß' to "ss", are not applied.
+// Time Complexity: O(n + m)
+func KMPSearchMatches(text, pattern string, opts MatchOptions) []Match {
+	matches := []Match{}
+	if len(pattern) == 0 {
+		return matches
+	}
+
+	if !opts.Runes {
+		t, p := []byte(text), []byte(pattern)
+		if opts.IgnoreCase {
+			for _, bytes := range [][]byte{t, p} {
+				for i, b := range bytes {
+					if 'A' <= b && b <= 'Z' {
+						bytes[i] = b + 'a' - 'A'
+					}
+				}
+			}
+		}
+		runes, last := 0, 0
+		for _, offset := range kmpSearchUnits(t, p) {
+			runes += utf8.RuneCountInString(text[last:offset])
+			last = offset
+			matches = append(matches, Match{Byte: offset, Rune: runes})
+		}
+		return matches
+	}
+
+	t, p := []rune(text), []rune(pattern)
+	byteOffsets := make([]int, 0, len(t))
+	for offset := range text {
+		byteOffsets = append(byteOffsets, offset)
+	}
+	if opts.IgnoreCase {
+		for _, runes := range [][]rune{t, p} {
+			for i, r := range runes {
+				runes[i] = foldRune(r)
+			}
+		}
+	}
+	for _, offset := range kmpSearchUnits(t, p) {
+		matches = append(matches, Match{Byte: byteOffsets[offset], Rune: offset})
+	}
+	return matches
+}
+
+// foldRune maps every rune of a case-folding orbit (k, K and the Kelvin
+// sign, for example) to the orbit's smallest member
+func foldRune(r rune) rune {
+	smallest := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		smallest = min(smallest, f)
+	}
+	return smallest
+}
+
+// kmpSearchUnits is KMPSearchSimple over any comparable units
+func kmpSearchUnits[T comparable](text, pattern []T) []int {
+	lps := make([]int, len(pattern))
+	for i, length := 1, 0; i < len(pattern); {
+		if pattern[i] == pattern[length] {
+			length++
+			lps[i] = length
+			i++
+		} else if length != 0 {
+			length = lps[length-1]
+		} else {
+			i++
+		}
+	}
+
+	matches := []int{}
+	for i, j := 0, 0; i < len(text); {
+		if text[i] == pattern[j] {
+			i++
+			j++
+			if j == len(pattern) {
+				matches = append(matches, i-j)
+				j = lps[j-1]
+			}
+		} else if j != 0 {
+			j = lps[j-1]
+		} else {
+			i++
+		}
+	}
+	return matches
+}
+
+// ================================
+// FIND AND REPLACE
+// ================================
+
+// OverlapPolicy decides what ReplaceFunc does when matches overlap, as
+// "aa" does three times in "aaaa"
+type OverlapPolicy int
+
+const (
+	// SkipOverlaps replaces the leftmost match and skips any match that
+	// overlaps one already replaced, like strings.ReplaceAll
+	SkipOverlaps OverlapPolicy = iota
+	// MergeOverlaps joins a chain of overlapping matches into one span and
+	// replaces the span once
+	MergeOverlaps
+)
+
+// ReplaceAll replaces every non-overlapping occurrence of pattern in text,
+// scanning left to right. An empty pattern matches nothing, so text comes
+// back unchanged.
+// Time Complexity: O(n + m) plus the size of the output
+func ReplaceAll(text, pattern, replacement string) string {
+	return ReplaceFunc(text, pattern, SkipOverlaps, func(string) string { return replacement })
+}
+
+// ReplaceFunc replaces occurrences of pattern in text with replace(span),
+// where span is the text being replaced: the match itself, or a whole
+// chain of overlapping matches under MergeOverlaps.
+// Time Complexity: O(n + m) plus the size of the output
+func ReplaceFunc(text, pattern string, policy OverlapPolicy, replace func(span string) string) string {
+	var result strings.Builder
+	last := 0 // end of the text already copied or replaced
+	matches := KMPSearchSimple(text, pattern)
+	for i := 0; i < len(matches); i++ {
+		start, end := matches[i], matches[i]+len(pattern)
+		if start < last {
+			continue // overlaps a span already replaced
+		}
+		if policy == MergeOverlaps {
+			for i+1 < len(matches) && matches[i+1] < end {
+				i++
+				end = matches[i] + len(pattern)
+			}
+		}
+		result.WriteString(text[last:start])
+		result.WriteString(replace(text[start:end]))
+		last = end
+	}
+	result.WriteString(text[last:])
+	return result.String()
+}
+
+// ================================
+// ROTATIONS AND PERIODS
+// ================================
+
+// RotationOffset returns k such that b == a[k:] + a[:k], the smallest if
+// there are several, or false if b is not a rotation of a. Every rotation
+// of a is a substring of a+a, so this is one KMP search.
+// Time Complexity: O(n)
+func RotationOffset(a, b string) (int, bool) {
+	if len(a) != len(b) {
+		return 0, false
+	}
+	if len(a) == 0 {
+		return 0, true
+	}
+	matches := KMPSearchSimple(a+a[:len(a)-1], b) // a+a minus its last byte: no duplicate of offset 0
+	if len(matches) == 0 {
+		return 0, false
+	}
+	return matches[0], true
+}
+
+// IsRotation reports whether b is a rotation of a, e.g. "erbottlewat" of
+// "waterbottle"
+// Time Complexity: O(n)
+func IsRotation(a, b string) bool {
+	_, ok := RotationOffset(a, b)
+	return ok
+}
+
+// Borders returns the lengths of every border of s (a proper, non-empty
+// prefix that is also a suffix), longest first. The longest is lps[n-1];
+// a border of a border is again a border, and every shorter border is one
+// of the longest, so following lps from there lists them all.
+// Time Complexity: O(n)
+func Borders(s string) []int {
+	borders := []int{}
+	if len(s) == 0 {
+		return borders
+	}
+	lps := buildLPS(s)
+	for border := lps[len(s)-1]; border > 0; border = lps[border-1] {
+		borders = append(borders, border)
+	}
+	return borders
+}
+
+// Periods returns every period of s in increasing order: the p in 1..n
+// with s[i] == s[i+p] wherever both exist. A border of length b is the
+// same thing as a period n-b, so these are the borders read backwards,
+// followed by n itself.
+// Time Complexity: O(n)
+func Periods(s string) []int {
+	periods := []int{}
+	for _, border := range Borders(s) {
+		periods = append(periods, len(s)-border)
+	}
+	if len(s) > 0 {
+		periods = append(periods, len(s))
+	}
+	return periods
+}
+
+// SmallestRepeatingUnit returns the shortest string u such that s is u
+// repeated, which is s itself if s is not a repetition. The smallest
+// period p is n - lps[n-1], and s is a whole number of copies of its first
+// p bytes exactly when p divides n.
+// Time Complexity: O(n)
+func SmallestRepeatingUnit(s string) string {
+	if len(s) == 0 {
+		return ""
+	}
+	period := Periods(s)[0]
+	if len(s)%period != 0 {
+		return s
+	}
+	return s[:period]
+}
+
+// ================================
+// SHORTEST PALINDROME
+// ================================
+
+// ShortestPalindromeByPrepending returns the shortest palindrome that
+// starts from s by adding characters in front of it, e.g. "aacecaaa" ->
+// "aaacecaaa". Only the longest palindromic prefix of s can stay in the
+// middle; the rest is reversed and prepended. That prefix is the longest
+// prefix of s that is also a suffix of reverse(s), i.e. the longest
+// border of s + "#" + reverse(s) no longer than s. The "#" usually caps
+// the border at len(s); following the border chain keeps the answer
+// right when s itself contains '#'.
+// s must be valid UTF-8: reversing stray bytes can join them into new
+// characters (e.g. "\xa9\xc3" becomes "é"), so no prepended string would
+// make a palindrome of the original bytes.
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+func ShortestPalindromeByPrepending(s string) (string, error) {
+	if !utf8.ValidString(s) {
+		return "", fmt.Errorf("%q is not valid UTF-8", s)
+	}
+	if len(s) == 0 {
+		return s, nil
+	}
+	reversed := reverseText(s)
+	lps := buildLPS(s + "#" + reversed)
+	prefix := lps[len(lps)-1]
+	for prefix > len(s) {
+		prefix = lps[prefix-1]
+	}
+	return reversed[:len(s)-prefix] + s, nil
+}
+
+// reverseText reverses s by UTF-8 sequences, copying each one's bytes
+// unchanged
+func reverseText(s string) string {
+	reversed := make([]byte, 0, len(s))
+	for end := len(s); end > 0; {
+		_, width := utf8.DecodeLastRuneInString(s[:end])
+		reversed = append(reversed, s[end-width:end]...)
+		end -= width
+	}
+	return string(reversed)
+}
+
+// shortestPalindromeNaive tries every prefix from the longest down until
+// one is a palindrome. It works on byte slices of s, not on []rune, so it
+// checks ShortestPalindromeByPrepending independently.
+// Time Complexity: O(n²)
+func shortestPalindromeNaive(s string) string {
+	for end := len(s); end > 0; {
+		if isPalindromeText(s[:end]) {
+			return reverseText(s[end:]) + s
+		}
+		_, width := utf8.DecodeLastRuneInString(s[:end])
+		end -= width
+	}
+	return s
+}
+
+// isPalindromeText reports whether s reads the same backwards, comparing
+// the bytes of the UTF-8 sequences taken from each end
+func isPalindromeText(s string) bool {
+	for i, j := 0, len(s); i < j; {
+		_, front := utf8.DecodeRuneInString(s[i:j])
+		_, back := utf8.DecodeLastRuneInString(s[i:j])
+		if s[i:i+front] != s[j-back:j] {
+			return false
+		}
+		i, j = i+front, j-back
+	}
+	return true
+}
+
+// ================================
+// APPROXIMATE MATCHING (K MISMATCHES)
+// ================================
+
+// ApproxMatch is an occurrence of a pattern with some characters substituted
+type ApproxMatch struct {
+	Position   int
+	Mismatches int
+}
+
+// SearchWithMismatches returns every position where pattern matches text
+// with at most k substituted bytes, with the number of substitutions.
+// KMP's shifts rely on exact prefixes, so this uses the bitap (Shift-Or)
+// algorithm instead: state[d] has bit i clear while the last i+1 bytes
+// of text match pattern[:i+1] with at most d mismatches. Each byte shifts
+// every state left; state[d] may also take state[d-1] one position on,
+// spending a mismatch on the byte just read. The states are one machine
+// word each, so the pattern is limited to 64 bytes.
+// Time Complexity: O(n * k)
+// Space Complexity: O(k)
+func SearchWithMismatches(text, pattern string, k int) ([]ApproxMatch, error) {
+	m := len(pattern)
+	if m == 0 || m > 64 {
+		return nil, fmt.Errorf("pattern length %d out of range [1, 64]", m)
+	}
+	if k < 0 {
+		return nil, fmt.Errorf("negative mismatch limit %d", k)
+	}
+	k = min(k, m)
+
+	// masks[c] has bit i clear where pattern[i] == c
+	var masks [256]uint64
+	for c := range masks {
+		masks[c] = ^uint64(0)
+	}
+	for i := 0; i < m; i++ {
+		masks[pattern[i]] &^= 1 << i
+	}
+
+	state := make([]uint64, k+1)
+	for d := range state {
+		state[d] = ^uint64(0) << d // the first d bytes can be mismatches
+	}
+	matches := []ApproxMatch{}
+	found := uint64(1) << (m - 1)
+	for i := 0; i < len(text); i++ {
+		previous := state[0]
+		state[0] = state[0]<<1 | masks[text[i]]
+		for d := 1; d <= k; d++ {
+			current := state[d]
+			state[d] = (current<<1 | masks[text[i]]) & (previous << 1)
+			previous = current
+		}
+		if i+1 < m {
+			continue
+		}
+		for d := 0; d <= k; d++ {
+			if state[d]&found == 0 {
+				matches = append(matches, ApproxMatch{Position: i - m + 1, Mismatches: d})
+				break
+			}
+		}
+	}
+	return matches, nil
+}
+
+// searchWithMismatchesNaive compares pattern with every window of text
+// Time Complexity: O(n * m)
+func searchWithMismatchesNaive(text, pattern string, k int) []ApproxMatch {
+	matches := []ApproxMatch{}
+	for start := 0; start+len(pattern) <= len(text); start++ {
+		mismatches := 0
+		for i := 0; i < len(pattern) && mismatches <= k; i++ {
+			if text[start+i] != pattern[i] {
+				mismatches++
+			}
+		}
+		if mismatches <= k {
+			matches = append(matches, ApproxMatch{Position: start, Mismatches: mismatches})
+		}
+	}
+	return matches
+}
+
 // ================================
 // NAIVE STRING MATCHING (FOR COMPARISON)
 // ================================
 
+// NaiveSearchSimple is NaiveSearch without detailed tracing
+func NaiveSearchSimple(text, pattern string) []int {
+	matches := []int{}
+	if len(pattern) == 0 {
+		return matches
+	}
+
+	for i := 0; i+len(pattern) <= len(text); i++ {
+		j := 0
+		for j < len(pattern) && text[i+j] == pattern[j] {
+			j++
+		}
+		if j == len(pattern) {
+			matches = append(matches, i)
+		}
+	}
+
+	return matches
+}
+
 // NaiveSearch performs brute force string matching
 func NaiveSearch(text, pattern string) []int {
 	matches := []int{}
@@ -228,17 +650,40 @@ func (tp *TextProcessor) AddPattern(name, pattern string) {
 	tp.matchers[name] = NewKMPMatcher(pattern)
 }
 
-// FindAll finds all patterns in the given text
-func (tp *TextProcessor) FindAll(text string) map[string][]int {
-	results := make(map[string][]int)
+// PatternMatches lists where one named pattern occurs in a text
+type PatternMatches struct {
+	Name      string
+	Positions []int
+}
+
+// FindAll finds all patterns in the given text, one entry per pattern
+// ordered by name, so results are the same on every run
+func (tp *TextProcessor) FindAll(text string) []PatternMatches {
+	results := []PatternMatches{}
 
-	for name, matcher := range tp.matchers {
-		results[name] = matcher.Search(text)
+	for _, name := range sortedKeys(tp.matchers) {
+		results = append(results, PatternMatches{name, tp.matchers[name].Search(text)})
 	}
 
 	return results
 }
 
+// ReplaceAll replaces the pattern registered as name throughout text, as
+// an editor's "replace all" does, and reports how many occurrences were
+// replaced
+func (tp *TextProcessor) ReplaceAll(text, name, replacement string) (string, int, error) {
+	matcher, exists := tp.matchers[name]
+	if !exists {
+		return text, 0, fmt.Errorf("no pattern named %q", name)
+	}
+	replaced := 0
+	result := ReplaceFunc(text, matcher.pattern, SkipOverlaps, func(string) string {
+		replaced++
+		return replacement
+	})
+	return result, replaced, nil
+}
+
 // WordCounter counts occurrences of specific words
 func WordCounter(text string, words []string) map[string]int {
 	counts := make(map[string]int)
@@ -267,14 +712,32 @@ func VirusScanner(data string, virusPatterns []string) []string {
 	return detected
 }
 
-// DNASequenceAnalyzer finds genetic patterns in DNA sequences
-func DNASequenceAnalyzer(dna string, patterns map[string]string) map[string][]int {
-	results := make(map[string][]int)
+// VirusScannerApprox also catches mutated signatures: it returns, for each
+// signature found with at most maxMismatches substituted bytes, where it
+// occurs. Short signatures need a small limit, or they match by chance.
+func VirusScannerApprox(data string, virusPatterns []string, maxMismatches int) (map[string][]ApproxMatch, error) {
+	detected := map[string][]ApproxMatch{}
+	for _, pattern := range virusPatterns {
+		matches, err := SearchWithMismatches(data, pattern, maxMismatches)
+		if err != nil {
+			return nil, fmt.Errorf("signature %q: %v", pattern, err)
+		}
+		if len(matches) > 0 {
+			detected[pattern] = matches
+		}
+	}
+	return detected, nil
+}
 
-	for name, pattern := range patterns {
-		matcher := NewKMPMatcher(pattern)
+// DNASequenceAnalyzer finds genetic patterns in DNA sequences, one entry
+// per pattern ordered by name
+func DNASequenceAnalyzer(dna string, patterns map[string]string) []PatternMatches {
+	results := []PatternMatches{}
+
+	for _, name := range sortedKeys(patterns) {
+		matcher := NewKMPMatcher(patterns[name])
 		matches := matcher.Search(dna)
-		results[name] = matches
+		results = append(results, PatternMatches{name, matches})
 	}
 
 	return results
@@ -319,7 +782,7 @@ func (mkmp *MultiKMP) SearchAll(text string) map[string][]int {
 // PERFORMANCE COMPARISON
 // ================================
 
-// PerformanceTest compares KMP vs Naive algorithms
+// PerformanceTest compares Naive, KMP and Rabin-Karp algorithms
 func PerformanceTest(text, pattern string) {
 	fmt.Printf("=== PERFORMANCE COMPARISON ===\n")
 	fmt.Printf("Text length: %d, Pattern length: %d\n\n", len(text), len(pattern))
@@ -335,8 +798,42 @@ func PerformanceTest(text, pattern string) {
 	kmpMatches := matcher.Search(text)
 	fmt.Printf("KMP found %d matches: %v\n\n", len(kmpMatches), kmpMatches)
 
+	// Rabin-Karp approach
+	fmt.Println("3. RABIN-KARP ALGORITHM:")
+	rkMatches := NewRabinKarpMatcher(pattern).Search(text)
+	fmt.Printf("Rabin-Karp found %d matches: %v\n\n", len(rkMatches), rkMatches)
+
 	// Verify results match
-	fmt.Printf("Results match: %v\n", equalSlices(naiveMatches, kmpMatches))
+	fmt.Printf("Results match: %v\n\n", equalSlices(naiveMatches, kmpMatches) && equalSlices(naiveMatches, rkMatches))
+
+	// Time the non-tracing versions on the same input
+	timeStringSearches("same input", text, pattern)
+}
+
+// timeStringSearches times the non-tracing Naive, KMP and Rabin-Karp
+// searches on one input, repeating each until it has run for a while
+func timeStringSearches(label, text, pattern string) {
+	searches := []struct {
+		name   string
+		search func(text, pattern string) []int
+	}{
+		{"Naive", NaiveSearchSimple},
+		{"KMP", KMPSearchSimple},
+		{"Rabin-Karp", RabinKarpSearchSimple},
+	}
+
+	fmt.Printf("%s (n=%d, m=%d):", label, len(text), len(pattern))
+	for _, s := range searches {
+		runs := 0
+		start := time.Now()
+		for runs == 0 || time.Since(start) < 20*time.Millisecond {
+			s.search(text, pattern)
+			runs++
+		}
+		perRun := time.Since(start) / time.Duration(runs)
+		fmt.Printf("  %s %.1fµs", s.name, float64(perRun)/float64(time.Microsecond))
+	}
+	fmt.Println()
 }
 
 // equalSlices checks if two slices are equal
@@ -358,7 +855,8 @@ func equalSlices(a, b []int) bool {
 
 // DemoKMP demonstrates the KMP algorithm with examples
 func DemoKMP() {
-	fmt.Println("=== KMP (KNUTH-MORRIS-PRATT) ALGORITHM ===\n")
+	fmt.Println("=== KMP (KNUTH-MORRIS-PRATT) ALGORITHM ===")
+	fmt.Println()
 
 	fmt.Println("KMP is an efficient string pattern matching algorithm that:")
 	fmt.Println("1. Preprocesses the pattern to build an LPS (failure function) table")
@@ -395,7 +893,8 @@ func DemoKMP() {
 					i, val, pattern[:val], pattern[i-val+1:i+1])
 			}
 		}
-		fmt.Println("\n")
+		fmt.Println()
+		fmt.Println()
 	}
 
 	// Example 3: Multiple occurrences
@@ -438,11 +937,83 @@ func DemoKMP() {
 		}
 		fmt.Println()
 	}
+
+	// Example 5: Multi-byte text
+	fmt.Println("=== EXAMPLE 5: Unicode and Case-Insensitive Matching ===")
+	unicodeText := "Ça va? Café, CAFÉ, café… naïve café"
+	fmt.Printf("Text: %s (%d bytes, %d runes)\n", unicodeText, len(unicodeText), utf8.RuneCountInString(unicodeText))
+	for _, opts := range []MatchOptions{{}, {Runes: true}, {Runes: true, IgnoreCase: true}, {IgnoreCase: true}} {
+		found := KMPSearchMatches(unicodeText, "café", opts)
+		fmt.Printf("%+v: 'café' at (byte, rune) offsets", opts)
+		for _, match := range found {
+			fmt.Printf(" (%d, %d)", match.Byte, match.Rune)
+		}
+		fmt.Println()
+	}
+	fmt.Println("Byte offsets slice the string; rune offsets count characters. Byte-mode")
+	fmt.Println("IgnoreCase folds only ASCII, so it cannot match 'É' with 'é'.")
+	kelvin := KMPSearchMatches("0 K is -273.15 °C, or 0 \u212A", "0 k", MatchOptions{Runes: true, IgnoreCase: true})
+	fmt.Printf("'0 k' in \"0 K is -273.15 °C, or 0 \\u212A\" (Kelvin sign): %v\n", kelvin)
+	fmt.Println()
+
+	// Example 6: Results derived from the LPS table
+	fmt.Println("=== EXAMPLE 6: Rotations and Repeating Units ===")
+	for _, pair := range [][2]string{{"waterbottle", "erbottlewat"}, {"abab", "baba"}, {"abcd", "acbd"}, {"aa", "aaa"}} {
+		offset, ok := RotationOffset(pair[0], pair[1])
+		fmt.Printf("%q rotation of %q: %v", pair[1], pair[0], ok)
+		if ok {
+			fmt.Printf(" (offset %d)", offset)
+		}
+		fmt.Println()
+	}
+	for _, s := range []string{"abcabcabc", "abab", "aaaa", "abcab", "abacaba", "x"} {
+		lps := buildLPS(s)
+		fmt.Printf("%-12q longest border %d, unit %q\n", s, lps[len(s)-1], SmallestRepeatingUnit(s))
+	}
+	fmt.Println("\"abcab\" has period 3 but 3 does not divide 5, so it is its own unit.")
+	fmt.Println()
+
+	// Example 7: Every border and period of the patterns above
+	fmt.Println("=== EXAMPLE 7: Borders and Periods ===")
+	fmt.Printf("%-10s %-12s %-16s %s\n", "Pattern", "Borders", "Periods", "Match definition")
+	for _, pattern := range append([]string{pattern1}, patterns...) {
+		periods := Periods(pattern)
+		// A period p by definition: every byte equals the one p further on
+		checked := []int{}
+		for p := 1; p <= len(pattern); p++ {
+			if pattern[:len(pattern)-p] == pattern[p:] {
+				checked = append(checked, p)
+			}
+		}
+		fmt.Printf("%-10s %-12s %-16s %v\n", pattern, fmt.Sprint(Borders(pattern)), fmt.Sprint(periods),
+			equalSlices(periods, checked))
+	}
+	fmt.Println("The smallest period says how far KMP can shift after a full match:")
+	fmt.Println("ABABCABAB has period 5, so matches can overlap by its border of 4.")
+	fmt.Println()
+
+	// Example 8: Palindromes from the failure function
+	fmt.Println("=== EXAMPLE 8: Shortest Palindrome by Prepending ===")
+	for _, s := range []string{"aacecaaa", "abcd", "racecar", "abab", "", "#a", "été", "\xffa"} {
+		shortest, err := ShortestPalindromeByPrepending(s)
+		if err != nil {
+			fmt.Printf("%-10q -> error: %v\n", s, err)
+			continue
+		}
+		fmt.Printf("%-10q -> %-14q palindrome: %-5v matches naive: %v\n", s, shortest,
+			isPalindromeText(shortest), shortest == shortestPalindromeNaive(s))
+	}
+	fmt.Println("For \"aacecaaa\" the LPS table of \"aacecaaa#aaacecaa\" ends in 7, the")
+	fmt.Println("palindromic prefix \"aacecaa\", so only the last \"a\" is added in front.")
+	fmt.Println("For \"#a\" the separator itself matches and the border of 3 is too long;")
+	fmt.Println("the next border in the chain, 1, is the palindrome \"#\".")
+	fmt.Println()
 }
 
 // DemoKMPApplications shows practical uses of KMP
 func DemoKMPApplications() {
-	fmt.Println("=== ADVANCED APPLICATIONS ===\n")
+	fmt.Println("=== ADVANCED APPLICATIONS ===")
+	fmt.Println()
 
 	// Application 1: Text Processing
 	fmt.Println("1. TEXT PROCESSING - KEYWORD DETECTION")
@@ -458,8 +1029,8 @@ func DemoKMPApplications() {
 	}
 
 	results := processor.FindAll(strings.ToLower(text))
-	for keyword, matches := range results {
-		fmt.Printf("'%s' found %d times at positions: %v\n", keyword, len(matches), matches)
+	for _, result := range results {
+		fmt.Printf("'%s' found %d times at positions: %v\n", result.Name, len(result.Positions), result.Positions)
 	}
 	fmt.Println()
 
@@ -477,9 +1048,8 @@ func DemoKMPApplications() {
 	fmt.Println("Searching for genetic patterns:")
 
 	dnaResults := DNASequenceAnalyzer(dnaSequence, geneticPatterns)
-	for name, positions := range dnaResults {
-		pattern := geneticPatterns[name]
-		fmt.Printf("%s (%s): found at positions %v\n", name, pattern, positions)
+	for _, result := range dnaResults {
+		fmt.Printf("%s (%s): found at positions %v\n", result.Name, geneticPatterns[result.Name], result.Positions)
 	}
 	fmt.Println()
 
@@ -497,6 +1067,38 @@ func DemoKMPApplications() {
 	} else {
 		fmt.Println("✅ No threats detected")
 	}
+
+	mutatedData := "ABCDEFVIRVSXYZMALWQREABCTROJAMDEFWORN"
+	fmt.Printf("Mutated data: %s\n", mutatedData)
+	exact := []string{}
+	for _, signature := range virusSignatures {
+		if len(KMPSearchSimple(mutatedData, signature)) > 0 {
+			exact = append(exact, signature)
+		}
+	}
+	fmt.Printf("Exact scan: %v; allowing 1 mismatch:\n", exact)
+	approx, err := VirusScannerApprox(mutatedData, virusSignatures, 1)
+	if err != nil {
+		fmt.Println("Error:", err)
+		return
+	}
+	for _, signature := range virusSignatures {
+		for _, match := range approx[signature] {
+			fmt.Printf("⚠️  %-8s ~ %s at %d (%d mismatch)\n", signature,
+				mutatedData[match.Position:match.Position+len(signature)], match.Position, match.Mismatches)
+		}
+	}
+	rng := rand.New(rand.NewSource(1062))
+	disagreements := 0
+	for trial := 0; trial < 1_000; trial++ {
+		text, pattern := randomDNA(60, rng), randomDNA(1+rng.Intn(8), rng)
+		k := rng.Intn(3)
+		fast, _ := SearchWithMismatches(text, pattern, k)
+		if fmt.Sprint(fast) != fmt.Sprint(searchWithMismatchesNaive(text, pattern, k)) {
+			disagreements++
+		}
+	}
+	fmt.Printf("Bitap vs comparing every window, 1000 random DNA searches: %d disagreements\n", disagreements)
 	fmt.Println()
 
 	// Application 4: Multi-pattern search
@@ -510,7 +1112,8 @@ func DemoKMPApplications() {
 	multiKMP := NewMultiKMP(searchTerms)
 	multiResults := multiKMP.SearchAll(strings.ToLower(document))
 
-	for term, positions := range multiResults {
+	for _, term := range searchTerms {
+		positions := multiResults[term]
 		if len(positions) > 0 {
 			fmt.Printf("'%s' found at positions: %v\n", term, positions)
 		}
@@ -533,6 +1136,27 @@ func DemoKMPApplications() {
 		len(longText)*len(testPattern))
 	fmt.Println()
 
+	// Application 6: Find and replace
+	fmt.Println("6. FIND AND REPLACE")
+	fmt.Printf("Text: %s\n", text)
+	replaced, count, _ := processor.ReplaceAll(text, "fox", "cat")
+	fmt.Printf("Replace all 'fox' -> 'cat' (%d replaced): %s\n", count, replaced)
+	if _, _, err := processor.ReplaceAll(text, "color", "red"); err != nil {
+		fmt.Println("Error:", err)
+	}
+	for _, policy := range []struct {
+		name   string
+		policy OverlapPolicy
+	}{{"SkipOverlaps", SkipOverlaps}, {"MergeOverlaps", MergeOverlaps}} {
+		fmt.Printf("%-13s 'aa' in \"aaaaa-aa\": %q\n", policy.name,
+			ReplaceFunc("aaaaa-aa", "aa", policy.policy, func(span string) string {
+				return "[" + span + "]"
+			}))
+	}
+	fmt.Printf("strings.ReplaceAll agrees with ReplaceAll: %v\n",
+		strings.ReplaceAll("aaaaa-aa", "aa", "b") == ReplaceAll("aaaaa-aa", "aa", "b"))
+	fmt.Println()
+
 	// Algorithm characteristics
 	fmt.Println("=== ALGORITHM CHARACTERISTICS ===")
 	fmt.Println("Time Complexity:")
@@ -0,0 +1,412 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ================================
+// SINGLY LINKED LIST
+// ================================
+
+// ListNode is a node of a singly linked list
+type ListNode struct {
+	Val  int
+	Next *ListNode
+}
+
+// NewList builds a list from values and returns its head (nil if empty)
+func NewList(values ...int) *ListNode {
+	dummy := &ListNode{}
+	tail := dummy
+	for _, val := range values {
+		tail.Next = &ListNode{Val: val}
+		tail = tail.Next
+	}
+	return dummy.Next
+}
+
+// Values returns the list's values in order. The list must not have a cycle.
+func (head *ListNode) Values() []int {
+	values := []int{}
+	for node := head; node != nil; node = node.Next {
+		values = append(values, node.Val)
+	}
+	return values
+}
+
+// String formats the list as 1 -> 2 -> 3
+func (head *ListNode) String() string {
+	parts := []string{}
+	for _, val := range head.Values() {
+		parts = append(parts, fmt.Sprint(val))
+	}
+	if len(parts) == 0 {
+		return "(empty)"
+	}
+	return strings.Join(parts, " -> ")
+}
+
+// ================================
+// REVERSAL
+// ================================
+
+// ReverseList reverses the list in place by turning each Next pointer
+// around, and returns the new head
+// Time Complexity: O(n)
+// Space Complexity: O(1)
+func ReverseList(head *ListNode) *ListNode {
+	var prev *ListNode
+	for head != nil {
+		next := head.Next
+		head.Next = prev
+		prev, head = head, next
+	}
+	return prev
+}
+
+// ReverseKGroup reverses every consecutive group of k nodes in place; a
+// final group shorter than k keeps its order
+// Time Complexity: O(n)
+// Space Complexity: O(1)
+func ReverseKGroup(head *ListNode, k int) *ListNode {
+	if k < 2 {
+		return head
+	}
+	dummy := &ListNode{Next: head}
+	groupPrev := dummy
+	for {
+		// Find the k-th node of the group; stop if the group is short
+		kth := groupPrev
+		for i := 0; i < k && kth != nil; i++ {
+			kth = kth.Next
+		}
+		if kth == nil {
+			return dummy.Next
+		}
+
+		groupStart, groupNext := groupPrev.Next, kth.Next
+		prev, node := groupNext, groupStart
+		for node != groupNext {
+			next := node.Next
+			node.Next = prev
+			prev, node = node, next
+		}
+		groupPrev.Next = kth
+		groupPrev = groupStart // now the last node of the reversed group
+	}
+}
+
+// ================================
+// FLOYD CYCLE DETECTION
+// ================================
+
+// DetectListCycle finds the node where a cycle begins and the cycle's
+// length, or nil and 0 if the list ends. A slow pointer moves one step and
+// a fast pointer two; if they meet, the cycle start is as far from the head
+// as it is (going forward) from the meeting point.
+// Time Complexity: O(n)
+// Space Complexity: O(1)
+func DetectListCycle(head *ListNode) (*ListNode, int) {
+	slow, fast := head, head
+	for fast != nil && fast.Next != nil {
+		slow, fast = slow.Next, fast.Next.Next
+		if slow != fast {
+			continue
+		}
+
+		length := 1
+		for node := slow.Next; node != slow; node = node.Next {
+			length++
+		}
+		start := head
+		for start != slow {
+			start, slow = start.Next, slow.Next
+		}
+		return start, length
+	}
+	return nil, 0
+}
+
+// ================================
+// MERGE, MIDDLE, PALINDROME, REORDER
+// ================================
+
+// MergeSortedLists splices two sorted lists into one sorted list without
+// allocating nodes; equal values keep a's before b's
+// Time Complexity: O(n + m)
+// Space Complexity: O(1)
+func MergeSortedLists(a, b *ListNode) *ListNode {
+	dummy := &ListNode{}
+	tail := dummy
+	for a != nil && b != nil {
+		if b.Val < a.Val {
+			tail.Next, b = b, b.Next
+		} else {
+			tail.Next, a = a, a.Next
+		}
+		tail = tail.Next
+	}
+	if a != nil {
+		tail.Next = a
+	} else {
+		tail.Next = b
+	}
+	return dummy.Next
+}
+
+// MiddleNode returns the middle node, the second of the two middles for an
+// even length: when the fast pointer runs off the end, slow is halfway
+// Time Complexity: O(n)
+// Space Complexity: O(1)
+func MiddleNode(head *ListNode) *ListNode {
+	slow, fast := head, head
+	for fast != nil && fast.Next != nil {
+		slow, fast = slow.Next, fast.Next.Next
+	}
+	return slow
+}
+
+// splitHalves cuts the list after its first half (the first middle) and
+// returns the head of the second half
+func splitHalves(head *ListNode) *ListNode {
+	slow, fast := head, head.Next
+	for fast != nil && fast.Next != nil {
+		slow, fast = slow.Next, fast.Next.Next
+	}
+	second := slow.Next
+	slow.Next = nil
+	return second
+}
+
+// IsPalindromeList reports whether the list reads the same both ways. It
+// reverses the second half in place to compare, then restores it.
+// Time Complexity: O(n)
+// Space Complexity: O(1)
+func IsPalindromeList(head *ListNode) bool {
+	if head == nil {
+		return true
+	}
+	firstTail := head
+	for fast := head.Next; fast != nil && fast.Next != nil; fast = fast.Next.Next {
+		firstTail = firstTail.Next
+	}
+	second := ReverseList(firstTail.Next)
+
+	palindrome := true
+	for a, b := head, second; b != nil; a, b = a.Next, b.Next {
+		if a.Val != b.Val {
+			palindrome = false
+			break
+		}
+	}
+	firstTail.Next = ReverseList(second)
+	return palindrome
+}
+
+// ReorderList rearranges L0 -> L1 -> ... -> Ln into
+// L0 -> Ln -> L1 -> Ln-1 -> ... in place: split at the middle, reverse the
+// second half, then interleave the halves
+// Time Complexity: O(n)
+// Space Complexity: O(1)
+func ReorderList(head *ListNode) {
+	if head == nil {
+		return
+	}
+	second := ReverseList(splitHalves(head))
+	for first := head; second != nil; {
+		firstNext, secondNext := first.Next, second.Next
+		first.Next = second
+		second.Next = firstNext
+		first, second = firstNext, secondNext
+	}
+}
+
+// ================================
+// DOUBLY LINKED LIST
+// ================================
+
+// DListNode is a node of a DoublyLinkedList
+type DListNode[T any] struct {
+	Value      T
+	prev, next *DListNode[T]
+	list       *DoublyLinkedList[T]
+}
+
+// DoublyLinkedList is a circular list around a sentinel node, so inserting
+// and removing never special-case the ends. Removing a node you hold a
+// pointer to is O(1), which is what LRU caches rely on.
+type DoublyLinkedList[T any] struct {
+	sentinel DListNode[T]
+	size     int
+}
+
+// NewDoublyLinkedList creates an empty list
+func NewDoublyLinkedList[T any]() *DoublyLinkedList[T] {
+	l := &DoublyLinkedList[T]{}
+	l.sentinel.prev, l.sentinel.next = &l.sentinel, &l.sentinel
+	return l
+}
+
+// insertAfter links a new node holding value after at
+func (l *DoublyLinkedList[T]) insertAfter(at *DListNode[T], value T) *DListNode[T] {
+	node := &DListNode[T]{Value: value, prev: at, next: at.next, list: l}
+	at.next.prev = node
+	at.next = node
+	l.size++
+	return node
+}
+
+// PushFront adds value at the front and returns its node
+// Time Complexity: O(1)
+func (l *DoublyLinkedList[T]) PushFront(value T) *DListNode[T] {
+	return l.insertAfter(&l.sentinel, value)
+}
+
+// PushBack adds value at the back and returns its node
+// Time Complexity: O(1)
+func (l *DoublyLinkedList[T]) PushBack(value T) *DListNode[T] {
+	return l.insertAfter(l.sentinel.prev, value)
+}
+
+// Remove unlinks node from the list
+// Time Complexity: O(1)
+func (l *DoublyLinkedList[T]) Remove(node *DListNode[T]) error {
+	if node.list != l {
+		return fmt.Errorf("node does not belong to this list")
+	}
+	node.prev.next = node.next
+	node.next.prev = node.prev
+	node.prev, node.next, node.list = nil, nil, nil
+	l.size--
+	return nil
+}
+
+// MoveToFront moves node to the front of the list
+// Time Complexity: O(1)
+func (l *DoublyLinkedList[T]) MoveToFront(node *DListNode[T]) error {
+	if err := l.Remove(node); err != nil {
+		return err
+	}
+	node.prev, node.next, node.list = &l.sentinel, l.sentinel.next, l
+	l.sentinel.next.prev = node
+	l.sentinel.next = node
+	l.size++
+	return nil
+}
+
+// Front returns the first node, or nil if the list is empty
+func (l *DoublyLinkedList[T]) Front() *DListNode[T] {
+	if l.size == 0 {
+		return nil
+	}
+	return l.sentinel.next
+}
+
+// Back returns the last node, or nil if the list is empty
+func (l *DoublyLinkedList[T]) Back() *DListNode[T] {
+	if l.size == 0 {
+		return nil
+	}
+	return l.sentinel.prev
+}
+
+// Reverse reverses the list in place by swapping every node's pointers
+// Time Complexity: O(n)
+func (l *DoublyLinkedList[T]) Reverse() {
+	node := &l.sentinel
+	for {
+		node.prev, node.next = node.next, node.prev
+		node = node.prev // the old next
+		if node == &l.sentinel {
+			return
+		}
+	}
+}
+
+// Values returns the values from front to back
+func (l *DoublyLinkedList[T]) Values() []T {
+	values := make([]T, 0, l.size)
+	for node := l.sentinel.next; node != &l.sentinel; node = node.next {
+		values = append(values, node.Value)
+	}
+	return values
+}
+
+// Len returns the number of nodes
+func (l *DoublyLinkedList[T]) Len() int {
+	return l.size
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoLinkedList demonstrates the classic linked list techniques
+func DemoLinkedList() {
+	fmt.Println("=== LINKED LISTS ===")
+	fmt.Println()
+
+	// Example 1: Reversal
+	fmt.Println("=== EXAMPLE 1: Reversal ===")
+	fmt.Printf("Reverse 1..5:            %v\n", ReverseList(NewList(1, 2, 3, 4, 5)))
+	for _, k := range []int{2, 3} {
+		fmt.Printf("Reverse 1..8 in %d-groups: %v\n", k, ReverseKGroup(NewList(1, 2, 3, 4, 5, 6, 7, 8), k))
+	}
+	fmt.Printf("Reverse empty list:      %v\n", ReverseList(nil))
+	fmt.Println()
+
+	// Example 2: Floyd cycle detection
+	fmt.Println("=== EXAMPLE 2: Floyd Cycle Detection ===")
+	cyclic := NewList(3, 2, 0, -4, 7)
+	tail := cyclic
+	for tail.Next != nil {
+		tail = tail.Next
+	}
+	tail.Next = cyclic.Next // 7 links back to 2
+	if start, length := DetectListCycle(cyclic); start != nil {
+		fmt.Printf("3 -> 2 -> 0 -> -4 -> 7 -> (back to 2): cycle starts at %d, length %d\n", start.Val, length)
+	}
+	if start, _ := DetectListCycle(NewList(1, 2, 3)); start == nil {
+		fmt.Println("1 -> 2 -> 3: no cycle")
+	}
+	fmt.Println()
+
+	// Example 3: Merge, middle, palindrome, reorder
+	fmt.Println("=== EXAMPLE 3: Merge, Middle, Palindrome, Reorder ===")
+	fmt.Printf("Merge [1 4 6] and [2 3 7 9]: %v\n", MergeSortedLists(NewList(1, 4, 6), NewList(2, 3, 7, 9)))
+	fmt.Printf("Middle of 1..5: %d, of 1..6: %d\n", MiddleNode(NewList(1, 2, 3, 4, 5)).Val, MiddleNode(NewList(1, 2, 3, 4, 5, 6)).Val)
+	for _, values := range [][]int{{1, 2, 3, 2, 1}, {1, 2, 2, 1}, {1, 2, 3}} {
+		list := NewList(values...)
+		palindrome := IsPalindromeList(list)
+		fmt.Printf("%v palindrome: %-5v (list afterwards: %v)\n", values, palindrome, list)
+	}
+	reordered := NewList(1, 2, 3, 4, 5, 6)
+	ReorderList(reordered)
+	fmt.Printf("Reorder 1..6: %v\n", reordered)
+	fmt.Println()
+
+	// Example 4: Doubly linked list
+	fmt.Println("=== EXAMPLE 4: Doubly Linked List ===")
+	recent := NewDoublyLinkedList[string]()
+	nodes := map[string]*DListNode[string]{}
+	for _, page := range []string{"home", "search", "product", "cart"} {
+		nodes[page] = recent.PushFront(page)
+	}
+	fmt.Printf("Most recent first: %v\n", recent.Values())
+	recent.MoveToFront(nodes["search"])
+	fmt.Printf("Revisit search:    %v\n", recent.Values())
+	recent.Remove(recent.Back())
+	fmt.Printf("Evict oldest:      %v\n", recent.Values())
+	recent.Reverse()
+	fmt.Printf("Reversed:          %v\n", recent.Values())
+	if err := NewDoublyLinkedList[string]().Remove(nodes["cart"]); err != nil {
+		fmt.Printf("Remove from another list: %v\n", err)
+	}
+	fmt.Println()
+
+	fmt.Println("Almost every list trick is pointer bookkeeping: a dummy head removes")
+	fmt.Println("the empty-list special case, and two pointers at different speeds find")
+	fmt.Println("middles and cycles without extra memory.")
+	fmt.Println()
+}
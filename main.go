@@ -2,10 +2,32 @@ package main
 
 import (
 	"fmt"
+	"io"
+	"os"
 	"strings"
 )
 
+// commands are run instead of the demos when named on the command line
+var commands = map[string]func(args []string, stdout io.Writer) error{
+	"bench-autocomplete": runAutocompleteBenchCommand,
+	"scan":               runScanCommand,
+	"wordfreq":           runWordFreqCommand,
+}
+
 func main() {
+	if len(os.Args) > 1 {
+		command, ok := commands[os.Args[1]]
+		if !ok {
+			fmt.Fprintf(os.Stderr, "unknown command %q; available: %s\n", os.Args[1], strings.Join(sortedKeys(commands), ", "))
+			os.Exit(2)
+		}
+		if err := command(os.Args[2:], os.Stdout); err != nil {
+			fmt.Fprintf(os.Stderr, "dsa %s: %v\n", os.Args[1], err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	fmt.Println("Welcome to DSA Practice!")
 
 	// Run Union-Find demonstration
@@ -0,0 +1,254 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+// ================================
+// BOYER-MOORE MAJORITY VOTE
+// ================================
+
+// MajorityElement returns the element occurring more than n/2 times, or
+// false if there is none.
+// Voting: keep one candidate and a counter; a different element cancels
+// one vote. A true majority cannot be cancelled by all the other elements
+// together, so it survives as the candidate. A second pass confirms it,
+// since without a majority the survivor is arbitrary.
+// Time Complexity: O(n)
+// Space Complexity: O(1)
+func MajorityElement(nums []int) (int, bool) {
+	candidate, votes := 0, 0
+	for _, num := range nums {
+		switch {
+		case votes == 0:
+			candidate, votes = num, 1
+		case num == candidate:
+			votes++
+		default:
+			votes--
+		}
+	}
+	return candidate, countOf(nums, candidate) > len(nums)/2
+}
+
+// MajorityElementsThird returns the elements occurring more than n/3
+// times (at most two), in ascending order.
+// Same voting with two candidates: an element matching neither cancels one
+// vote of each, so three distinct elements disappear together and anything
+// above n/3 survives.
+// Time Complexity: O(n)
+// Space Complexity: O(1)
+func MajorityElementsThird(nums []int) []int {
+	first, second, votesFirst, votesSecond := 0, 1, 0, 0
+	for _, num := range nums {
+		switch {
+		case num == first:
+			votesFirst++
+		case num == second:
+			votesSecond++
+		case votesFirst == 0:
+			first, votesFirst = num, 1
+		case votesSecond == 0:
+			second, votesSecond = num, 1
+		default:
+			votesFirst--
+			votesSecond--
+		}
+	}
+
+	result := []int{}
+	for _, candidate := range []int{first, second} {
+		if countOf(nums, candidate) > len(nums)/3 {
+			result = append(result, candidate)
+		}
+	}
+	sort.Ints(result)
+	return result
+}
+
+// countOf counts the occurrences of x in nums
+func countOf(nums []int, x int) int {
+	count := 0
+	for _, num := range nums {
+		if num == x {
+			count++
+		}
+	}
+	return count
+}
+
+// ================================
+// MISRA-GRIES HEAVY HITTERS
+// ================================
+
+// HeavyHitter is an item with its counter in a Misra-Gries summary
+type HeavyHitter[T comparable] struct {
+	Item  T
+	Count int
+}
+
+// MisraGries summarizes a stream in k counters and finds every item that
+// occurs more than n/(k+1) times in one pass. It is majority voting with k
+// candidates: an item with no free counter decrements all of them, which
+// cancels k+1 occurrences at once. Counts are underestimates by at most
+// n/(k+1), and never overestimates.
+type MisraGries[T comparable] struct {
+	k        int
+	counters map[T]int
+	n        int // items seen
+}
+
+// NewMisraGries creates a summary with k counters
+func NewMisraGries[T comparable](k int) (*MisraGries[T], error) {
+	if k < 1 {
+		return nil, fmt.Errorf("need at least 1 counter, got %d", k)
+	}
+	return &MisraGries[T]{k: k, counters: make(map[T]int, k+1)}, nil
+}
+
+// Add counts one occurrence of item
+// Time Complexity: O(1) amortized: each decrement round removes at least
+// one counter, and counters are only created by Add
+func (mg *MisraGries[T]) Add(item T) {
+	mg.n++
+	if _, tracked := mg.counters[item]; tracked || len(mg.counters) < mg.k {
+		mg.counters[item]++
+		return
+	}
+	for tracked := range mg.counters {
+		if mg.counters[tracked]--; mg.counters[tracked] == 0 {
+			delete(mg.counters, tracked)
+		}
+	}
+}
+
+// Estimate returns a lower bound on item's count; the true count is at
+// most Estimate + ErrorBound
+func (mg *MisraGries[T]) Estimate(item T) int {
+	return mg.counters[item]
+}
+
+// ErrorBound returns n/(k+1), the most any count can be underestimated by
+func (mg *MisraGries[T]) ErrorBound() int {
+	return mg.n / (mg.k + 1)
+}
+
+// HeavyHitters returns the tracked items, largest counter first. Every
+// item occurring more than n/(k+1) times is among them, but some may be
+// false positives; a second pass over the data can confirm them.
+func (mg *MisraGries[T]) HeavyHitters() []HeavyHitter[T] {
+	hitters := make([]HeavyHitter[T], 0, len(mg.counters))
+	for item, count := range mg.counters {
+		hitters = append(hitters, HeavyHitter[T]{item, count})
+	}
+	sort.SliceStable(hitters, func(i, j int) bool {
+		return hitters[i].Count > hitters[j].Count
+	})
+	return hitters
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoMajorityVote demonstrates majority voting and Misra-Gries on a word
+// stream compared with exact counts
+func DemoMajorityVote() {
+	fmt.Println("=== BOYER-MOORE MAJORITY VOTE AND MISRA-GRIES ===")
+	fmt.Println()
+
+	// Example 1: Majority element
+	fmt.Println("=== EXAMPLE 1: Majority Element (> n/2) ===")
+	for _, nums := range [][]int{
+		{2, 2, 1, 1, 1, 2, 2},
+		{3, 1, 3, 2, 3, 3, 1},
+		{1, 2, 3, 1, 2, 3, 1},
+		{},
+	} {
+		if majority, ok := MajorityElement(nums); ok {
+			fmt.Printf("%v: %d\n", nums, majority)
+		} else {
+			fmt.Printf("%v: no majority (the vote survivor was %d)\n", nums, majority)
+		}
+	}
+	fmt.Println()
+
+	// Example 2: More than n/3
+	fmt.Println("=== EXAMPLE 2: Elements Above n/3 ===")
+	for _, nums := range [][]int{
+		{3, 2, 3},
+		{1, 1, 1, 3, 3, 2, 2, 2},
+		{1, 2, 3, 4, 5, 6},
+	} {
+		fmt.Printf("%v: %v\n", nums, MajorityElementsThird(nums))
+	}
+	rng := rand.New(rand.NewSource(1056))
+	disagreements := 0
+	for trial := 0; trial < 1_000; trial++ {
+		nums := make([]int, 1+rng.Intn(30))
+		for i := range nums {
+			nums[i] = rng.Intn(4)
+		}
+		counts := map[int]int{}
+		for _, num := range nums {
+			counts[num]++
+		}
+		expected := []int{}
+		for _, num := range sortedKeys(counts) {
+			if counts[num] > len(nums)/3 {
+				expected = append(expected, num)
+			}
+		}
+		if !equalSlices(expected, MajorityElementsThird(nums)) {
+			disagreements++
+		}
+	}
+	fmt.Printf("1000 random arrays checked against exact counts: %d disagreements\n", disagreements)
+	fmt.Println()
+
+	// Example 3: Heavy hitters in a word stream
+	fmt.Println("=== EXAMPLE 3: Misra-Gries on the Explanation Docs ===")
+	words := []string{}
+	files, _ := explanationDocs.ReadDir(".")
+	for _, file := range files {
+		text, _ := explanationDocs.ReadFile(file.Name())
+		words = append(words, tokenizeWords(string(text))...)
+	}
+	exact := map[string]int{}
+	for _, word := range words {
+		exact[word]++
+	}
+	fmt.Printf("%d words, %d distinct\n", len(words), len(exact))
+	fmt.Printf("%8s %12s %12s %12s %14s\n", "Counters", "Error bound", "Above bound", "Tracked", "Within bound")
+	var summary *MisraGries[string]
+	for _, k := range []int{10, 50, 200} {
+		summary, _ = NewMisraGries[string](k)
+		for _, word := range words {
+			summary.Add(word)
+		}
+		withinBound, guaranteed := true, 0
+		for word, count := range exact {
+			missed := count - summary.Estimate(word)
+			withinBound = withinBound && missed >= 0 && missed <= summary.ErrorBound()
+			if count > summary.ErrorBound() {
+				guaranteed++
+				withinBound = withinBound && summary.Estimate(word) > 0
+			}
+		}
+		fmt.Printf("%8d %12d %12d %12d %14v\n", k, summary.ErrorBound(), guaranteed, len(summary.HeavyHitters()), withinBound)
+	}
+	fmt.Printf("Top words with %d counters:\n", summary.k)
+	fmt.Printf("  %-10s %8s %8s\n", "Word", "Exact", "Counter")
+	for _, hitter := range summary.HeavyHitters()[:6] {
+		fmt.Printf("  %-10s %8d %8d\n", hitter.Item, exact[hitter.Item], hitter.Count)
+	}
+	fmt.Println()
+
+	fmt.Println("Boyer-Moore voting is Misra-Gries with one counter: both cancel groups")
+	fmt.Println("of distinct items, so anything frequent enough must survive. They keep")
+	fmt.Println("k counters instead of one per distinct item and never overcount, but")
+	fmt.Println("the survivors still need a second pass when false positives matter.")
+	fmt.Println()
+}
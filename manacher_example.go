@@ -0,0 +1,199 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// ================================
+// MANACHER'S ALGORITHM
+// ================================
+
+// PalindromeRadii returns the radius of the longest palindrome around every
+// center of s, counted in runes:
+//   - odd[i] = r: the longest odd palindrome centered on rune i has length
+//     2r-1, runes i-r+1 .. i+r-1
+//   - even[i] = r: the longest even palindrome centered between runes i-1
+//     and i has length 2r, runes i-r .. i+r-1
+//
+// Manacher: keep the palindrome [l, r] reaching furthest right. A center i
+// inside it mirrors to l+r-i, whose radius is already known, so i starts
+// from that radius (capped at the edge r) instead of from scratch. Every
+// comparison that succeeds moves r right, so there are O(n) in total.
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+func PalindromeRadii(s string) (odd, even []int) {
+	runes := []rune(s)
+	n := len(runes)
+	odd, even = make([]int, n), make([]int, n)
+
+	for i, l, r := 0, 0, -1; i < n; i++ {
+		k := 1
+		if i <= r {
+			k = min(odd[l+r-i], r-i+1)
+		}
+		for i-k >= 0 && i+k < n && runes[i-k] == runes[i+k] {
+			k++
+		}
+		odd[i] = k
+		if i+k-1 > r {
+			l, r = i-k+1, i+k-1
+		}
+	}
+
+	for i, l, r := 0, 0, -1; i < n; i++ {
+		k := 0
+		if i <= r {
+			k = min(even[l+r-i+1], r-i+1)
+		}
+		for i-k-1 >= 0 && i+k < n && runes[i-k-1] == runes[i+k] {
+			k++
+		}
+		even[i] = k
+		if i+k-1 > r {
+			l, r = i-k, i+k-1
+		}
+	}
+	return odd, even
+}
+
+// LongestPalindrome returns the longest palindromic substring of s, the
+// leftmost one if several share the longest length
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+func LongestPalindrome(s string) string {
+	odd, even := PalindromeRadii(s)
+	start, length := 0, 0
+	for i := range odd {
+		if l := 2*odd[i] - 1; l > length || (l == length && i-odd[i]+1 < start) {
+			start, length = i-odd[i]+1, l
+		}
+		if l := 2 * even[i]; l > length || (l == length && i-even[i] < start) {
+			start, length = i-even[i], l
+		}
+	}
+	return string([]rune(s)[start : start+length])
+}
+
+// CountPalindromicSubstrings counts the palindromic substrings of s by
+// position: a center with radius r contributes r palindromes, one for each
+// shorter radius
+// Time Complexity: O(n)
+func CountPalindromicSubstrings(s string) int {
+	odd, even := PalindromeRadii(s)
+	count := 0
+	for i := range odd {
+		count += odd[i] + even[i]
+	}
+	return count
+}
+
+// LongestPalindromeNaive expands around each of the 2n-1 centers until the
+// ends differ. Simple, but a run like "aaaa..." makes every expansion
+// reach the edge of the string.
+// Time Complexity: O(n²)
+// Space Complexity: O(n) for the runes
+func LongestPalindromeNaive(s string) string {
+	runes := []rune(s)
+	start, length := 0, 0
+	for center := 0; center < 2*len(runes)-1; center++ {
+		left, right := center/2, (center+1)/2
+		for left >= 0 && right < len(runes) && runes[left] == runes[right] {
+			left--
+			right++
+		}
+		if l := right - left - 1; l > length || (l == length && left+1 < start) {
+			start, length = left+1, l
+		}
+	}
+	return string(runes[start : start+length])
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoManacher demonstrates palindromic radii and compares Manacher's
+// algorithm with expanding around every center
+func DemoManacher() {
+	fmt.Println("=== MANACHER'S ALGORITHM ===")
+	fmt.Println()
+
+	// Example 1: Radii at every center
+	fmt.Println("=== EXAMPLE 1: Palindromic Radii ===")
+	s := "abacabadd"
+	odd, even := PalindromeRadii(s)
+	fmt.Printf("%-6s", "s")
+	for _, char := range s {
+		fmt.Printf("%3c", char)
+	}
+	fmt.Printf("\n%-6s", "odd")
+	for _, r := range odd {
+		fmt.Printf("%3d", r)
+	}
+	fmt.Printf("\n%-6s", "even")
+	for _, r := range even {
+		fmt.Printf("%3d", r)
+	}
+	fmt.Println()
+	fmt.Printf("odd[3] = %d: %q; even[8] = %d: %q\n", odd[3], s[3-odd[3]+1:3+odd[3]], even[8], s[8-even[8]:8+even[8]])
+	fmt.Printf("Longest: %q; palindromic substrings: %d\n", LongestPalindrome(s), CountPalindromicSubstrings(s))
+	fmt.Println()
+
+	// Example 2: Longest palindromes
+	fmt.Println("=== EXAMPLE 2: Longest Palindromic Substring ===")
+	for _, s := range []string{"babad", "cbbd", "forgeeksskeegfor", "a", "", "été à Laval", "racecar racecar"} {
+		fmt.Printf("%-20q Manacher %-18q naive %q\n", s, LongestPalindrome(s), LongestPalindromeNaive(s))
+	}
+	rng := rand.New(rand.NewSource(1057))
+	disagreements := 0
+	for trial := 0; trial < 1_000; trial++ {
+		runes := make([]rune, rng.Intn(40))
+		for i := range runes {
+			runes[i] = 'a' + rune(rng.Intn(3))
+		}
+		if LongestPalindrome(string(runes)) != LongestPalindromeNaive(string(runes)) {
+			disagreements++
+		}
+	}
+	fmt.Printf("1000 random strings over {a, b, c}: %d disagreements\n", disagreements)
+	fmt.Println()
+
+	// Example 3: The naive worst case
+	fmt.Println("=== EXAMPLE 3: Manacher vs Expanding Around Centers ===")
+	fmt.Printf("%-22s %8s %12s %12s\n", "Text", "Length", "Manacher", "Naive")
+	randomText := make([]byte, 20_000)
+	for i := range randomText {
+		randomText[i] = 'a' + byte(rng.Intn(26))
+	}
+	for _, test := range []struct {
+		name, text string
+	}{
+		{"random letters", string(randomText)},
+		{"one repeated letter", strings.Repeat("a", 20_000)},
+		{"\"ab\" repeated", strings.Repeat("ab", 10_000)},
+	} {
+		start := time.Now()
+		fast := LongestPalindrome(test.text)
+		fastTime := time.Since(start)
+		start = time.Now()
+		slow := LongestPalindromeNaive(test.text)
+		slowTime := time.Since(start)
+		fmt.Printf("%-22s %8d %12v %12v", test.name, len(test.text),
+			fastTime.Round(time.Microsecond), slowTime.Round(time.Microsecond))
+		if fast != slow {
+			fmt.Print("  (results differ!)")
+		}
+		fmt.Println()
+	}
+	fmt.Println()
+
+	fmt.Println("Expanding around a center throws away everything learned about the")
+	fmt.Println("previous centers. Manacher reuses it: inside a known palindrome the")
+	fmt.Println("right half mirrors the left, so a center's radius starts from its")
+	fmt.Println("mirror's and only grows past the known edge, which moves right only.")
+	fmt.Println("Both give every center's radius; only Manacher does it in O(n).")
+	fmt.Println()
+}
@@ -0,0 +1,268 @@
+package main
+
+import "fmt"
+
+// ================================
+// ROTATION
+// ================================
+
+// RotateMatrix rotates a square matrix 90° clockwise in place: transpose
+// it (swap across the main diagonal), then reverse every row
+// Time Complexity: O(n²)
+// Space Complexity: O(1)
+func RotateMatrix(matrix [][]int) error {
+	return rotateMatrix(matrix, false)
+}
+
+// rotateMatrixVerbose is RotateMatrix with the intermediate matrix printed
+func rotateMatrixVerbose(matrix [][]int) error {
+	return rotateMatrix(matrix, true)
+}
+
+func rotateMatrix(matrix [][]int, verbose bool) error {
+	n := len(matrix)
+	for _, row := range matrix {
+		if len(row) != n {
+			return fmt.Errorf("rotation in place needs a square matrix, got %dx%d", n, len(row))
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			matrix[i][j], matrix[j][i] = matrix[j][i], matrix[i][j]
+		}
+	}
+	if verbose {
+		fmt.Println("Step 1: transpose (row i becomes column i)")
+		printMatrix(matrix)
+	}
+
+	for _, row := range matrix {
+		for i, j := 0, n-1; i < j; i, j = i+1, j-1 {
+			row[i], row[j] = row[j], row[i]
+		}
+	}
+	if verbose {
+		fmt.Println("Step 2: reverse each row")
+		printMatrix(matrix)
+	}
+	return nil
+}
+
+// ================================
+// SPIRAL ORDER
+// ================================
+
+// SpiralOrder returns the elements clockwise from the top-left corner,
+// peeling one ring at a time. Four bounds shrink as each side is consumed.
+// Time Complexity: O(rows * cols)
+// Space Complexity: O(1) besides the result
+func SpiralOrder(matrix [][]int) []int {
+	result := []int{}
+	if len(matrix) == 0 {
+		return result
+	}
+	top, bottom, left, right := 0, len(matrix)-1, 0, len(matrix[0])-1
+	for top <= bottom && left <= right {
+		for c := left; c <= right; c++ {
+			result = append(result, matrix[top][c])
+		}
+		top++
+		for r := top; r <= bottom; r++ {
+			result = append(result, matrix[r][right])
+		}
+		right--
+		// A single remaining row or column has no bottom or left side
+		if top <= bottom {
+			for c := right; c >= left; c-- {
+				result = append(result, matrix[bottom][c])
+			}
+			bottom--
+		}
+		if left <= right {
+			for r := bottom; r >= top; r-- {
+				result = append(result, matrix[r][left])
+			}
+			left++
+		}
+	}
+	return result
+}
+
+// ================================
+// SET MATRIX ZEROES
+// ================================
+
+// SetMatrixZeroes sets the whole row and column of every 0 to 0 in place.
+// Instead of separate marker arrays it records the zero rows and columns
+// in the first column and first row, with two flags for the first row and
+// column themselves.
+// Time Complexity: O(rows * cols)
+// Space Complexity: O(1)
+func SetMatrixZeroes(matrix [][]int) {
+	if len(matrix) == 0 {
+		return
+	}
+	rows, cols := len(matrix), len(matrix[0])
+	firstRowZero, firstColZero := false, false
+	for c := 0; c < cols; c++ {
+		firstRowZero = firstRowZero || matrix[0][c] == 0
+	}
+	for r := 0; r < rows; r++ {
+		firstColZero = firstColZero || matrix[r][0] == 0
+	}
+
+	// Mark: a zero at (r, c) zeroes the row's and column's header cells
+	for r := 1; r < rows; r++ {
+		for c := 1; c < cols; c++ {
+			if matrix[r][c] == 0 {
+				matrix[r][0], matrix[0][c] = 0, 0
+			}
+		}
+	}
+	// Sweep the interior using the headers, then the headers themselves
+	for r := 1; r < rows; r++ {
+		for c := 1; c < cols; c++ {
+			if matrix[r][0] == 0 || matrix[0][c] == 0 {
+				matrix[r][c] = 0
+			}
+		}
+	}
+	if firstRowZero {
+		for c := 0; c < cols; c++ {
+			matrix[0][c] = 0
+		}
+	}
+	if firstColZero {
+		for r := 0; r < rows; r++ {
+			matrix[r][0] = 0
+		}
+	}
+}
+
+// ================================
+// STAIRCASE SEARCH
+// ================================
+
+// SearchSortedMatrix finds target in a matrix whose rows and columns are
+// both sorted ascending. Starting at the top-right corner, every
+// comparison discards a row (value too small) or a column (too large).
+// Returns the position and true, or -1, -1 and false.
+// Time Complexity: O(rows + cols)
+// Space Complexity: O(1)
+func SearchSortedMatrix(matrix [][]int, target int) (int, int, bool) {
+	return searchSortedMatrix(matrix, target, false)
+}
+
+// searchSortedMatrixVerbose is SearchSortedMatrix with step-by-step output
+func searchSortedMatrixVerbose(matrix [][]int, target int) (int, int, bool) {
+	return searchSortedMatrix(matrix, target, true)
+}
+
+func searchSortedMatrix(matrix [][]int, target int, verbose bool) (int, int, bool) {
+	if len(matrix) == 0 {
+		return -1, -1, false
+	}
+	r, c := 0, len(matrix[0])-1
+	step := 1
+	for r < len(matrix) && c >= 0 {
+		value := matrix[r][c]
+		if verbose {
+			fmt.Printf("  Step %d: matrix[%d][%d] = %d", step, r, c, value)
+		}
+		switch {
+		case value == target:
+			if verbose {
+				fmt.Println(" -> found!")
+			}
+			return r, c, true
+		case value < target:
+			if verbose {
+				fmt.Printf(" < %d, the rest of row %d is smaller, move down\n", target, r)
+			}
+			r++
+		default:
+			if verbose {
+				fmt.Printf(" > %d, the rest of column %d is larger, move left\n", target, c)
+			}
+			c--
+		}
+		step++
+	}
+	if verbose {
+		fmt.Printf("  Walked off the matrix: %d not present\n", target)
+	}
+	return -1, -1, false
+}
+
+// printMatrix prints a matrix with aligned columns
+func printMatrix(matrix [][]int) {
+	for _, row := range matrix {
+		for _, cell := range row {
+			fmt.Printf("%4d", cell)
+		}
+		fmt.Println()
+	}
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoMatrixAlgorithms demonstrates in-place matrix manipulation and search
+func DemoMatrixAlgorithms() {
+	fmt.Println("=== MATRIX ALGORITHMS ===")
+	fmt.Println()
+
+	// Example 1: Rotation
+	fmt.Println("=== EXAMPLE 1: Rotate 90° Clockwise ===")
+	square := [][]int{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}
+	fmt.Println("Original")
+	printMatrix(square)
+	rotateMatrixVerbose(square)
+	if err := RotateMatrix([][]int{{1, 2, 3}, {4, 5, 6}}); err != nil {
+		fmt.Printf("2x3 matrix: %v\n", err)
+	}
+	fmt.Println()
+
+	// Example 2: Spiral order
+	fmt.Println("=== EXAMPLE 2: Spiral Order ===")
+	for _, matrix := range [][][]int{
+		{{1, 2, 3, 4}, {5, 6, 7, 8}, {9, 10, 11, 12}},
+		{{1, 2}, {3, 4}, {5, 6}},
+		{{1, 2, 3}},
+	} {
+		fmt.Printf("%v -> %v\n", matrix, SpiralOrder(matrix))
+	}
+	fmt.Println()
+
+	// Example 3: Set matrix zeroes
+	fmt.Println("=== EXAMPLE 3: Set Matrix Zeroes ===")
+	zeroes := [][]int{{1, 2, 3, 4}, {5, 0, 7, 8}, {9, 10, 11, 0}, {13, 14, 15, 16}}
+	fmt.Println("Before")
+	printMatrix(zeroes)
+	SetMatrixZeroes(zeroes)
+	fmt.Println("After (rows 1, 2 and columns 1, 3 cleared)")
+	printMatrix(zeroes)
+	fmt.Println()
+
+	// Example 4: Staircase search
+	fmt.Println("=== EXAMPLE 4: Search a Row/Column-Sorted Matrix ===")
+	sorted := [][]int{
+		{1, 4, 7, 11, 15},
+		{2, 5, 8, 12, 19},
+		{3, 6, 9, 16, 22},
+		{10, 13, 14, 17, 24},
+		{18, 21, 23, 26, 30},
+	}
+	printMatrix(sorted)
+	for _, target := range []int{14, 20} {
+		fmt.Printf("Searching for %d from the top-right corner:\n", target)
+		searchSortedMatrixVerbose(sorted, target)
+	}
+	fmt.Println()
+
+	fmt.Println("The top-right corner is the largest of its row and the smallest of its")
+	fmt.Println("column, so one comparison always rules out a whole row or column.")
+	fmt.Println()
+}
@@ -0,0 +1,285 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+// ================================
+// FLOW NETWORK
+// ================================
+
+// flowArc is one direction of an edge in the residual graph.
+// Every AddEdge creates a forward arc and a reverse arc with capacity 0;
+// pushing flow along one arc frees the same amount on its partner.
+type flowArc struct {
+	to       int
+	capacity int // remaining (residual) capacity
+	original int // capacity given to AddEdge, 0 for reverse arcs
+}
+
+// FlowNetwork is a directed graph with edge capacities
+type FlowNetwork struct {
+	vertices int
+	arcs     []flowArc // arc i and arc i^1 are partners
+	adjList  [][]int   // arc indexes leaving each vertex
+	level    []int     // BFS level from the source, rebuilt each phase
+	next     []int     // next arc to try per vertex during DFS
+}
+
+// FlowEdge describes an edge and the flow currently on it
+type FlowEdge struct {
+	From     int
+	To       int
+	Flow     int
+	Capacity int
+}
+
+// NewFlowNetwork creates a flow network with the given number of vertices
+func NewFlowNetwork(vertices int) *FlowNetwork {
+	return &FlowNetwork{
+		vertices: vertices,
+		adjList:  make([][]int, vertices),
+	}
+}
+
+// AddEdge adds a directed edge from u to v with the given capacity
+func (fn *FlowNetwork) AddEdge(u, v, capacity int) {
+	fn.adjList[u] = append(fn.adjList[u], len(fn.arcs))
+	fn.arcs = append(fn.arcs, flowArc{to: v, capacity: capacity, original: capacity})
+	fn.adjList[v] = append(fn.adjList[v], len(fn.arcs))
+	fn.arcs = append(fn.arcs, flowArc{to: u})
+}
+
+// ================================
+// DINIC'S ALGORITHM
+// ================================
+
+// MaxFlow computes the maximum flow from source to sink with Dinic's algorithm.
+// Each phase builds a level graph with BFS, then sends a blocking flow with
+// DFS along arcs that go exactly one level deeper.
+// Time Complexity: O(V² * E), O(E * sqrt(V)) on unit-capacity bipartite graphs
+// Space Complexity: O(V + E)
+func (fn *FlowNetwork) MaxFlow(source, sink int) int {
+	if source == sink {
+		return 0
+	}
+
+	total := 0
+	for fn.buildLevels(source, sink) {
+		fn.next = make([]int, fn.vertices)
+		for {
+			pushed := fn.sendFlow(source, sink, math.MaxInt)
+			if pushed == 0 {
+				break
+			}
+			total += pushed
+		}
+	}
+	return total
+}
+
+// buildLevels labels vertices by BFS distance from source over arcs with
+// residual capacity, and reports whether the sink is still reachable
+func (fn *FlowNetwork) buildLevels(source, sink int) bool {
+	fn.level = make([]int, fn.vertices)
+	for i := range fn.level {
+		fn.level[i] = -1
+	}
+	fn.level[source] = 0
+
+	queue := Queue[int]{}
+	queue.Enqueue(source)
+	for queue.Len() > 0 {
+		u, _ := queue.Dequeue()
+		for _, id := range fn.adjList[u] {
+			arc := fn.arcs[id]
+			if arc.capacity > 0 && fn.level[arc.to] == -1 {
+				fn.level[arc.to] = fn.level[u] + 1
+				queue.Enqueue(arc.to)
+			}
+		}
+	}
+	return fn.level[sink] != -1
+}
+
+// sendFlow pushes up to limit units from u towards sink along the level graph.
+// fn.next skips arcs that are already saturated or lead to dead ends.
+func (fn *FlowNetwork) sendFlow(u, sink, limit int) int {
+	if u == sink {
+		return limit
+	}
+
+	for ; fn.next[u] < len(fn.adjList[u]); fn.next[u]++ {
+		id := fn.adjList[u][fn.next[u]]
+		arc := fn.arcs[id]
+		if arc.capacity <= 0 || fn.level[arc.to] != fn.level[u]+1 {
+			continue
+		}
+
+		if pushed := fn.sendFlow(arc.to, sink, min(limit, arc.capacity)); pushed > 0 {
+			fn.arcs[id].capacity -= pushed
+			fn.arcs[id^1].capacity += pushed
+			return pushed
+		}
+	}
+	return 0
+}
+
+// ================================
+// RESIDUAL GRAPH AND MIN-CUT
+// ================================
+
+// Edges returns every edge added with AddEdge and the flow it carries
+func (fn *FlowNetwork) Edges() []FlowEdge {
+	edges := []FlowEdge{}
+	for id := 0; id < len(fn.arcs); id += 2 {
+		arc := fn.arcs[id]
+		edges = append(edges, FlowEdge{
+			From:     fn.arcs[id^1].to,
+			To:       arc.to,
+			Flow:     arc.original - arc.capacity,
+			Capacity: arc.original,
+		})
+	}
+	return edges
+}
+
+// ResidualCapacity returns how much more flow could be sent directly from u
+// to v: unused capacity on u -> v plus flow on v -> u that could be cancelled
+func (fn *FlowNetwork) ResidualCapacity(u, v int) int {
+	residual := 0
+	for _, id := range fn.adjList[u] {
+		if fn.arcs[id].to == v {
+			residual += fn.arcs[id].capacity
+		}
+	}
+	return residual
+}
+
+// MinCut returns the source side of a minimum cut and the saturated edges
+// crossing it. Call after MaxFlow; the cut capacity equals the max flow.
+// Time Complexity: O(V + E)
+func (fn *FlowNetwork) MinCut(source int) ([]int, []FlowEdge) {
+	reachable := make([]bool, fn.vertices)
+	reachable[source] = true
+	queue := Queue[int]{}
+	queue.Enqueue(source)
+	for queue.Len() > 0 {
+		u, _ := queue.Dequeue()
+		for _, id := range fn.adjList[u] {
+			arc := fn.arcs[id]
+			if arc.capacity > 0 && !reachable[arc.to] {
+				reachable[arc.to] = true
+				queue.Enqueue(arc.to)
+			}
+		}
+	}
+
+	sourceSide := []int{}
+	for v, inSide := range reachable {
+		if inSide {
+			sourceSide = append(sourceSide, v)
+		}
+	}
+
+	cut := []FlowEdge{}
+	for _, edge := range fn.Edges() {
+		if reachable[edge.From] && !reachable[edge.To] {
+			cut = append(cut, edge)
+		}
+	}
+	return sourceSide, cut
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoMaxFlow demonstrates Dinic's algorithm on assignment and pipeline problems
+func DemoMaxFlow() {
+	fmt.Println("=== MAXIMUM FLOW (DINIC'S ALGORITHM) ===")
+	fmt.Println()
+
+	// Example 1: Bipartite assignment
+	fmt.Println("=== EXAMPLE 1: Assigning Workers to Jobs ===")
+	workers := []string{"Alice", "Bob", "Carol", "Dave"}
+	jobs := []string{"Frontend", "Backend", "Database", "DevOps"}
+	skills := map[string][]string{
+		"Alice": {"Frontend", "Backend"},
+		"Bob":   {"Frontend"},
+		"Carol": {"Backend", "Database", "DevOps"},
+		"Dave":  {"Database"},
+	}
+
+	// Vertex layout: source, workers, jobs, sink
+	source := 0
+	sink := len(workers) + len(jobs) + 1
+	network := NewFlowNetwork(sink + 1)
+	jobIndex := make(map[string]int)
+	for j, job := range jobs {
+		jobIndex[job] = 1 + len(workers) + j
+		network.AddEdge(jobIndex[job], sink, 1)
+	}
+	for w, worker := range workers {
+		network.AddEdge(source, 1+w, 1)
+		for _, job := range skills[worker] {
+			network.AddEdge(1+w, jobIndex[job], 1)
+		}
+	}
+
+	assigned := network.MaxFlow(source, sink)
+	fmt.Printf("Maximum assignments: %d of %d\n", assigned, len(workers))
+	for _, edge := range network.Edges() {
+		isWorkerToJob := edge.From >= 1 && edge.From <= len(workers) && edge.To != sink
+		if isWorkerToJob && edge.Flow > 0 {
+			fmt.Printf("  %s -> %s\n", workers[edge.From-1], jobs[edge.To-1-len(workers)])
+		}
+	}
+	fmt.Println()
+
+	// Example 2: Pipeline capacity
+	fmt.Println("=== EXAMPLE 2: Oil Pipeline Capacity ===")
+	stations := []string{"Refinery", "Pump-A", "Pump-B", "Junction-C", "Junction-D", "Port"}
+	pipes := []FlowEdge{
+		{From: 0, To: 1, Capacity: 16},
+		{From: 0, To: 2, Capacity: 13},
+		{From: 2, To: 1, Capacity: 4},
+		{From: 1, To: 3, Capacity: 12},
+		{From: 3, To: 2, Capacity: 9},
+		{From: 2, To: 4, Capacity: 14},
+		{From: 4, To: 3, Capacity: 7},
+		{From: 3, To: 5, Capacity: 20},
+		{From: 4, To: 5, Capacity: 4},
+	}
+	pipeline := NewFlowNetwork(len(stations))
+	for _, pipe := range pipes {
+		pipeline.AddEdge(pipe.From, pipe.To, pipe.Capacity)
+	}
+
+	maxFlow := pipeline.MaxFlow(0, 5)
+	fmt.Printf("Maximum throughput Refinery -> Port: %d units/hour\n", maxFlow)
+	fmt.Println("Flow per pipe:")
+	for _, edge := range pipeline.Edges() {
+		fmt.Printf("  %-10s -> %-10s %2d / %2d\n", stations[edge.From], stations[edge.To], edge.Flow, edge.Capacity)
+	}
+
+	sourceSide, cut := pipeline.MinCut(0)
+	cutCapacity := 0
+	fmt.Print("Minimum cut, refinery side: ")
+	for _, v := range sourceSide {
+		fmt.Printf("%s ", stations[v])
+	}
+	fmt.Println()
+	fmt.Println("Bottleneck pipes (all flow to the port crosses these):")
+	for _, edge := range cut {
+		cutCapacity += edge.Capacity
+		fmt.Printf("  %s -> %s (capacity %d)\n", stations[edge.From], stations[edge.To], edge.Capacity)
+	}
+	fmt.Printf("Cut capacity %d equals max flow %d: %v\n", cutCapacity, maxFlow, cutCapacity == maxFlow)
+	fmt.Printf("Residual capacity Pump-B -> Junction-D: %d\n\n", pipeline.ResidualCapacity(2, 4))
+
+	fmt.Println("Time Complexity: O(V² * E) in general")
+	fmt.Println("Unit-capacity bipartite matching: O(E * sqrt(V))")
+	fmt.Println()
+}
@@ -0,0 +1,357 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ================================
+// MAZE PARSING AND SOLVING
+// ================================
+
+// Maze cell symbols
+const (
+	mazeWall  = '#'
+	mazeOpen  = '.'
+	mazeStart = 'S'
+	mazeGoal  = 'G'
+	mazePath  = '*'
+)
+
+// Maze is a rectangular grid of walls and open cells.
+// Cell (r, c) is vertex r*cols + c, so a Maze can be used wherever an
+// AdjacencyGraph is accepted.
+type Maze struct {
+	grid  [][]byte
+	rows  int
+	cols  int
+	start int // vertex id of 'S'
+	goal  int // vertex id of 'G'
+}
+
+// MazeAlgorithm selects the search used by Maze.Solve
+type MazeAlgorithm int
+
+const (
+	MazeBFS   MazeAlgorithm = iota // shortest path, explores in rings
+	MazeDFS                        // any path, explores one corridor at a time
+	MazeAStar                      // shortest path, guided by Manhattan distance
+)
+
+// String returns the algorithm name
+func (a MazeAlgorithm) String() string {
+	switch a {
+	case MazeBFS:
+		return "BFS"
+	case MazeDFS:
+		return "DFS"
+	case MazeAStar:
+		return "A*"
+	}
+	return "unknown"
+}
+
+// MazeSolution contains the path found and how much work the search did
+type MazeSolution struct {
+	Algorithm MazeAlgorithm
+	Path      []int // vertex ids from start to goal
+	Explored  int   // number of cells expanded
+}
+
+// ParseMaze reads a maze from text. '#' is a wall, '.' or ' ' is open,
+// 'S' marks the start and 'G' the goal. Rows shorter than the widest row
+// are padded with walls.
+func ParseMaze(text string) (*Maze, error) {
+	lines := strings.Split(strings.Trim(text, "\r\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSuffix(line, "\r")
+	}
+	if len(lines) == 0 || (len(lines) == 1 && lines[0] == "") {
+		return nil, fmt.Errorf("maze is empty")
+	}
+
+	cols := 0
+	for _, line := range lines {
+		if len(line) > cols {
+			cols = len(line)
+		}
+	}
+
+	maze := &Maze{rows: len(lines), cols: cols, start: -1, goal: -1}
+	maze.grid = make([][]byte, maze.rows)
+
+	for r, line := range lines {
+		maze.grid[r] = make([]byte, cols)
+		for c := 0; c < cols; c++ {
+			cell := byte(mazeWall)
+			if c < len(line) {
+				cell = line[c]
+			}
+
+			switch cell {
+			case mazeWall, mazeOpen:
+			case ' ':
+				cell = mazeOpen
+			case mazeStart:
+				if maze.start != -1 {
+					return nil, fmt.Errorf("maze has more than one start")
+				}
+				maze.start = r*cols + c
+			case mazeGoal:
+				if maze.goal != -1 {
+					return nil, fmt.Errorf("maze has more than one goal")
+				}
+				maze.goal = r*cols + c
+			default:
+				return nil, fmt.Errorf("unexpected character %q at row %d, column %d", cell, r, c)
+			}
+			maze.grid[r][c] = cell
+		}
+	}
+
+	if maze.start == -1 {
+		return nil, fmt.Errorf("maze has no start 'S'")
+	}
+	if maze.goal == -1 {
+		return nil, fmt.Errorf("maze has no goal 'G'")
+	}
+	return maze, nil
+}
+
+// cell converts a vertex id to (row, column)
+func (m *Maze) cell(v int) (int, int) {
+	return v / m.cols, v % m.cols
+}
+
+// isOpen reports whether (r, c) is inside the maze and not a wall
+func (m *Maze) isOpen(r, c int) bool {
+	return r >= 0 && r < m.rows && c >= 0 && c < m.cols && m.grid[r][c] != mazeWall
+}
+
+// Vertices returns the ids of all open cells
+func (m *Maze) Vertices() []int {
+	vertices := []int{}
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			if m.isOpen(r, c) {
+				vertices = append(vertices, r*m.cols+c)
+			}
+		}
+	}
+	return vertices
+}
+
+// Neighbors returns the open cells up, down, left and right of v
+func (m *Maze) Neighbors(v int) []int {
+	r, c := m.cell(v)
+	neighbors := []int{}
+	for _, dir := range [][]int{{-1, 0}, {1, 0}, {0, -1}, {0, 1}} {
+		nr, nc := r+dir[0], c+dir[1]
+		if m.isOpen(nr, nc) {
+			neighbors = append(neighbors, nr*m.cols+nc)
+		}
+	}
+	return neighbors
+}
+
+// manhattan is the A* heuristic: grid distance ignoring walls
+func (m *Maze) manhattan(v int) float64 {
+	r1, c1 := m.cell(v)
+	r2, c2 := m.cell(m.goal)
+	return float64(abs(r1-r2) + abs(c1-c2))
+}
+
+// abs returns the absolute value of x
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// Solve finds a path from 'S' to 'G' with the chosen algorithm
+func (m *Maze) Solve(algorithm MazeAlgorithm) (*MazeSolution, error) {
+	var previous map[int]int
+	var explored int
+
+	switch algorithm {
+	case MazeBFS:
+		previous, explored = m.solveBFS()
+	case MazeDFS:
+		previous, explored = m.solveDFS()
+	case MazeAStar:
+		previous, explored = m.solveAStar()
+	default:
+		return nil, fmt.Errorf("unknown maze algorithm %d", algorithm)
+	}
+
+	if _, found := previous[m.goal]; !found {
+		return nil, fmt.Errorf("goal is unreachable with %v", algorithm)
+	}
+
+	path := []int{}
+	for v := m.goal; v != -1; v = previous[v] {
+		path = append(path, v)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return &MazeSolution{Algorithm: algorithm, Path: path, Explored: explored}, nil
+}
+
+// solveBFS explores cells in order of distance from the start
+func (m *Maze) solveBFS() (map[int]int, int) {
+	previous := map[int]int{m.start: -1}
+	queue := Queue[int]{}
+	queue.Enqueue(m.start)
+	explored := 0
+
+	for queue.Len() > 0 {
+		v, _ := queue.Dequeue()
+		explored++
+		if v == m.goal {
+			break
+		}
+
+		for _, next := range m.Neighbors(v) {
+			if _, seen := previous[next]; !seen {
+				previous[next] = v
+				queue.Enqueue(next)
+			}
+		}
+	}
+	return previous, explored
+}
+
+// solveDFS follows one corridor as deep as possible before backtracking.
+// A cell is marked when it is popped, not when it is pushed, so its
+// previous cell is the one the search actually came from.
+func (m *Maze) solveDFS() (map[int]int, int) {
+	previous := make(map[int]int)
+	stack := [][2]int{{m.start, -1}} // cell and the cell that pushed it
+	explored := 0
+
+	for len(stack) > 0 {
+		top := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		v := top[0]
+		if _, visited := previous[v]; visited {
+			continue
+		}
+		previous[v] = top[1]
+		explored++
+		if v == m.goal {
+			break
+		}
+
+		for _, next := range m.Neighbors(v) {
+			if _, visited := previous[next]; !visited {
+				stack = append(stack, [2]int{next, v})
+			}
+		}
+	}
+	return previous, explored
+}
+
+// solveAStar expands the cell with the lowest distance + heuristic first
+func (m *Maze) solveAStar() (map[int]int, int) {
+	previous := map[int]int{m.start: -1}
+	distance := map[int]float64{m.start: 0}
+	closed := make(map[int]bool)
+	explored := 0
+
+	pq := NewIndexedMinHeap[float64](m.rows * m.cols)
+	pq.Push(m.start, m.manhattan(m.start))
+
+	for pq.Len() > 0 {
+		v, _, _ := pq.Pop()
+		closed[v] = true
+		explored++
+		if v == m.goal {
+			break
+		}
+
+		for _, next := range m.Neighbors(v) {
+			newDistance := distance[v] + 1
+			if old, seen := distance[next]; !closed[next] && (!seen || newDistance < old) {
+				distance[next] = newDistance
+				previous[next] = v
+				pq.PushOrDecrease(next, newDistance+m.manhattan(next))
+			}
+		}
+	}
+	return previous, explored
+}
+
+// Render draws the maze with the path marked by '*'
+func (m *Maze) Render(path []int) string {
+	canvas := make([][]byte, m.rows)
+	for r := range m.grid {
+		canvas[r] = append([]byte(nil), m.grid[r]...)
+	}
+	for _, v := range path {
+		r, c := m.cell(v)
+		if canvas[r][c] == mazeOpen {
+			canvas[r][c] = mazePath
+		}
+	}
+
+	var sb strings.Builder
+	for _, row := range canvas {
+		sb.Write(row)
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoMaze parses an ASCII maze and solves it with every algorithm
+func DemoMaze() {
+	fmt.Println("=== MAZE SOLVER ===")
+	fmt.Println()
+
+	text := `
+##########
+#S...#...#
+#.##.#.#.#
+#.#..#.#.#
+#.#.##.#.#
+#...#..#G#
+###.#.####
+#.......##
+##########`
+
+	maze, err := ParseMaze(text)
+	if err != nil {
+		fmt.Printf("Parse error: %v\n", err)
+		return
+	}
+
+	fmt.Println("Maze:")
+	fmt.Print(maze.Render(nil))
+	fmt.Printf("Open cells: %d\n\n", len(maze.Vertices()))
+
+	for _, algorithm := range []MazeAlgorithm{MazeBFS, MazeDFS, MazeAStar} {
+		solution, err := maze.Solve(algorithm)
+		if err != nil {
+			fmt.Printf("%v: %v\n\n", algorithm, err)
+			continue
+		}
+		fmt.Printf("%v: path length %d, cells explored %d\n",
+			algorithm, len(solution.Path)-1, solution.Explored)
+		fmt.Print(maze.Render(solution.Path))
+		fmt.Println()
+	}
+
+	// The maze is an AdjacencyGraph, so the generic helpers work on it too
+	fmt.Printf("Maze contains a loop: %v\n\n", GraphHasCycle(maze))
+
+	blocked, _ := ParseMaze("#####\n#S#G#\n#####")
+	if _, err := blocked.Solve(MazeBFS); err != nil {
+		fmt.Printf("Blocked maze: %v\n\n", err)
+	}
+}
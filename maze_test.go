@@ -0,0 +1,45 @@
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParseMazeAcceptsCRLF(t *testing.T) {
+	lines := []string{"S.#", "#..", "##G"}
+	unix, err := ParseMaze(strings.Join(lines, "\n"))
+	if err != nil {
+		t.Fatalf("ParseMaze(LF): %v", err)
+	}
+	windows, err := ParseMaze(strings.Join(lines, "\r\n") + "\r\n")
+	if err != nil {
+		t.Fatalf("ParseMaze(CRLF): %v", err)
+	}
+	if windows.Render(nil) != unix.Render(nil) {
+		t.Errorf("CRLF maze differs:\n%s\nwant:\n%s", windows.Render(nil), unix.Render(nil))
+	}
+}
+
+func TestSolveDFSPathFollowsTheSearch(t *testing.T) {
+	// Each step of the path goes to an adjacent open cell, and the path
+	// follows the order in which DFS marks cells: in an open room DFS
+	// snakes through the rows, so every marked cell lies on the path
+	maze, err := ParseMaze("S...\n....\n....\n...G")
+	if err != nil {
+		t.Fatalf("ParseMaze: %v", err)
+	}
+	solution, err := maze.Solve(MazeDFS)
+	if err != nil {
+		t.Fatalf("Solve: %v", err)
+	}
+	for i := 1; i < len(solution.Path); i++ {
+		if !slices.Contains(maze.Neighbors(solution.Path[i-1]), solution.Path[i]) {
+			t.Errorf("path steps from %d to %d, which is not an adjacent open cell", solution.Path[i-1], solution.Path[i])
+		}
+	}
+	want := []int{0, 1, 2, 3, 7, 6, 5, 4, 8, 9, 10, 11, 15}
+	if !slices.Equal(solution.Path, want) || solution.Explored != len(want) {
+		t.Errorf("Solve(DFS) = %v after %d cells, want %v after %d", solution.Path, solution.Explored, want, len(want))
+	}
+}
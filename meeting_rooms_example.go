@@ -0,0 +1,120 @@
+package main
+
+import (
+	"container/heap"
+	"fmt"
+	"sort"
+)
+
+// ================================
+// MEETING ROOMS
+// ================================
+
+// Meeting occupies a room from Start up to, but not including, End
+type Meeting struct {
+	Name       string
+	Start, End int
+}
+
+// busyRoom is a room in use until end
+type busyRoom struct {
+	end, room int
+}
+
+// roomHeap is a min-heap of busy rooms by end time; ties go to the lower
+// room number so assignments are deterministic
+type roomHeap []busyRoom
+
+func (h roomHeap) Len() int { return len(h) }
+
+func (h roomHeap) Less(i, j int) bool {
+	if h[i].end != h[j].end {
+		return h[i].end < h[j].end
+	}
+	return h[i].room < h[j].room
+}
+
+func (h roomHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *roomHeap) Push(x interface{}) { *h = append(*h, x.(busyRoom)) }
+
+func (h *roomHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[0 : n-1]
+	return item
+}
+
+// MinMeetingRooms returns the fewest rooms that can host all meetings and
+// a room number for each meeting (in input order).
+// Greedy: go through meetings by start time and reuse the room that frees
+// up first if it is free by then; otherwise open a new room. A new room is
+// only opened when every open room is busy, so the count equals the
+// largest number of meetings overlapping at one moment.
+// Time Complexity: O(n log n)
+// Space Complexity: O(n)
+func MinMeetingRooms(meetings []Meeting) (int, []int) {
+	order := make([]int, len(meetings))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return meetings[order[a]].Start < meetings[order[b]].Start
+	})
+
+	busy := &roomHeap{}
+	rooms := 0
+	assignment := make([]int, len(meetings))
+	for _, i := range order {
+		room := rooms
+		if busy.Len() > 0 && (*busy)[0].end <= meetings[i].Start {
+			room = heap.Pop(busy).(busyRoom).room
+		} else {
+			rooms++
+		}
+		assignment[i] = room
+		heap.Push(busy, busyRoom{meetings[i].End, room})
+	}
+	return rooms, assignment
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoMeetingRooms demonstrates assigning meetings to the fewest rooms
+func DemoMeetingRooms() {
+	fmt.Println("=== MEETING ROOMS ===")
+	fmt.Println()
+
+	fmt.Println("Each meeting needs a room for its whole duration. Find the fewest")
+	fmt.Println("rooms that fit all meetings.")
+	fmt.Println()
+
+	meetings := []Meeting{
+		{"Standup", 9, 10},
+		{"Design review", 9, 12},
+		{"Interview", 10, 11},
+		{"Lunch talk", 12, 13},
+		{"Planning", 11, 13},
+		{"1:1", 10, 12},
+		{"Retro", 13, 14},
+	}
+	rooms, assignment := MinMeetingRooms(meetings)
+	fmt.Printf("Rooms needed: %d\n", rooms)
+	for room := 0; room < rooms; room++ {
+		fmt.Printf("  Room %d:", room)
+		for i, meeting := range meetings {
+			if assignment[i] == room {
+				fmt.Printf(" %s [%d-%d)", meeting.Name, meeting.Start, meeting.End)
+			}
+		}
+		fmt.Println()
+	}
+	fmt.Println("  (a meeting ending at 11 frees its room for one starting at 11)")
+	fmt.Println()
+
+	fmt.Println("Time Complexity: O(n log n) - sort plus one heap operation per meeting")
+	fmt.Println()
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"slices"
+)
+
+// ================================
+// K-WAY MERGE ITERATOR
+// ================================
+
+// MergeSortedSeq lazily merges ascending sequences into one ascending
+// sequence. Each source is pulled one element at a time, and an
+// IndexedMinHeap keyed by source index holds the current head of every
+// source that still has elements, so at most k values are buffered and
+// sources may be unbounded. Stopping early releases every source.
+// The sources must each be sorted; this is not checked.
+// Time Complexity: O(log k) per element for k sources
+// Space Complexity: O(k)
+func MergeSortedSeq[T cmp.Ordered](sources ...iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		nexts := make([]func() (T, bool), len(sources))
+		heads := NewIndexedMinHeap[T](len(sources))
+		for i, source := range sources {
+			next, stop := iter.Pull(source)
+			defer stop()
+			nexts[i] = next
+			if value, ok := next(); ok {
+				heads.Push(i, value)
+			}
+		}
+		for heads.Len() > 0 {
+			i, value, _ := heads.Pop()
+			if !yield(value) {
+				return
+			}
+			if value, ok := nexts[i](); ok {
+				heads.Push(i, value)
+			}
+		}
+	}
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// multiplesOf yields step, 2*step, 3*step, ... without end, counting how
+// many values it has produced
+func multiplesOf(step int, produced *int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for value := step; ; value += step {
+			*produced++
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// DemoMergeIterator demonstrates lazy k-way merging of sorted sequences
+func DemoMergeIterator() {
+	fmt.Println("=== K-WAY MERGE ITERATOR ===")
+	fmt.Println()
+
+	// Example 1: Finite slices
+	fmt.Println("=== EXAMPLE 1: Merging Sorted Slices ===")
+	lists := [][]int{{1, 4, 5}, {1, 3, 4}, {2, 6}, {}}
+	sources := []iter.Seq[int]{}
+	for _, list := range lists {
+		sources = append(sources, slices.Values(list))
+	}
+	fmt.Printf("Lists:  %v\n", lists)
+	fmt.Printf("Merged: %v\n", slices.Collect(MergeSortedSeq(sources...)))
+	sorted, runs := ExternalSort([]int{9, 2, 7, 4, 8, 1, 6, 3, 5, 0}, 3)
+	fmt.Printf("ExternalSort with runs of 3: %v (%d runs)\n", sorted, runs)
+	fmt.Println()
+
+	// Example 2: Unbounded sources
+	fmt.Println("=== EXAMPLE 2: Merging Infinite Streams ===")
+	produced := make([]int, 3)
+	merged := MergeSortedSeq(multiplesOf(3, &produced[0]), multiplesOf(5, &produced[1]), multiplesOf(7, &produced[2]))
+	values := []int{}
+	for value := range merged {
+		if len(values) == 12 {
+			break
+		}
+		values = append(values, value)
+	}
+	fmt.Printf("First 12 multiples of 3, 5 or 7 (with repeats): %v\n", values)
+	fmt.Printf("Values pulled from each stream: %v\n", produced)
+	fmt.Println()
+
+	// Example 3: Sorted trie iterators
+	fmt.Println("=== EXAMPLE 3: Merging Keys of Two Tries ===")
+	fruit, veg := NewTrieMap[int](), NewTrieMap[int]()
+	for _, word := range []string{"cherry", "apple", "banana", "cranberry"} {
+		fruit.Put(word, len(word))
+	}
+	for _, word := range []string{"carrot", "asparagus", "cabbage", "beet"} {
+		veg.Put(word, len(word))
+	}
+	fmt.Printf("All keys:          %v\n", slices.Collect(MergeSortedSeq(fruit.Keys(""), veg.Keys(""))))
+	fmt.Printf("Keys under \"c\":    %v\n", slices.Collect(MergeSortedSeq(fruit.Keys("c"), veg.Keys("c"))))
+	fmt.Println()
+}
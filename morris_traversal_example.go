@@ -2,6 +2,9 @@ package main
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+	"sync"
 )
 
 // ================================
@@ -82,33 +85,10 @@ func MorrisInorderTraversal(root *MorrisTreeNode) []int {
 // MorrisInorderSimple is a clean version without debug output
 func MorrisInorderSimple(root *MorrisTreeNode) []int {
 	result := []int{}
-	current := root
-
-	for current != nil {
-		if current.Left == nil {
-			// No left subtree, visit current and go right
-			result = append(result, current.Val)
-			current = current.Right
-		} else {
-			// Find inorder predecessor
-			predecessor := current.Left
-			for predecessor.Right != nil && predecessor.Right != current {
-				predecessor = predecessor.Right
-			}
-
-			if predecessor.Right == nil {
-				// Create thread and go left
-				predecessor.Right = current
-				current = current.Left
-			} else {
-				// Remove thread, visit current, and go right
-				predecessor.Right = nil
-				result = append(result, current.Val)
-				current = current.Right
-			}
-		}
-	}
-
+	MorrisInorderVisit(root, func(node *MorrisTreeNode) bool {
+		result = append(result, node.Val)
+		return true
+	})
 	return result
 }
 
@@ -163,34 +143,13 @@ func IterativeInorder(root *MorrisTreeNode) []int {
 // MorrisPreorderTraversal performs preorder traversal using Morris algorithm
 func MorrisPreorderTraversal(root *MorrisTreeNode) []int {
 	result := []int{}
-	current := root
 
 	fmt.Println("=== MORRIS PREORDER TRAVERSAL ===")
 
-	for current != nil {
-		if current.Left == nil {
-			// No left subtree, visit current and go right
-			result = append(result, current.Val)
-			current = current.Right
-		} else {
-			// Find inorder predecessor
-			predecessor := current.Left
-			for predecessor.Right != nil && predecessor.Right != current {
-				predecessor = predecessor.Right
-			}
-
-			if predecessor.Right == nil {
-				// First time visiting, visit current, create thread, and go left
-				result = append(result, current.Val) // Visit before going left (preorder)
-				predecessor.Right = current
-				current = current.Left
-			} else {
-				// Remove thread and go right (don't visit again)
-				predecessor.Right = nil
-				current = current.Right
-			}
-		}
-	}
+	MorrisPreorderVisit(root, func(node *MorrisTreeNode) bool {
+		result = append(result, node.Val)
+		return true
+	})
 
 	fmt.Printf("Preorder result: %v\n\n", result)
 	return result
@@ -322,30 +281,32 @@ func VisualizeTree(root *MorrisTreeNode) {
 	fmt.Println()
 }
 
-// getLevels returns nodes at each level for visualization
-func getLevels(root *MorrisTreeNode) map[int][]*MorrisTreeNode {
-	levels := make(map[int][]*MorrisTreeNode)
+// getLevels returns nodes at each level for visualization, indexed by depth
+func getLevels(root *MorrisTreeNode) [][]*MorrisTreeNode {
+	levels := [][]*MorrisTreeNode{}
 	if root == nil {
 		return levels
 	}
 
-	queue := []*MorrisTreeNode{root}
-	levelQueue := []int{0}
+	type entry struct {
+		node  *MorrisTreeNode
+		level int
+	}
+	queue := Queue[entry]{}
+	queue.Enqueue(entry{root, 0})
 
-	for len(queue) > 0 {
-		node := queue[0]
-		level := levelQueue[0]
-		queue = queue[1:]
-		levelQueue = levelQueue[1:]
+	for queue.Len() > 0 {
+		current, _ := queue.Dequeue()
+		node, level := current.node, current.level
 
-		if levels[level] == nil {
-			levels[level] = []*MorrisTreeNode{}
+		if level == len(levels) {
+			levels = append(levels, []*MorrisTreeNode{})
 		}
 		levels[level] = append(levels[level], node)
 
 		if node != nil {
-			queue = append(queue, node.Left, node.Right)
-			levelQueue = append(levelQueue, level+1, level+1)
+			queue.Enqueue(entry{node.Left, level + 1})
+			queue.Enqueue(entry{node.Right, level + 1})
 		}
 	}
 
@@ -393,107 +354,282 @@ func equalIntSlices(a, b []int) bool {
 }
 
 // ================================
-// ADVANCED APPLICATIONS
+// SAFE TRAVERSAL WITH GUARANTEED RESTORATION
 // ================================
 
-// MorrisTraversalValidator validates BST property using Morris traversal
-func MorrisTraversalValidator(root *MorrisTreeNode) bool {
-	if root == nil {
-		return true
+// activeMorrisRoots records trees currently being threaded so that a second
+// traversal of the same tree (from another goroutine or from inside a
+// callback) is detected instead of silently corrupting the threads.
+// It is keyed by the root passed in, so only whole-tree re-entrancy is
+// caught: walking a subtree of a tree that is being threaded, or the whole
+// tree from one of its subtrees, goes unnoticed. Tracking every threaded
+// node would cost the O(n) space that Morris traversal exists to avoid.
+var activeMorrisRoots sync.Map
+
+// MorrisVisitor is called for every node in traversal order.
+// Returning false stops the traversal early.
+type MorrisVisitor func(node *MorrisTreeNode) bool
+
+// morrisOrder selects which traversal morrisWalk performs
+type morrisOrder int
+
+const (
+	morrisInorder        morrisOrder = iota // left, node, right
+	morrisReverseInorder                    // right, node, left (mirror image)
+	morrisPreorder                          // node, left, right
+)
+
+// MorrisInorderVisit walks the tree inorder in O(1) extra space, calling visit
+// for each node. The tree is always restored before returning: if visit stops
+// early or panics, a deferred cleanup finishes the walk silently so every
+// thread that was created is removed again. Returns true if all nodes were visited.
+//
+// The tree must not be read by anyone else while the traversal is running,
+// because threads temporarily turn it into a graph with cycles. A second
+// walk from the same root panics; a walk over an overlapping subtree is not
+// detected.
+func MorrisInorderVisit(root *MorrisTreeNode, visit MorrisVisitor) bool {
+	return morrisWalk(root, morrisInorder, visit)
+}
+
+// MorrisReverseInorderVisit walks the tree in descending (right-to-left) order
+// with the same restoration guarantees as MorrisInorderVisit
+func MorrisReverseInorderVisit(root *MorrisTreeNode, visit MorrisVisitor) bool {
+	return morrisWalk(root, morrisReverseInorder, visit)
+}
+
+// MorrisPreorderVisit walks the tree in preorder with the same restoration
+// guarantees as MorrisInorderVisit
+func MorrisPreorderVisit(root *MorrisTreeNode, visit MorrisVisitor) bool {
+	return morrisWalk(root, morrisPreorder, visit)
+}
+
+// morrisChildren returns pointers to the child visited first (near) and the
+// child that holds threads (far); reverse traversal simply mirrors the tree
+func morrisChildren(node *MorrisTreeNode, order morrisOrder) (near, far **MorrisTreeNode) {
+	if order == morrisReverseInorder {
+		return &node.Right, &node.Left
 	}
+	return &node.Left, &node.Right
+}
 
-	current := root
-	prev := -1 << 31 // Minimum integer value
+// morrisCursor is the single implementation of the threading loop, shared
+// by every Morris-based traversal over any node type N (a pointer type whose
+// zero value is the empty tree). links returns pointers to the child visited
+// first (near) and the child that holds threads (far), so a mirrored walk
+// just swaps them. threads counts the far pointers currently borrowed.
+type morrisCursor[N comparable] struct {
+	current  N
+	links    func(N) (near, far *N)
+	preorder bool
+	threads  int
+}
 
-	fmt.Println("=== BST VALIDATION USING MORRIS TRAVERSAL ===")
+// next advances to the next node in traversal order, or returns false once
+// the walk is over. The cursor moves past a node before returning it, so
+// finish can resume from the right place if the caller stops there.
+// Time Complexity: O(1) amortized (each edge is walked at most 3 times)
+func (c *morrisCursor[N]) next() (N, bool) {
+	var none N
+	for c.current != none {
+		current := c.current
+		near, far := c.links(current)
+		if *near == none {
+			c.current = *far // a real child or a thread
+			return current, true
+		}
 
-	for current != nil {
-		if current.Left == nil {
-			// Visit current node
-			fmt.Printf("Visiting node %d (previous was %d)\n", current.Val, prev)
-			if current.Val <= prev {
-				fmt.Printf("BST property violated: %d <= %d\n", current.Val, prev)
-				return false
+		// Find the predecessor: the far-most node of the near subtree
+		predecessor := *near
+		_, predFar := c.links(predecessor)
+		for *predFar != none && *predFar != current {
+			predecessor = *predFar
+			_, predFar = c.links(predecessor)
+		}
+
+		if *predFar == none {
+			// First time here: create thread and descend
+			*predFar = current
+			c.threads++
+			c.current = *near
+			if c.preorder {
+				return current, true
 			}
-			prev = current.Val
-			current = current.Right
 		} else {
-			// Find predecessor
-			predecessor := current.Left
-			for predecessor.Right != nil && predecessor.Right != current {
-				predecessor = predecessor.Right
-			}
-
-			if predecessor.Right == nil {
-				// Create thread
-				predecessor.Right = current
-				current = current.Left
-			} else {
-				// Remove thread and visit current
-				predecessor.Right = nil
-				fmt.Printf("Visiting node %d (previous was %d)\n", current.Val, prev)
-				if current.Val <= prev {
-					fmt.Printf("BST property violated: %d <= %d\n", current.Val, prev)
-					return false
-				}
-				prev = current.Val
-				current = current.Right
+			// Second time here: remove thread and move on
+			*predFar = none
+			c.threads--
+			c.current = *far
+			if !c.preorder {
+				return current, true
 			}
 		}
 	}
+	return none, false
+}
 
-	fmt.Println("BST property maintained throughout traversal")
-	return true
+// finish continues the walk without returning nodes until every thread has
+// been removed, then ends it
+// Time Complexity: O(n) in the worst case
+func (c *morrisCursor[N]) finish() {
+	for c.threads > 0 {
+		c.next()
+	}
+	var none N
+	c.current = none
 }
 
-// KthSmallestElementMorris finds kth smallest element using Morris traversal
-func KthSmallestElementMorris(root *MorrisTreeNode, k int) int {
-	if root == nil || k <= 0 {
-		return -1
+// morrisWalk drives a morrisCursor over a MorrisTreeNode tree, calling visit
+// for each node and finishing the walk however it ends
+func morrisWalk(root *MorrisTreeNode, order morrisOrder, visit MorrisVisitor) bool {
+	if root == nil {
+		return true
+	}
+	if _, busy := activeMorrisRoots.LoadOrStore(root, true); busy {
+		panic("morris: tree is already being traversed")
 	}
 
-	current := root
-	count := 0
+	cursor := &morrisCursor[*MorrisTreeNode]{
+		current:  root,
+		links:    func(node *MorrisTreeNode) (near, far **MorrisTreeNode) { return morrisChildren(node, order) },
+		preorder: order == morrisPreorder,
+	}
+	defer func() {
+		cursor.finish()
+		activeMorrisRoots.Delete(root)
+	}()
 
-	fmt.Printf("=== FINDING %d-TH SMALLEST ELEMENT ===\n", k)
+	for node, ok := cursor.next(); ok; node, ok = cursor.next() {
+		if !visit(node) {
+			return false
+		}
+	}
+	return true
+}
 
-	for current != nil {
-		if current.Left == nil {
-			// Visit current node
-			count++
-			fmt.Printf("Visiting node %d (count = %d)\n", current.Val, count)
-			if count == k {
-				fmt.Printf("Found %d-th smallest element: %d\n\n", k, current.Val)
-				return current.Val
+// VerifyNoThreads checks that no Right pointer loops back into the tree,
+// i.e. that every node is reachable exactly once from root
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+func VerifyNoThreads(root *MorrisTreeNode) error {
+	seen := make(map[*MorrisTreeNode]bool)
+	stack := []*MorrisTreeNode{}
+	if root != nil {
+		stack = append(stack, root)
+	}
+
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		seen[node] = true
+
+		for _, child := range []*MorrisTreeNode{node.Left, node.Right} {
+			if child == nil {
+				continue
 			}
-			current = current.Right
-		} else {
-			// Find predecessor
-			predecessor := current.Left
-			for predecessor.Right != nil && predecessor.Right != current {
-				predecessor = predecessor.Right
+			if seen[child] {
+				return fmt.Errorf("node %d points back to already visited node %d (leftover thread)", node.Val, child.Val)
 			}
+			stack = append(stack, child)
+		}
+	}
 
-			if predecessor.Right == nil {
-				// Create thread
-				predecessor.Right = current
-				current = current.Left
-			} else {
-				// Remove thread and visit current
-				predecessor.Right = nil
-				count++
-				fmt.Printf("Visiting node %d (count = %d)\n", current.Val, count)
-				if count == k {
-					fmt.Printf("Found %d-th smallest element: %d\n\n", k, current.Val)
-					return current.Val
-				}
-				current = current.Right
-			}
+	return nil
+}
+
+// SerializeTree encodes the tree shape and values in preorder with '#' for
+// nil children. Two trees serialize identically iff they are structurally equal,
+// which makes it easy to check that a traversal left the tree untouched.
+// Only call this on trees without threads (see VerifyNoThreads).
+func SerializeTree(root *MorrisTreeNode) string {
+	var sb strings.Builder
+	serializeHelper(root, &sb)
+	return sb.String()
+}
+
+func serializeHelper(node *MorrisTreeNode, sb *strings.Builder) {
+	if node == nil {
+		sb.WriteString("#,")
+		return
+	}
+	sb.WriteString(strconv.Itoa(node.Val))
+	sb.WriteByte(',')
+	serializeHelper(node.Left, sb)
+	serializeHelper(node.Right, sb)
+}
+
+// ================================
+// ADVANCED APPLICATIONS
+// ================================
+
+// MorrisTraversalValidator validates BST property using Morris traversal.
+// Stopping at the first violation is safe: the visitor restores the tree.
+func MorrisTraversalValidator(root *MorrisTreeNode) bool {
+	if root == nil {
+		return true
+	}
+
+	prev := -1 << 31 // Minimum integer value
+
+	fmt.Println("=== BST VALIDATION USING MORRIS TRAVERSAL ===")
+
+	valid := MorrisInorderVisit(root, func(node *MorrisTreeNode) bool {
+		fmt.Printf("Visiting node %d (previous was %d)\n", node.Val, prev)
+		if node.Val <= prev {
+			fmt.Printf("BST property violated: %d <= %d\n", node.Val, prev)
+			return false
 		}
+		prev = node.Val
+		return true
+	})
+
+	if valid {
+		fmt.Println("BST property maintained throughout traversal")
 	}
+	return valid
+}
 
-	fmt.Printf("Tree has fewer than %d elements\n\n", k)
-	return -1
+// KthSmallestElementMorris finds kth smallest element using Morris traversal;
+// ok is false if the tree has fewer than k nodes
+func KthSmallestElementMorris(root *MorrisTreeNode, k int) (int, bool) {
+	fmt.Printf("=== FINDING %d-TH SMALLEST ELEMENT ===\n", k)
+	return kthMorris(root, k, "smallest", MorrisInorderVisit)
+}
+
+// KthLargestElementMorris finds kth largest element using reverse Morris
+// traversal; ok is false if the tree has fewer than k nodes
+func KthLargestElementMorris(root *MorrisTreeNode, k int) (int, bool) {
+	fmt.Printf("=== FINDING %d-TH LARGEST ELEMENT ===\n", k)
+	return kthMorris(root, k, "largest", MorrisReverseInorderVisit)
+}
+
+// kthMorris returns the value of the kth node produced by walk, and whether
+// there was one
+func kthMorris(root *MorrisTreeNode, k int, label string,
+	walk func(*MorrisTreeNode, MorrisVisitor) bool) (int, bool) {
+	if root == nil || k <= 0 {
+		return 0, false
+	}
+
+	count := 0
+	result, found := 0, false
+
+	walk(root, func(node *MorrisTreeNode) bool {
+		count++
+		fmt.Printf("Visiting node %d (count = %d)\n", node.Val, count)
+		if count == k {
+			result, found = node.Val, true
+			return false
+		}
+		return true
+	})
+
+	if !found {
+		fmt.Printf("Tree has fewer than %d elements\n\n", k)
+	} else {
+		fmt.Printf("Found %d-th %s element: %d\n\n", k, label, result)
+	}
+	return result, found
 }
 
 // ================================
@@ -502,7 +638,8 @@ func KthSmallestElementMorris(root *MorrisTreeNode, k int) int {
 
 // DemoMorrisTraversal demonstrates Morris traversal with detailed examples
 func DemoMorrisTraversal() {
-	fmt.Println("=== MORRIS TRAVERSAL ALGORITHM ===\n")
+	fmt.Println("=== MORRIS TRAVERSAL ALGORITHM ===")
+	fmt.Println()
 
 	fmt.Println("Morris Traversal is a tree traversal technique that achieves:")
 	fmt.Println("✓ O(n) time complexity")
@@ -544,7 +681,8 @@ func DemoMorrisTraversal() {
 
 // DemoMorrisApplications shows practical applications
 func DemoMorrisApplications() {
-	fmt.Println("=== PRACTICAL APPLICATIONS ===\n")
+	fmt.Println("=== PRACTICAL APPLICATIONS ===")
+	fmt.Println()
 
 	// Application 1: BST Validation
 	fmt.Println("1. BST VALIDATION")
@@ -569,8 +707,14 @@ func DemoMorrisApplications() {
 	VisualizeTree(tree)
 
 	k := 5
-	kthElement := KthSmallestElementMorris(tree, k)
-	fmt.Printf("Result: %d\n", kthElement)
+	if kthElement, ok := KthSmallestElementMorris(tree, k); ok {
+		fmt.Printf("Result: %d\n", kthElement)
+	}
+
+	if kthLargest, ok := KthLargestElementMorris(tree, 3); ok {
+		fmt.Printf("3rd largest: %d\n", kthLargest)
+	}
+	fmt.Printf("Tree intact after early exits: %v\n\n", VerifyNoThreads(tree) == nil)
 
 	// Application 3: Different traversal orders
 	fmt.Println("3. DIFFERENT TRAVERSAL ORDERS")
@@ -610,3 +754,65 @@ func DemoMorrisApplications() {
 	fmt.Println("- Tree structure is restored after traversal")
 	fmt.Println()
 }
+
+// DemoMorrisSafety shows that the tree is restored even when a traversal
+// stops early or its callback panics
+func DemoMorrisSafety() {
+	fmt.Println("=== MORRIS TRAVERSAL SAFETY ===")
+	fmt.Println()
+
+	tree := BuildComplexTree()
+	before := SerializeTree(tree)
+	fmt.Printf("Tree before: %s\n\n", before)
+
+	// Case 1: caller stops after three nodes
+	fmt.Println("1. EARLY EXIT")
+	visited := []int{}
+	MorrisInorderVisit(tree, func(node *MorrisTreeNode) bool {
+		visited = append(visited, node.Val)
+		return len(visited) < 3
+	})
+	fmt.Printf("Visited: %v\n", visited)
+	fmt.Printf("No threads left: %v\n", VerifyNoThreads(tree) == nil)
+	fmt.Printf("Tree identical: %v\n\n", SerializeTree(tree) == before)
+
+	// Case 2: callback panics in the middle of the traversal
+	fmt.Println("2. PANICKING CALLBACK")
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				fmt.Printf("Recovered from panic: %v\n", r)
+			}
+		}()
+		MorrisInorderVisit(tree, func(node *MorrisTreeNode) bool {
+			if node.Val == 12 {
+				panic("callback failed at node 12")
+			}
+			return true
+		})
+	}()
+	fmt.Printf("No threads left: %v\n", VerifyNoThreads(tree) == nil)
+	fmt.Printf("Tree identical: %v\n\n", SerializeTree(tree) == before)
+
+	// Case 3: nested traversal of the same tree is a read hazard
+	fmt.Println("3. CONCURRENT TRAVERSAL DETECTION")
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				fmt.Printf("Detected: %v\n", r)
+			}
+		}()
+		MorrisInorderVisit(tree, func(node *MorrisTreeNode) bool {
+			MorrisInorderVisit(tree, func(*MorrisTreeNode) bool { return true })
+			return true
+		})
+	}()
+	fmt.Printf("No threads left: %v\n", VerifyNoThreads(tree) == nil)
+	fmt.Printf("Tree identical: %v\n\n", SerializeTree(tree) == before)
+
+	// Leftover threads are reported by VerifyNoThreads
+	fmt.Println("4. DETECTING A CORRUPTED TREE")
+	corrupted := BuildSampleTree()
+	corrupted.Left.Right.Right = corrupted // a thread that was never removed
+	fmt.Printf("VerifyNoThreads: %v\n\n", VerifyNoThreads(corrupted))
+}
@@ -0,0 +1,191 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+)
+
+// morrisGoldenTrees pairs each sample tree with its serialization before
+// any traversal has touched it
+var morrisGoldenTrees = []struct {
+	name   string
+	build  func() *MorrisTreeNode
+	golden string
+}{
+	{"sample", BuildSampleTree, "4,2,1,#,#,3,#,#,6,5,#,#,7,#,#,"},
+	{"complex", BuildComplexTree, "10,5,3,1,#,#,#,7,6,#,#,8,#,#,15,12,#,#,20,#,25,#,#,"},
+	{"linear", BuildLinearTree, "1,#,2,#,3,#,4,#,5,#,#,"},
+	{"single", func() *MorrisTreeNode { return NewMorrisTreeNode(42) }, "42,#,#,"},
+}
+
+var morrisWalks = []struct {
+	name string
+	walk func(*MorrisTreeNode, MorrisVisitor) bool
+}{
+	{"inorder", MorrisInorderVisit},
+	{"reverse inorder", MorrisReverseInorderVisit},
+	{"preorder", MorrisPreorderVisit},
+}
+
+// morrisLinks records every node's child pointers, so a restored tree must
+// reuse the same nodes in the same places, not just equal values
+func morrisLinks(root *MorrisTreeNode) map[*MorrisTreeNode][2]*MorrisTreeNode {
+	links := map[*MorrisTreeNode][2]*MorrisTreeNode{}
+	var walk func(*MorrisTreeNode)
+	walk = func(node *MorrisTreeNode) {
+		if node == nil {
+			return
+		}
+		links[node] = [2]*MorrisTreeNode{node.Left, node.Right}
+		walk(node.Left)
+		walk(node.Right)
+	}
+	walk(root)
+	return links
+}
+
+// assertUntouched fails unless root matches golden and still has exactly
+// the child pointers recorded in links
+func assertUntouched(t *testing.T, context string, root *MorrisTreeNode, golden string, links map[*MorrisTreeNode][2]*MorrisTreeNode) {
+	t.Helper()
+	if err := VerifyNoThreads(root); err != nil {
+		t.Fatalf("%s: %v", context, err)
+	}
+	if got := SerializeTree(root); got != golden {
+		t.Fatalf("%s: tree is %s, want %s", context, got, golden)
+	}
+	for node, children := range links {
+		if node.Left != children[0] || node.Right != children[1] {
+			t.Fatalf("%s: node %d has different children", context, node.Val)
+		}
+	}
+}
+
+func TestMorrisGoldenTrees(t *testing.T) {
+	for _, tree := range morrisGoldenTrees {
+		if got := SerializeTree(tree.build()); got != tree.golden {
+			t.Errorf("%s: SerializeTree = %s, want %s", tree.name, got, tree.golden)
+		}
+	}
+	if got := SerializeTree(nil); got != "#," {
+		t.Errorf("SerializeTree(nil) = %s, want #,", got)
+	}
+}
+
+// TestMorrisRestoresTreeAfterEarlyExit stops every traversal after each
+// possible number of nodes, or not at all, and checks the tree is unchanged
+func TestMorrisRestoresTreeAfterEarlyExit(t *testing.T) {
+	for _, tree := range morrisGoldenTrees {
+		root := tree.build()
+		links := morrisLinks(root)
+		for _, walk := range morrisWalks {
+			for stop := 1; stop <= len(links)+1; stop++ {
+				visited := 0
+				complete := walk.walk(root, func(*MorrisTreeNode) bool {
+					visited++
+					return visited < stop
+				})
+				context := fmt.Sprintf("%s %s stopped after %d", tree.name, walk.name, stop)
+				if complete != (stop > len(links)) || visited != min(stop, len(links)) {
+					t.Errorf("%s: returned %v after %d visits", context, complete, visited)
+				}
+				assertUntouched(t, context, root, tree.golden, links)
+			}
+		}
+	}
+}
+
+// TestMorrisRestoresTreeAfterPanic panics at every node in turn; the panic
+// must reach the caller and the tree must be restored on the way out
+func TestMorrisRestoresTreeAfterPanic(t *testing.T) {
+	for _, tree := range morrisGoldenTrees {
+		root := tree.build()
+		links := morrisLinks(root)
+		for _, walk := range morrisWalks {
+			for target := range links {
+				context := fmt.Sprintf("%s %s panicking at %d", tree.name, walk.name, target.Val)
+				recovered := func() (r any) {
+					defer func() { r = recover() }()
+					walk.walk(root, func(node *MorrisTreeNode) bool {
+						if node == target {
+							panic(context)
+						}
+						return true
+					})
+					return nil
+				}()
+				if recovered != context {
+					t.Fatalf("%s: recovered %v", context, recovered)
+				}
+				assertUntouched(t, context, root, tree.golden, links)
+			}
+		}
+	}
+}
+
+// TestMorrisNestedTraversalPanics checks that walking a tree from inside its
+// own traversal is refused and leaves both walks' threads cleaned up
+func TestMorrisNestedTraversalPanics(t *testing.T) {
+	root := BuildComplexTree()
+	links := morrisLinks(root)
+	golden := SerializeTree(root)
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("nested traversal did not panic")
+			}
+		}()
+		MorrisInorderVisit(root, func(*MorrisTreeNode) bool {
+			MorrisPreorderVisit(root, func(*MorrisTreeNode) bool { return true })
+			return true
+		})
+	}()
+	assertUntouched(t, "after nested traversal", root, golden, links)
+
+	// The tree is free to be traversed again afterwards
+	values := []int{}
+	MorrisInorderVisit(root, func(node *MorrisTreeNode) bool {
+		values = append(values, node.Val)
+		return true
+	})
+	if want := RecursiveInorder(root); !equalIntSlices(values, want) {
+		t.Errorf("inorder after recovery = %v, want %v", values, want)
+	}
+}
+
+func TestVerifyNoThreadsDetectsThread(t *testing.T) {
+	root := BuildSampleTree()
+	root.Left.Right.Right = root
+	if err := VerifyNoThreads(root); err == nil {
+		t.Error("VerifyNoThreads missed a leftover thread")
+	}
+}
+
+func TestKthMorrisReportsMissingElement(t *testing.T) {
+	tree := NewMorrisTreeNode(-1)
+	if value, ok := KthSmallestElementMorris(tree, 1); !ok || value != -1 {
+		t.Errorf("KthSmallestElementMorris(k=1) = %d, %v; want -1, true", value, ok)
+	}
+	if _, ok := KthLargestElementMorris(tree, 2); ok {
+		t.Error("KthLargestElementMorris(k=2) found an element in a one-node tree")
+	}
+}
+
+func TestBSTKthMorrisRestoresTree(t *testing.T) {
+	tree := NewBST()
+	for _, val := range []int{50, 30, 70, 20, 40, 60, 80, 65} {
+		tree.Insert(val)
+	}
+	want := fmt.Sprint(tree.InOrder())
+	for k, expected := range []int{20, 30, 40, 50, 60, 65, 70, 80} {
+		if got, ok := bstKthMorris(tree.root, k+1); !ok || got != expected {
+			t.Errorf("bstKthMorris(k=%d) = %d, %v; want %d, true", k+1, got, ok, expected)
+		}
+		if got := fmt.Sprint(tree.InOrder()); got != want {
+			t.Fatalf("after k=%d inorder = %s, want %s", k+1, got, want)
+		}
+	}
+	if _, ok := bstKthMorris(tree.root, 9); ok {
+		t.Error("bstKthMorris(k=9) found an element in an 8-node tree")
+	}
+}
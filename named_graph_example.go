@@ -0,0 +1,193 @@
+package main
+
+import (
+	"fmt"
+)
+
+// ================================
+// NAMED (KEYED) WEIGHTED GRAPH
+// ================================
+
+// NamedGraph is a WeightedGraph whose vertices are identified by keys such
+// as city or router names. An index map translates keys to the dense vertex
+// ids the algorithms work on, so lookups are O(1) instead of a linear scan.
+type NamedGraph[K comparable] struct {
+	graph *WeightedGraph
+	index map[K]int
+	keys  []K // keys[i] is the key of vertex i
+}
+
+// NewNamedGraph creates a graph with one vertex per key, in the given order.
+// Duplicate keys are added once.
+func NewNamedGraph[K comparable](keys []K) *NamedGraph[K] {
+	ng := &NamedGraph[K]{
+		graph: NewWeightedGraph(0),
+		index: make(map[K]int, len(keys)),
+	}
+	for _, key := range keys {
+		ng.AddVertex(key)
+	}
+	return ng
+}
+
+// AddVertex adds key as a new vertex and returns its id.
+// If key already exists its current id is returned.
+func (ng *NamedGraph[K]) AddVertex(key K) int {
+	if id, exists := ng.index[key]; exists {
+		return id
+	}
+	id := len(ng.keys)
+	ng.index[key] = id
+	ng.keys = append(ng.keys, key)
+	ng.graph.adjList = append(ng.graph.adjList, nil)
+	ng.graph.vertices++
+	return id
+}
+
+// Index returns the vertex id of key and whether key exists
+func (ng *NamedGraph[K]) Index(key K) (int, bool) {
+	id, exists := ng.index[key]
+	return id, exists
+}
+
+// Key returns the key of vertex id
+func (ng *NamedGraph[K]) Key(id int) K {
+	return ng.keys[id]
+}
+
+// Keys returns all keys in vertex id order
+func (ng *NamedGraph[K]) Keys() []K {
+	return append([]K(nil), ng.keys...)
+}
+
+// Len returns the number of vertices
+func (ng *NamedGraph[K]) Len() int {
+	return len(ng.keys)
+}
+
+// Graph returns the underlying WeightedGraph for id-based algorithms.
+// Vertex i of the result is Key(i).
+func (ng *NamedGraph[K]) Graph() *WeightedGraph {
+	return ng.graph
+}
+
+// AddEdge adds a directed edge between two existing keys
+func (ng *NamedGraph[K]) AddEdge(from, to K, weight float64) error {
+	u, v, err := ng.endpoints(from, to)
+	if err != nil {
+		return err
+	}
+	ng.graph.AddEdge(u, v, weight)
+	return nil
+}
+
+// AddUndirectedEdge adds an edge in both directions between two existing keys
+func (ng *NamedGraph[K]) AddUndirectedEdge(a, b K, weight float64) error {
+	u, v, err := ng.endpoints(a, b)
+	if err != nil {
+		return err
+	}
+	ng.graph.AddUndirectedEdge(u, v, weight)
+	return nil
+}
+
+// endpoints looks up both keys of an edge
+func (ng *NamedGraph[K]) endpoints(from, to K) (int, int, error) {
+	u, ok := ng.index[from]
+	if !ok {
+		return 0, 0, fmt.Errorf("unknown vertex %v", from)
+	}
+	v, ok := ng.index[to]
+	if !ok {
+		return 0, 0, fmt.Errorf("unknown vertex %v", to)
+	}
+	return u, v, nil
+}
+
+// ShortestPath runs Dijkstra from one key to another and returns the path as
+// keys with its total weight. ok is false if either key is unknown or to is
+// unreachable.
+// Time Complexity: O((V + E) log V)
+func (ng *NamedGraph[K]) ShortestPath(from, to K) (path []K, distance float64, ok bool) {
+	u, v, err := ng.endpoints(from, to)
+	if err != nil {
+		return nil, 0, false
+	}
+	distance, ids := ng.graph.DijkstraWithPath(u, v)
+	if ids == nil {
+		return nil, 0, false
+	}
+	return ng.keysOf(ids), distance, true
+}
+
+// keysOf converts a path of vertex ids to keys
+func (ng *NamedGraph[K]) keysOf(ids []int) []K {
+	keys := make([]K, len(ids))
+	for i, id := range ids {
+		keys[i] = ng.keys[id]
+	}
+	return keys
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// gridCell is a comparable struct key, showing keys need not be strings
+type gridCell struct {
+	row, col int
+}
+
+// DemoNamedGraph demonstrates key-based edges and shortest paths
+func DemoNamedGraph() {
+	fmt.Println("=== NAMED GRAPH ===")
+	fmt.Println()
+
+	// Example 1: String keys
+	fmt.Println("=== EXAMPLE 1: String Keys ===")
+	rail := NewNamedGraph([]string{"Paris", "Lyon", "Geneva", "Turin"})
+	rail.AddUndirectedEdge("Paris", "Lyon", 2.0)
+	rail.AddUndirectedEdge("Lyon", "Geneva", 1.9)
+	rail.AddUndirectedEdge("Lyon", "Turin", 4.0)
+	rail.AddUndirectedEdge("Geneva", "Turin", 3.5)
+	zurich := rail.AddVertex("Zurich") // vertices can be added after construction
+	rail.AddUndirectedEdge("Geneva", "Zurich", 2.8)
+	fmt.Printf("Zurich got vertex id %d; Index(\"Lyon\") = ", zurich)
+	fmt.Println(rail.Index("Lyon"))
+
+	if path, hours, ok := rail.ShortestPath("Paris", "Zurich"); ok {
+		fmt.Printf("Paris -> Zurich: %v (%.1f h)\n", path, hours)
+	}
+	if err := rail.AddEdge("Paris", "Berlin", 8); err != nil {
+		fmt.Printf("AddEdge Paris -> Berlin: %v\n", err)
+	}
+	_, _, ok := rail.ShortestPath("Paris", "Berlin")
+	fmt.Printf("Path to Berlin found: %v\n\n", ok)
+
+	// Example 2: Struct keys
+	fmt.Println("=== EXAMPLE 2: Struct Keys (grid cells) ===")
+	grid := NewNamedGraph[gridCell](nil)
+	for row := 0; row < 3; row++ {
+		for col := 0; col < 3; col++ {
+			grid.AddVertex(gridCell{row, col})
+		}
+	}
+	for row := 0; row < 3; row++ {
+		for col := 0; col < 3; col++ {
+			cost := float64(1 + (row+col)%3)
+			if col+1 < 3 {
+				grid.AddUndirectedEdge(gridCell{row, col}, gridCell{row, col + 1}, cost)
+			}
+			if row+1 < 3 {
+				grid.AddUndirectedEdge(gridCell{row, col}, gridCell{row + 1, col}, cost)
+			}
+		}
+	}
+	path, cost, _ := grid.ShortestPath(gridCell{0, 0}, gridCell{2, 2})
+	fmt.Printf("Cheapest walk (0,0) -> (2,2): %v, cost %.0f\n", path, cost)
+	fmt.Println()
+
+	fmt.Println("Algorithms still run on dense ids; the key map is only used at the edges")
+	fmt.Println("of the API, and Graph() exposes the ids for index-based algorithms.")
+	fmt.Println()
+}
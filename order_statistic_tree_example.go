@@ -0,0 +1,269 @@
+package main
+
+import (
+	"fmt"
+)
+
+// ================================
+// ORDER-STATISTIC TREE
+// ================================
+
+// ostNode is an AVL node augmented with the number of values in its subtree.
+// Equal values share one node and are tracked by count.
+type ostNode struct {
+	key    int
+	count  int // copies of key stored in this node
+	size   int // total values in this subtree, counting duplicates
+	height int
+	left   *ostNode
+	right  *ostNode
+}
+
+// OrderStatisticTree is a balanced BST (AVL) that answers rank and
+// selection queries in O(log n) by keeping subtree sizes up to date
+type OrderStatisticTree struct {
+	root *ostNode
+}
+
+// NewOrderStatisticTree creates an empty tree
+func NewOrderStatisticTree() *OrderStatisticTree {
+	return &OrderStatisticTree{}
+}
+
+func ostSize(n *ostNode) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func ostHeight(n *ostNode) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+// update recomputes size and height from the children
+func (n *ostNode) update() {
+	n.size = ostSize(n.left) + n.count + ostSize(n.right)
+	n.height = 1 + max(ostHeight(n.left), ostHeight(n.right))
+}
+
+func rotateRight(n *ostNode) *ostNode {
+	pivot := n.left
+	n.left = pivot.right
+	pivot.right = n
+	n.update()
+	pivot.update()
+	return pivot
+}
+
+func rotateLeft(n *ostNode) *ostNode {
+	pivot := n.right
+	n.right = pivot.left
+	pivot.left = n
+	n.update()
+	pivot.update()
+	return pivot
+}
+
+// rebalance restores the AVL property (child heights differ by at most 1)
+func rebalance(n *ostNode) *ostNode {
+	n.update()
+	balance := ostHeight(n.left) - ostHeight(n.right)
+	if balance > 1 {
+		if ostHeight(n.left.left) < ostHeight(n.left.right) {
+			n.left = rotateLeft(n.left)
+		}
+		return rotateRight(n)
+	}
+	if balance < -1 {
+		if ostHeight(n.right.right) < ostHeight(n.right.left) {
+			n.right = rotateRight(n.right)
+		}
+		return rotateLeft(n)
+	}
+	return n
+}
+
+// Insert adds one copy of x
+// Time Complexity: O(log n)
+func (t *OrderStatisticTree) Insert(x int) {
+	var insert func(n *ostNode) *ostNode
+	insert = func(n *ostNode) *ostNode {
+		if n == nil {
+			return &ostNode{key: x, count: 1, size: 1, height: 1}
+		}
+		switch {
+		case x < n.key:
+			n.left = insert(n.left)
+		case x > n.key:
+			n.right = insert(n.right)
+		default:
+			n.count++
+		}
+		return rebalance(n)
+	}
+	t.root = insert(t.root)
+}
+
+// Delete removes one copy of x. Returns false if x is not present.
+// Time Complexity: O(log n)
+func (t *OrderStatisticTree) Delete(x int) bool {
+	found := false
+	var remove func(n *ostNode, x int, all bool) *ostNode
+	remove = func(n *ostNode, x int, all bool) *ostNode {
+		if n == nil {
+			return nil
+		}
+		switch {
+		case x < n.key:
+			n.left = remove(n.left, x, all)
+		case x > n.key:
+			n.right = remove(n.right, x, all)
+		default:
+			found = true
+			if n.count > 1 && !all {
+				n.count--
+				break
+			}
+			if n.left == nil {
+				return n.right
+			}
+			if n.right == nil {
+				return n.left
+			}
+			// Move the successor's key and copies here, then unlink it
+			successor := n.right
+			for successor.left != nil {
+				successor = successor.left
+			}
+			n.key, n.count = successor.key, successor.count
+			n.right = remove(n.right, successor.key, true)
+		}
+		return rebalance(n)
+	}
+	t.root = remove(t.root, x, false)
+	return found
+}
+
+// Len returns the number of values stored, counting duplicates
+func (t *OrderStatisticTree) Len() int {
+	return ostSize(t.root)
+}
+
+// CountLess returns how many stored values are strictly less than x
+// Time Complexity: O(log n)
+func (t *OrderStatisticTree) CountLess(x int) int {
+	less := 0
+	for n := t.root; n != nil; {
+		if x <= n.key {
+			n = n.left
+		} else {
+			less += ostSize(n.left) + n.count
+			n = n.right
+		}
+	}
+	return less
+}
+
+// Rank returns the 1-based position x has (or would have) in sorted order,
+// i.e. CountLess(x) + 1
+func (t *OrderStatisticTree) Rank(x int) int {
+	return t.CountLess(x) + 1
+}
+
+// Select returns the k-th smallest value (1-based)
+// Time Complexity: O(log n)
+func (t *OrderStatisticTree) Select(k int) (int, error) {
+	if k < 1 || k > t.Len() {
+		return 0, fmt.Errorf("k = %d out of range [1, %d]", k, t.Len())
+	}
+	n := t.root
+	for {
+		leftSize := ostSize(n.left)
+		switch {
+		case k <= leftSize:
+			n = n.left
+		case k <= leftSize+n.count:
+			return n.key, nil
+		default:
+			k -= leftSize + n.count
+			n = n.right
+		}
+	}
+}
+
+// ================================
+// COUNT OF SMALLER NUMBERS AFTER SELF
+// ================================
+
+// CountSmallerAfterSelf returns, for each nums[i], how many later elements
+// are smaller. Scanning from the right, the tree holds exactly the elements
+// after i, so the answer is one CountLess query.
+// Time Complexity: O(n log n)
+// Space Complexity: O(n)
+func CountSmallerAfterSelf(nums []int) []int {
+	tree := NewOrderStatisticTree()
+	counts := make([]int, len(nums))
+	for i := len(nums) - 1; i >= 0; i-- {
+		counts[i] = tree.CountLess(nums[i])
+		tree.Insert(nums[i])
+	}
+	return counts
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoOrderStatisticTree demonstrates rank and select queries
+func DemoOrderStatisticTree() {
+	fmt.Println("=== ORDER-STATISTIC TREE ===")
+	fmt.Println()
+
+	// Example 1: Rank and select
+	fmt.Println("=== EXAMPLE 1: Rank and Select ===")
+	values := []int{41, 20, 65, 11, 29, 50, 91, 32, 72, 99, 29}
+	tree := NewOrderStatisticTree()
+	for _, v := range values {
+		tree.Insert(v)
+	}
+	fmt.Printf("Values: %v (height %d for %d values)\n", values, ostHeight(tree.root), tree.Len())
+
+	for _, k := range []int{1, 4, 6, 11} {
+		val, _ := tree.Select(k)
+		arr := append([]int(nil), values...)
+		fmt.Printf("Select(%d) = %d (QuickSelect agrees: %v)\n", k, val, FindKthSmallest(arr, k) == val)
+	}
+	fmt.Printf("Rank(50) = %d, CountLess(30) = %d\n", tree.Rank(50), tree.CountLess(30))
+
+	tree.Delete(29)
+	tree.Delete(65)
+	median, _ := tree.Select((tree.Len() + 1) / 2)
+	fmt.Printf("After deleting 29 and 65: median = %d of %d values\n", median, tree.Len())
+	if _, err := tree.Select(20); err != nil {
+		fmt.Printf("Select(20): %v\n", err)
+	}
+	fmt.Println()
+
+	// Example 2: Count of smaller numbers after self
+	fmt.Println("=== EXAMPLE 2: Count of Smaller Numbers After Self ===")
+	for _, nums := range [][]int{{5, 2, 6, 1}, {-1, -1}, {3, 1, 4, 1, 5, 9, 2, 6}} {
+		fmt.Printf("nums = %v -> %v\n", nums, CountSmallerAfterSelf(nums))
+	}
+	fmt.Println()
+
+	// Example 3: Balance under sorted input
+	sorted := NewOrderStatisticTree()
+	for i := 1; i <= 1000; i++ {
+		sorted.Insert(i)
+	}
+	fmt.Printf("Inserting 1..1000 in order: height %d (a plain BST would be 1000)\n", ostHeight(sorted.root))
+	fmt.Println()
+
+	fmt.Println("QuickSelect answers one k-th query in O(n); this tree answers any")
+	fmt.Println("number of rank/select queries in O(log n) each, with updates in between.")
+	fmt.Println()
+}
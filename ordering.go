@@ -0,0 +1,19 @@
+package main
+
+import (
+	"cmp"
+	"sort"
+)
+
+// sortedKeys returns the keys of m in ascending order so results built from
+// maps print and compare the same way on every run
+func sortedKeys[K cmp.Ordered, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i] < keys[j]
+	})
+	return keys
+}
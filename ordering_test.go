@@ -0,0 +1,136 @@
+package main
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// assertStable calls compute repeatedly and fails if any result differs
+// from the first
+func assertStable[T any](t *testing.T, name string, compute func() T) T {
+	t.Helper()
+	// Map iteration order is randomized per range loop, so unsorted output
+	// would differ within a few runs
+	const runs = 20
+	first := compute()
+	for i := 1; i < runs; i++ {
+		if got := compute(); !reflect.DeepEqual(got, first) {
+			t.Fatalf("%s changed between runs:\nfirst: %v\nrun %d: %v", name, first, i, got)
+		}
+	}
+	return first
+}
+
+func TestTextProcessorFindAllStable(t *testing.T) {
+	processor := NewTextProcessor()
+	for _, keyword := range []string{"fox", "quick", "the", "brown", "dog", "lazy"} {
+		processor.AddPattern(keyword, keyword)
+	}
+	text := "the quick brown fox jumps over the lazy dog. the fox is quick and brown."
+	results := assertStable(t, "FindAll", func() []PatternMatches { return processor.FindAll(text) })
+
+	names := []string{}
+	for _, result := range results {
+		names = append(names, result.Name)
+	}
+	if want := []string{"brown", "dog", "fox", "lazy", "quick", "the"}; !slices.Equal(names, want) {
+		t.Errorf("FindAll names = %v, want %v", names, want)
+	}
+	if fox := results[2].Positions; !slices.Equal(fox, []int{16, 49}) {
+		t.Errorf("fox positions = %v, want [16 49]", fox)
+	}
+}
+
+func TestDNASequenceAnalyzerStable(t *testing.T) {
+	patterns := map[string]string{
+		"Start Codon": "ATG",
+		"Stop Codon":  "TAG",
+		"Promoter":    "ATCG",
+		"Enhancer":    "GCTA",
+	}
+	dna := "ATCGATCGATCGTAGCTAGCTATCGATCGTAGCT"
+	results := assertStable(t, "DNASequenceAnalyzer", func() []PatternMatches { return DNASequenceAnalyzer(dna, patterns) })
+	want := []PatternMatches{
+		{"Enhancer", []int{14, 18}},
+		{"Promoter", []int{0, 4, 8, 21, 25}},
+		{"Start Codon", []int{}},
+		{"Stop Codon", []int{12, 16, 29}},
+	}
+	if !reflect.DeepEqual(results, want) {
+		t.Errorf("DNASequenceAnalyzer = %v, want %v", results, want)
+	}
+}
+
+func TestGetComponentsStable(t *testing.T) {
+	components := assertStable(t, "GetComponents", func() [][]int {
+		uf := NewUnionFind(8)
+		for _, pair := range [][2]int{{5, 1}, {7, 3}, {1, 0}, {6, 4}, {3, 6}} {
+			uf.Union(pair[0], pair[1])
+		}
+		return uf.GetComponents()
+	})
+	want := [][]int{{0, 1, 5}, {2}, {3, 4, 6, 7}}
+	if !reflect.DeepEqual(components, want) {
+		t.Errorf("GetComponents = %v, want %v", components, want)
+	}
+}
+
+func TestTrieWordsStable(t *testing.T) {
+	trie := NewTrie()
+	for _, word := range []string{"tea", "ten", "inn", "to", "in", "ted", "team", "i"} {
+		trie.InsertSimple(word)
+	}
+	all := assertStable(t, "GetAllWords", trie.GetAllWords)
+	if !slices.IsSorted(all) || len(all) != 8 {
+		t.Errorf("GetAllWords = %v, want 8 sorted words", all)
+	}
+	withPrefix := assertStable(t, "GetWordsWithPrefix", func() []string { return trie.GetWordsWithPrefix("te") })
+	if want := []string{"tea", "team", "ted", "ten"}; !slices.Equal(withPrefix, want) {
+		t.Errorf("GetWordsWithPrefix(te) = %v, want %v", withPrefix, want)
+	}
+}
+
+func TestAccountsMergeStable(t *testing.T) {
+	accounts := [][]string{
+		{"John", "johnsmith@mail.com", "john_newyork@mail.com"},
+		{"John", "johnsmith@mail.com", "john00@mail.com"},
+		{"Mary", "mary@mail.com"},
+		{"John", "johnnybravo@mail.com"},
+	}
+	merged := assertStable(t, "AccountsMerge", func() [][]string { return AccountsMerge(accounts) })
+	want := [][]string{
+		{"John", "john00@mail.com", "john_newyork@mail.com", "johnsmith@mail.com"},
+		{"John", "johnnybravo@mail.com"},
+		{"Mary", "mary@mail.com"},
+	}
+	if !reflect.DeepEqual(merged, want) {
+		t.Errorf("AccountsMerge = %v, want %v", merged, want)
+	}
+}
+
+func TestGetLevelsOrdered(t *testing.T) {
+	root := &MorrisTreeNode{Val: 4,
+		Left:  &MorrisTreeNode{Val: 2, Left: &MorrisTreeNode{Val: 1}, Right: &MorrisTreeNode{Val: 3}},
+		Right: &MorrisTreeNode{Val: 6, Right: &MorrisTreeNode{Val: 7}},
+	}
+	levels := assertStable(t, "getLevels", func() [][]int {
+		values := [][]int{}
+		for _, level := range getLevels(root) {
+			row := []int{}
+			for _, node := range level {
+				if node == nil {
+					row = append(row, -1) // missing children are kept as placeholders
+				} else {
+					row = append(row, node.Val)
+				}
+			}
+			values = append(values, row)
+		}
+		return values
+	})
+	want := [][]int{{4}, {2, 6}, {1, 3, -1, 7}, {-1, -1, -1, -1, -1, -1}}
+	if !reflect.DeepEqual(levels, want) {
+		t.Errorf("getLevels = %v, want %v", levels, want)
+	}
+}
@@ -0,0 +1,291 @@
+package main
+
+import (
+	"container/heap"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ================================
+// MULTI-CRITERIA GRAPH
+// ================================
+
+// MultiWeightEdge is an edge with one non-negative cost per criterion
+type MultiWeightEdge struct {
+	to    int
+	costs []float64
+}
+
+// MultiCriteriaGraph is a directed graph whose edges carry several costs,
+// such as price and duration. There is usually no single best path, only a
+// set of trade-offs.
+type MultiCriteriaGraph struct {
+	vertices int
+	criteria []string
+	adjList  [][]MultiWeightEdge
+}
+
+// NewMultiCriteriaGraph creates a graph whose edges have one cost per criterion
+func NewMultiCriteriaGraph(vertices int, criteria ...string) *MultiCriteriaGraph {
+	return &MultiCriteriaGraph{
+		vertices: vertices,
+		criteria: criteria,
+		adjList:  make([][]MultiWeightEdge, vertices),
+	}
+}
+
+// AddEdge adds a directed edge with one cost per criterion, in order
+func (g *MultiCriteriaGraph) AddEdge(from, to int, costs ...float64) error {
+	if len(costs) != len(g.criteria) {
+		return fmt.Errorf("edge %d -> %d has %d costs, want %d (%s)",
+			from, to, len(costs), len(g.criteria), strings.Join(g.criteria, ", "))
+	}
+	for _, cost := range costs {
+		if cost < 0 {
+			return fmt.Errorf("edge %d -> %d has negative cost %v", from, to, cost)
+		}
+	}
+	g.adjList[from] = append(g.adjList[from], MultiWeightEdge{to: to, costs: append([]float64(nil), costs...)})
+	return nil
+}
+
+// AddUndirectedEdge adds the edge in both directions
+func (g *MultiCriteriaGraph) AddUndirectedEdge(u, v int, costs ...float64) error {
+	if err := g.AddEdge(u, v, costs...); err != nil {
+		return err
+	}
+	return g.AddEdge(v, u, costs...)
+}
+
+// ================================
+// PARETO-OPTIMAL PATHS
+// ================================
+
+// ParetoPath is a path that no other path beats on every criterion
+type ParetoPath struct {
+	Path  []int
+	Costs []float64
+}
+
+// dominates reports whether a is at least as good as b on every criterion.
+// Equal vectors count as dominated so only one path per cost vector is kept.
+func dominates(a, b []float64) bool {
+	for i := range a {
+		if a[i] > b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// pathLabel is a partial path: its cost vector and the label it extends
+type pathLabel struct {
+	vertex int
+	costs  []float64
+	parent *pathLabel
+}
+
+// labelHeap pops labels in lexicographic order of their cost vectors
+type labelHeap []*pathLabel
+
+func (h labelHeap) Len() int { return len(h) }
+func (h labelHeap) Less(i, j int) bool {
+	for c := range h[i].costs {
+		if h[i].costs[c] != h[j].costs[c] {
+			return h[i].costs[c] < h[j].costs[c]
+		}
+	}
+	return false
+}
+func (h labelHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h *labelHeap) Push(x any)   { *h = append(*h, x.(*pathLabel)) }
+func (h *labelHeap) Pop() any {
+	old := *h
+	last := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return last
+}
+
+// dominatedBy reports whether costs is dominated by any of the labels
+func dominatedBy(labels []*pathLabel, costs []float64) bool {
+	for _, label := range labels {
+		if dominates(label.costs, costs) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParetoShortestPaths returns every Pareto-optimal path from source to
+// target, sorted by the first criterion. It generalizes Dijkstra (Martins'
+// label-setting algorithm): a vertex keeps a set of non-dominated labels
+// instead of one distance, and labels are settled in lexicographic order,
+// so a settled label can never be dominated by one found later.
+// Time Complexity: exponential in the worst case (the Pareto set itself can
+// be that large); in practice O(L log L) for L labels created
+// Space Complexity: O(L)
+func (g *MultiCriteriaGraph) ParetoShortestPaths(source, target int) []ParetoPath {
+	settled := make([][]*pathLabel, g.vertices)
+	pq := &labelHeap{{vertex: source, costs: make([]float64, len(g.criteria))}}
+
+	for pq.Len() > 0 {
+		label := heap.Pop(pq).(*pathLabel)
+		u := label.vertex
+		// Labels that reach the target are settled first, so anything they
+		// dominate can be dropped wherever it is
+		if dominatedBy(settled[u], label.costs) || dominatedBy(settled[target], label.costs) {
+			continue
+		}
+		settled[u] = append(settled[u], label)
+		if u == target {
+			continue
+		}
+
+		for _, edge := range g.adjList[u] {
+			costs := make([]float64, len(label.costs))
+			for c := range costs {
+				costs[c] = label.costs[c] + edge.costs[c]
+			}
+			if !dominatedBy(settled[edge.to], costs) {
+				heap.Push(pq, &pathLabel{vertex: edge.to, costs: costs, parent: label})
+			}
+		}
+	}
+
+	paths := make([]ParetoPath, 0, len(settled[target]))
+	for _, label := range settled[target] {
+		path := []int{}
+		for l := label; l != nil; l = l.parent {
+			path = append(path, l.vertex)
+		}
+		for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+			path[i], path[j] = path[j], path[i]
+		}
+		paths = append(paths, ParetoPath{Path: path, Costs: label.costs})
+	}
+	sort.Slice(paths, func(i, j int) bool { return paths[i].Costs[0] < paths[j].Costs[0] })
+	return paths
+}
+
+// ================================
+// PRACTICAL APPLICATION: FLIGHT PLANNER
+// ================================
+
+// FlightOption is one Pareto-optimal itinerary
+type FlightOption struct {
+	Route []string
+	Price float64
+	Hours float64
+}
+
+// FlightPlanner finds itineraries that trade off price against duration
+type FlightPlanner struct {
+	airports *NamedGraph[string] // airport codes to ids; the flights live in flights
+	flights  *MultiCriteriaGraph
+}
+
+// NewFlightPlanner creates a planner for the given airports
+func NewFlightPlanner(airports []string) *FlightPlanner {
+	names := NewNamedGraph(airports)
+	return &FlightPlanner{
+		airports: names,
+		flights:  NewMultiCriteriaGraph(names.Len(), "price", "hours"),
+	}
+}
+
+// AddFlight adds a flight in both directions
+func (fp *FlightPlanner) AddFlight(from, to string, price, hours float64) error {
+	u, v, err := fp.airports.endpoints(from, to)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrUnknownLocation, err)
+	}
+	return fp.flights.AddUndirectedEdge(u, v, price, hours)
+}
+
+// Options returns every itinerary not beaten on both price and duration,
+// cheapest first (and therefore slowest first). The error wraps
+// ErrUnknownLocation or ErrNoRoute.
+func (fp *FlightPlanner) Options(from, to string) ([]FlightOption, error) {
+	u, v, err := fp.airports.endpoints(from, to)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnknownLocation, err)
+	}
+
+	paths := fp.flights.ParetoShortestPaths(u, v)
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("%s to %s: %w", from, to, ErrNoRoute)
+	}
+	options := make([]FlightOption, len(paths))
+	for i, path := range paths {
+		options[i] = FlightOption{Route: fp.airports.keysOf(path.Path), Price: path.Costs[0], Hours: path.Costs[1]}
+	}
+	return options, nil
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoParetoShortestPath demonstrates multi-criteria shortest paths
+func DemoParetoShortestPath() {
+	fmt.Println("=== MULTI-CRITERIA (PARETO) SHORTEST PATHS ===")
+	fmt.Println()
+
+	// Example 1: Three criteria
+	fmt.Println("=== EXAMPLE 1: Distance, Toll and Time ===")
+	roads := NewMultiCriteriaGraph(5, "km", "toll", "minutes")
+	roads.AddEdge(0, 1, 50, 0, 60)   // country road
+	roads.AddEdge(0, 2, 40, 5, 25)   // motorway
+	roads.AddEdge(1, 4, 40, 0, 50)   // country road
+	roads.AddEdge(2, 4, 55, 8, 30)   // motorway
+	roads.AddEdge(2, 3, 20, 0, 20)   // connector
+	roads.AddEdge(3, 4, 30, 0, 45)   // town streets
+	roads.AddEdge(0, 4, 120, 0, 115) // scenic route, dominated by 0 -> 1 -> 4
+	if err := roads.AddEdge(1, 3, 10); err != nil {
+		fmt.Printf("Rejected edge: %v\n", err)
+	}
+	for _, option := range roads.ParetoShortestPaths(0, 4) {
+		fmt.Printf("  %v: %v km, %v toll, %v min\n", option.Path, option.Costs[0], option.Costs[1], option.Costs[2])
+	}
+	fmt.Println("Dijkstra on any single criterion would show only one of these.")
+	fmt.Println()
+
+	// Example 2: Price against flight time
+	fmt.Println("=== EXAMPLE 2: Flights, Price vs Duration ===")
+	planner := NewFlightPlanner([]string{"BOS", "SEA", "MSP", "PHX", "SLC", "HNL"})
+	for _, flight := range []struct {
+		from, to     string
+		price, hours float64
+	}{
+		{"BOS", "SEA", 560, 6.0}, // nonstop
+		{"BOS", "MSP", 190, 3.0},
+		{"BOS", "PHX", 150, 5.5},
+		{"MSP", "SEA", 230, 3.5},
+		{"MSP", "SLC", 120, 3.0},
+		{"PHX", "SLC", 80, 1.5},
+		{"SLC", "SEA", 100, 2.0},
+		{"PHX", "SEA", 210, 3.0},
+	} {
+		planner.AddFlight(flight.from, flight.to, flight.price, flight.hours)
+	}
+	options, err := planner.Options("BOS", "SEA")
+	if err != nil {
+		fmt.Println("Error:", err)
+		return
+	}
+	for _, option := range options {
+		fmt.Printf("  $%.0f, %.1f h: %s\n", option.Price, option.Hours, strings.Join(option.Route, " -> "))
+	}
+	if err := planner.AddFlight("SEA", "SFO", 90, 2.0); err != nil {
+		fmt.Printf("AddFlight SEA -> SFO: %v\n", err)
+	}
+	if _, err := planner.Options("BOS", "HNL"); err != nil {
+		fmt.Printf("Options BOS -> HNL: %v\n", err)
+	}
+	fmt.Println()
+
+	fmt.Println("Each option is cheaper or faster than every other one; picking between")
+	fmt.Println("them is a preference, not an optimization, so all of them are returned.")
+	fmt.Println()
+}
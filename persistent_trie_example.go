@@ -0,0 +1,223 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ================================
+// PERSISTENT (IMMUTABLE) TRIE
+// ================================
+
+// persistentNode is never modified after it becomes reachable from a version
+type persistentNode struct {
+	children map[rune]*persistentNode
+	isEnd    bool
+}
+
+// PersistentTrie is an immutable Trie. Insert and Delete return a new version
+// and leave the receiver untouched; the new version copies only the nodes on
+// the changed word's path and shares every other node with the old one.
+type PersistentTrie struct {
+	root *persistentNode
+	size int
+}
+
+// NewPersistentTrie returns the empty version
+func NewPersistentTrie() *PersistentTrie {
+	return &PersistentTrie{root: &persistentNode{children: map[rune]*persistentNode{}}}
+}
+
+// clone copies a node's fields; its children are shared, not copied
+func (n *persistentNode) clone() *persistentNode {
+	children := make(map[rune]*persistentNode, len(n.children))
+	for char, child := range n.children {
+		children[char] = child
+	}
+	return &persistentNode{children: children, isEnd: n.isEnd}
+}
+
+// Insert returns a version that also contains word
+// Time Complexity: O(m * k) for word length m and k children per copied node
+// Space Complexity: O(m) new nodes
+func (pt *PersistentTrie) Insert(word string) *PersistentTrie {
+	if pt.Contains(word) {
+		return pt
+	}
+
+	newRoot := pt.root.clone()
+	current := newRoot
+	for _, char := range word {
+		var next *persistentNode
+		if child := current.children[char]; child != nil {
+			next = child.clone()
+		} else {
+			next = &persistentNode{children: map[rune]*persistentNode{}}
+		}
+		current.children[char] = next
+		current = next
+	}
+	current.isEnd = true
+
+	return &PersistentTrie{root: newRoot, size: pt.size + 1}
+}
+
+// Delete returns a version without word, pruning nodes left without words
+// Time Complexity: O(m * k)
+func (pt *PersistentTrie) Delete(word string) *PersistentTrie {
+	if !pt.Contains(word) {
+		return pt
+	}
+
+	var remove func(node *persistentNode, chars []rune) *persistentNode
+	remove = func(node *persistentNode, chars []rune) *persistentNode {
+		copied := node.clone()
+		if len(chars) == 0 {
+			copied.isEnd = false
+		} else if child := remove(node.children[chars[0]], chars[1:]); child == nil {
+			delete(copied.children, chars[0])
+		} else {
+			copied.children[chars[0]] = child
+		}
+
+		if !copied.isEnd && len(copied.children) == 0 {
+			return nil
+		}
+		return copied
+	}
+
+	newRoot := remove(pt.root, []rune(word))
+	if newRoot == nil {
+		newRoot = &persistentNode{children: map[rune]*persistentNode{}}
+	}
+	return &PersistentTrie{root: newRoot, size: pt.size - 1}
+}
+
+// Contains reports whether word is in this version
+// Time Complexity: O(m)
+func (pt *PersistentTrie) Contains(word string) bool {
+	node := pt.find(word)
+	return node != nil && node.isEnd
+}
+
+// find returns the node reached by following prefix, or nil
+func (pt *PersistentTrie) find(prefix string) *persistentNode {
+	node := pt.root
+	for _, char := range prefix {
+		node = node.children[char]
+		if node == nil {
+			return nil
+		}
+	}
+	return node
+}
+
+// WordsWithPrefix returns the words of this version starting with prefix, sorted
+func (pt *PersistentTrie) WordsWithPrefix(prefix string) []string {
+	words := []string{}
+	var collect func(node *persistentNode, current string)
+	collect = func(node *persistentNode, current string) {
+		if node.isEnd {
+			words = append(words, current)
+		}
+		for _, char := range sortedKeys(node.children) {
+			collect(node.children[char], current+string(char))
+		}
+	}
+
+	if node := pt.find(prefix); node != nil {
+		collect(node, prefix)
+	}
+	return words
+}
+
+// Size returns the number of words in this version
+func (pt *PersistentTrie) Size() int {
+	return pt.size
+}
+
+// sharedNodes counts the nodes of pt that are physically shared with other
+func (pt *PersistentTrie) sharedNodes(other *PersistentTrie) (shared, total int) {
+	inOther := make(map[*persistentNode]bool)
+	var mark func(node *persistentNode)
+	mark = func(node *persistentNode) {
+		inOther[node] = true
+		for _, child := range node.children {
+			mark(child)
+		}
+	}
+	mark(other.root)
+
+	var count func(node *persistentNode)
+	count = func(node *persistentNode) {
+		total++
+		if inOther[node] {
+			shared++
+		}
+		for _, child := range node.children {
+			count(child)
+		}
+	}
+	count(pt.root)
+	return shared, total
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoPersistentTrie shows user dictionaries layered over a shared base dictionary
+func DemoPersistentTrie() {
+	fmt.Println("=== PERSISTENT TRIE ===")
+	fmt.Println()
+
+	fmt.Println("Every update returns a new version; old versions stay valid and")
+	fmt.Println("unchanged subtrees are shared instead of copied.")
+	fmt.Println()
+
+	// Example 1: Versions
+	fmt.Println("=== EXAMPLE 1: Versions ===")
+	v0 := NewPersistentTrie()
+	v1 := v0.Insert("car").Insert("cart")
+	v2 := v1.Insert("care")
+	v3 := v2.Delete("car")
+	for i, version := range []*PersistentTrie{v0, v1, v2, v3} {
+		fmt.Printf("v%d: %v\n", i, version.WordsWithPrefix(""))
+	}
+	fmt.Println()
+
+	// Example 2: Spell-checker overlays
+	fmt.Println("=== EXAMPLE 2: Spell-Checker Dictionary Overlays ===")
+	base := NewPersistentTrie()
+	for _, word := range strings.Fields("the quick brown fox jumps over lazy dog program programming language compiler") {
+		base = base.Insert(word)
+	}
+
+	alice := base.Insert("golang").Insert("goroutine")
+	bob := base.Insert("kubernetes").Delete("lazy") // Bob never wants "lazy" suggested
+
+	users := []struct {
+		name string
+		dict *PersistentTrie
+	}{
+		{"Base", base},
+		{"Alice", alice},
+		{"Bob", bob},
+	}
+	for _, user := range users {
+		var misspelled []string
+		for _, word := range strings.Fields("the lazy goroutine uses kubernetes") {
+			if !user.dict.Contains(word) {
+				misspelled = append(misspelled, word)
+			}
+		}
+		fmt.Printf("%-5s (%2d words) flags: %v\n", user.name, user.dict.Size(), misspelled)
+	}
+
+	shared, total := alice.sharedNodes(base)
+	fmt.Printf("\nAlice's dictionary has %d nodes, %d of them shared with the base\n", total, shared)
+	shared, total = bob.sharedNodes(base)
+	fmt.Printf("Bob's dictionary has %d nodes, %d of them shared with the base\n", total, shared)
+	fmt.Println("Snapshots cost O(word length) nodes, not a copy of the whole dictionary.")
+	fmt.Println()
+}
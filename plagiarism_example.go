@@ -0,0 +1,259 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// ================================
+// SHARED PASSAGES (SUFFIX ARRAY)
+// ================================
+
+// SharedPassage is a stretch of text found in both documents
+type SharedPassage struct {
+	PositionA int
+	PositionB int
+	Text      string
+}
+
+// SharedPassages returns the maximal passages of a, at least minLength
+// bytes long, that also occur in b, in order of position in a. A passage
+// is maximal when it cannot be extended either way and still occur in b;
+// passages may overlap.
+//
+// It builds one suffix array over a + "\x00" + b. For a suffix of a, the
+// longest prefix it shares with any suffix of b is the LCP with the nearest
+// suffix of b above or below it in sorted order, and the LCP between two
+// entries is the minimum of the lcp values between them. One sweep in each
+// direction therefore gives every position of a its longest match in b.
+// Time Complexity: O(n log n) for n = len(a) + len(b)
+// Space Complexity: O(n)
+func SharedPassages(a, b string, minLength int) ([]SharedPassage, error) {
+	if strings.ContainsRune(a, 0) || strings.ContainsRune(b, 0) {
+		return nil, fmt.Errorf("documents must not contain NUL bytes")
+	}
+	if minLength < 1 {
+		return nil, fmt.Errorf("minimum passage length must be at least 1, got %d", minLength)
+	}
+	index := NewSuffixArray(a + "\x00" + b)
+	fromB := func(suffix int) bool { return suffix > len(a) }
+
+	// match[i] is the longest prefix of a[i:] occurring in b, and where
+	matchLength, matchAt := make([]int, len(a)), make([]int, len(a))
+	sweep := func(ranks []int, lcpBetween func(rank int) int) {
+		shared, nearest := 0, -1 // LCP with the nearest suffix of b seen so far
+		for _, rank := range ranks {
+			suffix := index.sa[rank]
+			if nearest >= 0 {
+				shared = min(shared, lcpBetween(rank))
+			}
+			switch {
+			case fromB(suffix):
+				shared, nearest = math.MaxInt, suffix-len(a)-1
+			case suffix < len(a) && nearest >= 0 && shared > matchLength[suffix]:
+				matchLength[suffix], matchAt[suffix] = shared, nearest
+			}
+		}
+	}
+	forward, backward := make([]int, len(index.sa)), make([]int, len(index.sa))
+	for i := range forward {
+		forward[i], backward[i] = i, len(index.sa)-1-i
+	}
+	sweep(forward, func(rank int) int { return index.lcp[rank] })
+	sweep(backward, func(rank int) int { return index.lcp[rank+1] })
+
+	// The match at i is the tail of the match at i-1 unless it is at least
+	// as long, so only those starts begin a maximal passage
+	passages := []SharedPassage{}
+	for i := range a {
+		if matchLength[i] >= minLength && (i == 0 || matchLength[i-1] <= matchLength[i]) {
+			passages = append(passages, SharedPassage{i, matchAt[i], a[i : i+matchLength[i]]})
+		}
+	}
+	return passages, nil
+}
+
+// ================================
+// WINNOWING FINGERPRINTS
+// ================================
+
+// normalizeForFingerprint keeps only lowercase letters and digits, so
+// reformatting, punctuation and case changes do not hide copied text
+func normalizeForFingerprint(text string) string {
+	var normalized strings.Builder
+	for _, char := range strings.ToLower(text) {
+		if unicode.IsLetter(char) || unicode.IsDigit(char) {
+			normalized.WriteRune(char)
+		}
+	}
+	return normalized.String()
+}
+
+// WinnowFingerprints hashes every k-gram of the normalized text with a
+// Rabin-Karp rolling hash and keeps the minimum hash of each window of w
+// consecutive k-grams (winnowing). Any passage of at least w+k-1
+// normalized characters shared by two documents is guaranteed to produce a
+// shared fingerprint, while only about 2/(w+1) of the hashes are kept.
+// The window minimum is tracked with a monotonic deque.
+// Time Complexity: O(n)
+func WinnowFingerprints(text string, k, w int) (map[uint64]bool, error) {
+	if k < 1 || w < 1 {
+		return nil, fmt.Errorf("k-gram size %d and window %d must both be at least 1", k, w)
+	}
+	normalized := normalizeForFingerprint(text)
+	fingerprints := map[uint64]bool{}
+	if len(normalized) < k {
+		return fingerprints, nil
+	}
+
+	// Arithmetic wraps modulo 2^64; highPower removes the leaving byte
+	const base = 1_000_003
+	hashes := make([]uint64, 0, len(normalized)-k+1)
+	var hash, highPower uint64 = 0, 1
+	for i := 0; i < k-1; i++ {
+		highPower *= base
+	}
+	for i := 0; i < len(normalized); i++ {
+		if i >= k {
+			hash -= uint64(normalized[i-k]) * highPower
+		}
+		hash = hash*base + uint64(normalized[i])
+		if i >= k-1 {
+			hashes = append(hashes, hash)
+		}
+	}
+
+	// Indices of increasing hashes; the front is the window minimum, and
+	// the rightmost of equal minimums wins, as winnowing prescribes
+	window := Deque[int]{}
+	for i, h := range hashes {
+		for {
+			back, ok := window.Back()
+			if !ok || hashes[back] < h {
+				break
+			}
+			window.PopBack()
+		}
+		window.PushBack(i)
+		if front, _ := window.Front(); front <= i-w {
+			window.PopFront()
+		}
+		if i >= w-1 || i == len(hashes)-1 {
+			front, _ := window.Front()
+			fingerprints[hashes[front]] = true
+		}
+	}
+	return fingerprints, nil
+}
+
+// FingerprintSimilarity returns the Jaccard similarity of the two
+// documents' winnowed fingerprints: shared fingerprints over all distinct
+// fingerprints, from 0 (nothing in common) to 1
+func FingerprintSimilarity(a, b string, k, w int) (float64, error) {
+	printsA, err := WinnowFingerprints(a, k, w)
+	if err != nil {
+		return 0, err
+	}
+	printsB, _ := WinnowFingerprints(b, k, w)
+	if len(printsA)+len(printsB) == 0 {
+		return 0, nil
+	}
+	shared := 0
+	for print := range printsA {
+		if printsB[print] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(printsA)+len(printsB)-shared), nil
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoPlagiarismDetection compares essays for copied passages
+func DemoPlagiarismDetection() {
+	fmt.Println("=== PLAGIARISM DETECTION ===")
+	fmt.Println()
+
+	essays := map[string]string{
+		"original": "Dijkstra's algorithm finds the shortest path from a source vertex to all " +
+			"other vertices in a weighted graph with non-negative edge weights. It uses a greedy " +
+			"approach with a priority queue, always settling the closest unsettled vertex next.",
+		"copied": "As we know, Dijkstra's algorithm finds the shortest path from a source vertex " +
+			"to every other vertex in a weighted graph with non-negative edge weights! It uses a " +
+			"GREEDY approach with a priority-queue, always settling the closest unsettled vertex.",
+		"independent": "Breadth-first search explores a graph level by level from the start " +
+			"vertex, which makes it the natural choice for shortest paths when every edge costs " +
+			"the same and a simple queue replaces the priority queue.",
+	}
+	names := sortedKeys(essays)
+
+	// Example 1: Shared passages
+	fmt.Println("=== EXAMPLE 1: Passages of 'copied' Found in 'original' (20+ bytes) ===")
+	passages, _ := SharedPassages(essays["copied"], essays["original"], 20)
+	copied := make([]bool, len(essays["copied"]))
+	for _, passage := range passages {
+		fmt.Printf("  at %3d (original %3d): %q\n", passage.PositionA, passage.PositionB, passage.Text)
+		for i := range passage.Text {
+			copied[passage.PositionA+i] = true
+		}
+	}
+	copiedBytes := 0
+	for _, isCopied := range copied {
+		if isCopied {
+			copiedBytes++
+		}
+	}
+	fmt.Printf("%.0f%% of 'copied' appears verbatim in 'original'\n", 100*float64(copiedBytes)/float64(len(essays["copied"])))
+	fmt.Println()
+
+	// Example 2: Longest common substring, two ways
+	fmt.Println("=== EXAMPLE 2: Longest Common Substring ===")
+	for i, first := range names {
+		for _, second := range names[i+1:] {
+			longest := ""
+			passages, _ := SharedPassages(essays[first], essays[second], 1)
+			for _, passage := range passages {
+				if len(passage.Text) > len(longest) {
+					longest = passage.Text
+				}
+			}
+			fromTree, _ := LongestCommonSubstring(essays[first], essays[second])
+			fmt.Printf("  %-11s / %-11s %3d bytes (suffix tree agrees: %v) %q\n",
+				first, second, len(longest), len(longest) == len(fromTree), longest)
+		}
+	}
+	fmt.Println()
+
+	// Example 3: Similarity scores
+	const k, w = 8, 4
+	fmt.Printf("=== EXAMPLE 3: Winnowing Similarity (k = %d, window = %d) ===\n", k, w)
+	for i, first := range names {
+		for _, second := range names[i+1:] {
+			score, _ := FingerprintSimilarity(essays[first], essays[second], k, w)
+			verdict := "independent"
+			if score >= 0.5 {
+				verdict = "likely copied"
+			} else if score >= 0.2 {
+				verdict = "review"
+			}
+			fmt.Printf("  %-11s / %-11s similarity %.2f  %s\n", first, second, score, verdict)
+		}
+	}
+	prints, _ := WinnowFingerprints(essays["original"], k, w)
+	fmt.Printf("'original' keeps %d fingerprints for %d normalized characters\n",
+		len(prints), len(normalizeForFingerprint(essays["original"])))
+	if _, err := FingerprintSimilarity("a", "b", 0, w); err != nil {
+		fmt.Printf("k = 0: %v\n", err)
+	}
+	fmt.Println()
+
+	fmt.Println("Shared passages point at exact copied text, but one changed word splits a")
+	fmt.Println("passage in two. Fingerprints ignore case and punctuation and survive small")
+	fmt.Println("edits, and comparing fingerprint sets scales to many documents: each one")
+	fmt.Println("is hashed once, and only the kept hashes are compared.")
+	fmt.Println()
+}
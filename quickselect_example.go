@@ -271,11 +271,13 @@ func quickSelectPartial(arr []int, left, right, k int) {
 // ================================
 
 func DemoQuickSelect() {
-	fmt.Println("=== QUICKSELECT ALGORITHM EXPLANATION ===\n")
+	fmt.Println("=== QUICKSELECT ALGORITHM EXPLANATION ===")
+	fmt.Println()
 
 	fmt.Println("QuickSelect is a selection algorithm to find the k-th smallest element")
 	fmt.Println("in an unordered list. It's related to QuickSort but only recurses into")
-	fmt.Println("one partition, making it more efficient for selection problems.\n")
+	fmt.Println("one partition, making it more efficient for selection problems.")
+	fmt.Println()
 
 	// Example 1: Basic QuickSelect
 	fmt.Println("=== EXAMPLE 1: Basic QuickSelect ===")
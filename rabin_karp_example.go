@@ -0,0 +1,292 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// ================================
+// RABIN-KARP ALGORITHM
+// ================================
+
+// The hash of s is s[0]*base^(m-1) + ... + s[m-1] modulo a prime, so
+// sliding the window one byte removes s[0]*base^(m-1) and appends one byte
+const (
+	rabinKarpBase    = 256
+	rabinKarpModulus = 1_000_000_007
+)
+
+// RabinKarpMatcher represents a Rabin-Karp pattern matcher
+type RabinKarpMatcher struct {
+	pattern     string
+	modulus     uint64
+	patternHash uint64
+	highPower   uint64 // base^(m-1) mod modulus: weight of the leaving byte
+}
+
+// NewRabinKarpMatcher creates a new Rabin-Karp matcher for the given pattern
+func NewRabinKarpMatcher(pattern string) *RabinKarpMatcher {
+	return newRabinKarpMatcher(pattern, rabinKarpModulus)
+}
+
+// newRabinKarpMatcher uses a custom modulus; a small one makes spurious
+// hash hits easy to see
+func newRabinKarpMatcher(pattern string, modulus uint64) *RabinKarpMatcher {
+	return &RabinKarpMatcher{
+		pattern:     pattern,
+		modulus:     modulus,
+		patternHash: polynomialHash(pattern, modulus),
+		highPower:   rabinKarpHighPower(len(pattern), modulus),
+	}
+}
+
+// polynomialHash hashes s with the Rabin-Karp polynomial
+func polynomialHash(s string, modulus uint64) uint64 {
+	var hash uint64
+	for i := 0; i < len(s); i++ {
+		hash = (hash*rabinKarpBase + uint64(s[i])) % modulus
+	}
+	return hash
+}
+
+// rabinKarpHighPower returns base^(m-1) mod modulus
+func rabinKarpHighPower(m int, modulus uint64) uint64 {
+	power := uint64(1)
+	for i := 1; i < m; i++ {
+		power = power * rabinKarpBase % modulus
+	}
+	return power
+}
+
+// rollHash slides a window hash one byte to the right
+// Time Complexity: O(1)
+func rollHash(hash uint64, leaving, entering byte, highPower, modulus uint64) uint64 {
+	hash = (hash + modulus - uint64(leaving)*highPower%modulus) % modulus
+	return (hash*rabinKarpBase + uint64(entering)) % modulus
+}
+
+// Search finds all occurrences of pattern in text using Rabin-Karp.
+// Windows whose hash equals the pattern's are compared byte by byte, so a
+// hash collision (a spurious hit) costs time but never a wrong match.
+// Time Complexity: O(n + m) expected, O(nm) if every window collides
+func (rk *RabinKarpMatcher) Search(text string) []int {
+	m := len(rk.pattern)
+	if m == 0 || m > len(text) {
+		return []int{}
+	}
+
+	matches := []int{}
+	fmt.Printf("Rabin-Karp search for pattern '%s' (hash %d) in text '%s':\n", rk.pattern, rk.patternHash, text)
+
+	hash := polynomialHash(text[:m], rk.modulus)
+	for i := 0; ; i++ {
+		fmt.Printf("Window text[%d:%d]='%s' hash=%d: ", i, i+m, text[i:i+m], hash)
+		if hash != rk.patternHash {
+			fmt.Printf("hash differs, skip\n")
+		} else if text[i:i+m] == rk.pattern {
+			fmt.Printf("*** PATTERN FOUND at index %d ***\n", i)
+			matches = append(matches, i)
+		} else {
+			fmt.Printf("hash matches but text differs (spurious hit)\n")
+		}
+
+		if i+m == len(text) {
+			break
+		}
+		hash = rollHash(hash, text[i], text[i+m], rk.highPower, rk.modulus)
+	}
+
+	return matches
+}
+
+// ================================
+// ALTERNATIVE IMPLEMENTATIONS
+// ================================
+
+// RabinKarpSearchSimple is a simpler version without detailed tracing
+func RabinKarpSearchSimple(text, pattern string) []int {
+	matches, _ := rabinKarpSearch(text, pattern, rabinKarpModulus)
+	return matches
+}
+
+// rabinKarpSearch also reports how many hash hits turned out to be
+// collisions
+func rabinKarpSearch(text, pattern string, modulus uint64) (matches []int, spurious int) {
+	matches = []int{}
+	m := len(pattern)
+	if m == 0 || m > len(text) {
+		return matches, 0
+	}
+
+	target := polynomialHash(pattern, modulus)
+	highPower := rabinKarpHighPower(m, modulus)
+	hash := polynomialHash(text[:m], modulus)
+	for i := 0; ; i++ {
+		if hash == target {
+			if text[i:i+m] == pattern {
+				matches = append(matches, i)
+			} else {
+				spurious++
+			}
+		}
+		if i+m == len(text) {
+			break
+		}
+		hash = rollHash(hash, text[i], text[i+m], highPower, modulus)
+	}
+
+	return matches, spurious
+}
+
+// ================================
+// MULTI-PATTERN SEARCH
+// ================================
+
+// MultiRabinKarp searches for many patterns at once. Patterns are grouped
+// by length and each group is looked up in a hash set, so the text is
+// scanned once per distinct length instead of once per pattern.
+type MultiRabinKarp struct {
+	patterns []string
+	byLength map[int]map[uint64][]string // length -> window hash -> patterns
+}
+
+// NewMultiRabinKarp creates a multi-pattern Rabin-Karp searcher
+func NewMultiRabinKarp(patterns []string) *MultiRabinKarp {
+	mrk := &MultiRabinKarp{
+		patterns: patterns,
+		byLength: make(map[int]map[uint64][]string),
+	}
+
+	seen := make(map[string]bool)
+	for _, pattern := range patterns {
+		if pattern == "" || seen[pattern] {
+			continue
+		}
+		seen[pattern] = true
+		group := mrk.byLength[len(pattern)]
+		if group == nil {
+			group = make(map[uint64][]string)
+			mrk.byLength[len(pattern)] = group
+		}
+		hash := polynomialHash(pattern, rabinKarpModulus)
+		group[hash] = append(group[hash], pattern)
+	}
+
+	return mrk
+}
+
+// SearchAll returns the positions of every pattern in text
+// Time Complexity: O(L*n + z*m) expected, for L distinct pattern lengths
+// and z hash hits of length m
+func (mrk *MultiRabinKarp) SearchAll(text string) map[string][]int {
+	results := make(map[string][]int)
+	for _, pattern := range mrk.patterns {
+		results[pattern] = []int{}
+	}
+
+	for _, m := range sortedKeys(mrk.byLength) {
+		if m > len(text) {
+			continue
+		}
+		group := mrk.byLength[m]
+		highPower := rabinKarpHighPower(m, rabinKarpModulus)
+		hash := polynomialHash(text[:m], rabinKarpModulus)
+		for i := 0; ; i++ {
+			for _, pattern := range group[hash] {
+				if text[i:i+m] == pattern {
+					results[pattern] = append(results[pattern], i)
+				}
+			}
+			if i+m == len(text) {
+				break
+			}
+			hash = rollHash(hash, text[i], text[i+m], highPower, rabinKarpModulus)
+		}
+	}
+
+	return results
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoRabinKarp demonstrates rolling-hash search, spurious hits and
+// multi-pattern search, and compares Rabin-Karp with KMP and naive search
+func DemoRabinKarp() {
+	fmt.Println("=== RABIN-KARP STRING SEARCH ===")
+	fmt.Println()
+
+	fmt.Println("Rabin-Karp compares hashes instead of characters:")
+	fmt.Println("1. Hash the pattern and the first window of the text")
+	fmt.Println("2. Slide the window, updating its hash in O(1) (rolling hash)")
+	fmt.Println("3. Only when the hashes agree, compare the window with the pattern")
+	fmt.Println()
+
+	// Example 1: Rolling hash in action
+	fmt.Println("=== EXAMPLE 1: Rolling Hash Search ===")
+	matcher := NewRabinKarpMatcher("ABA")
+	matches := matcher.Search("ABABCABA")
+	fmt.Printf("Matches found at indices: %v\n\n", matches)
+
+	// Example 2: A tiny modulus makes collisions visible
+	fmt.Println("=== EXAMPLE 2: Spurious Hits with Modulus 13 ===")
+	small := newRabinKarpMatcher("CAB", 13)
+	smallMatches := small.Search("ABCABDDCAB")
+	fmt.Printf("Matches found at indices: %v\n", smallMatches)
+	longText := strings.Repeat("ABRACADABRA", 2_000)
+	for _, modulus := range []uint64{13, 101, 10_007, rabinKarpModulus} {
+		found, spurious := rabinKarpSearch(longText, "CADABRA", modulus)
+		fmt.Printf("Modulus %-10d %d matches, %d spurious hits in %d windows\n",
+			modulus, len(found), spurious, len(longText)-len("CADABRA")+1)
+	}
+	fmt.Println()
+
+	// Example 3: Multi-pattern search over a DNA sequence
+	fmt.Println("=== EXAMPLE 3: Multi-Pattern Search ===")
+	rng := rand.New(rand.NewSource(1051))
+	dna := make([]byte, 200_000)
+	for i := range dna {
+		dna[i] = "ACGT"[rng.Intn(4)]
+	}
+	codons := []string{"ATG", "TAA", "TAG", "TGA", "GGC", "GCC", "CGT", "AGA", "TTTT", "GATC", "GAATTC"}
+	fmt.Printf("%d patterns of %d distinct lengths in %d bases\n", len(codons), len(NewMultiRabinKarp(codons).byLength), len(dna))
+	start := time.Now()
+	multiResults := NewMultiRabinKarp(codons).SearchAll(string(dna))
+	multiTime := time.Since(start)
+	start = time.Now()
+	separate := make(map[string][]int)
+	for _, codon := range codons {
+		separate[codon] = KMPSearchSimple(string(dna), codon)
+	}
+	kmpTime := time.Since(start)
+	agree := true
+	for _, codon := range codons {
+		agree = agree && equalSlices(multiResults[codon], separate[codon])
+	}
+	for _, codon := range codons[:4] {
+		fmt.Printf("'%s' found %d times\n", codon, len(multiResults[codon]))
+	}
+	fmt.Printf("MultiRabinKarp (%d passes): %v\n", len(NewMultiRabinKarp(codons).byLength), multiTime.Round(time.Microsecond))
+	fmt.Printf("KMP per pattern (%d passes): %v\n", len(codons), kmpTime.Round(time.Microsecond))
+	fmt.Printf("Same positions: %v\n\n", agree)
+
+	// Example 4: All three algorithms on the same inputs
+	fmt.Println("=== EXAMPLE 4: Naive vs KMP vs Rabin-Karp ===")
+	PerformanceTest("AABAACAADAABAABA", "AABA")
+	fmt.Println()
+	fmt.Println("Larger inputs (non-tracing versions):")
+	adversarial := strings.Repeat("A", 100_000) + "B"
+	timeStringSearches("random DNA, 12-mer", string(dna), string(dna[150_000:150_012]))
+	timeStringSearches("A...AB, pattern A^50B", adversarial, strings.Repeat("A", 50)+"B")
+	fmt.Println()
+
+	fmt.Println("Naive search re-reads up to m characters per window, KMP never moves")
+	fmt.Println("backwards in the text, and Rabin-Karp does O(1) arithmetic per window")
+	fmt.Println("plus a full comparison on each hash hit. Its strength is that one hash")
+	fmt.Println("lookup checks a window against any number of same-length patterns,")
+	fmt.Println("which is why plagiarism detection fingerprints text the same way.")
+	fmt.Println()
+}
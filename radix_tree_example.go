@@ -0,0 +1,339 @@
+package main
+
+import (
+	"embed"
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ================================
+// RADIX TREE (PATRICIA TRIE)
+// ================================
+
+// radixNode is a node of a RadixTree. The edge into it carries a whole
+// label instead of a single character.
+type radixNode struct {
+	label    string              // text on the edge from the parent
+	children map[rune]*radixNode // keyed by the first rune of each child's label
+	isEnd    bool                // a word ends here
+}
+
+func newRadixNode(label string) *radixNode {
+	return &radixNode{label: label, children: make(map[rune]*radixNode)}
+}
+
+// RadixTree is a Trie in which every chain of single-child, non-word nodes
+// is merged into one edge. It has the same Insert/Search/StartsWith API as
+// Trie but at most 2n nodes for n words, however long the words are.
+type RadixTree struct {
+	root *radixNode
+	size int
+}
+
+// NewRadixTree creates an empty radix tree
+func NewRadixTree() *RadixTree {
+	return &RadixTree{root: newRadixNode("")}
+}
+
+// firstRune returns the first rune of a non-empty string
+func firstRune(s string) rune {
+	r, _ := utf8.DecodeRuneInString(s)
+	return r
+}
+
+// commonPrefixLength returns the length in bytes of the longest common
+// prefix of a and b, never splitting a multi-byte rune
+func commonPrefixLength(a, b string) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	for n > 0 && n < len(a) && !utf8.RuneStart(a[n]) {
+		n--
+	}
+	return n
+}
+
+// Insert adds a word. An edge that diverges from the word part-way is split
+// in two, with the shared part becoming a new inner node.
+// Time Complexity: O(L) for a word of length L
+func (t *RadixTree) Insert(word string) {
+	node, rest := t.root, word
+	for rest != "" {
+		child := node.children[firstRune(rest)]
+		if child == nil {
+			leaf := newRadixNode(rest)
+			node.children[firstRune(rest)] = leaf
+			node, rest = leaf, ""
+			break
+		}
+
+		common := commonPrefixLength(rest, child.label)
+		if common < len(child.label) {
+			split := newRadixNode(child.label[:common])
+			child.label = child.label[common:]
+			split.children[firstRune(child.label)] = child
+			node.children[firstRune(split.label)] = split
+			child = split
+		}
+		node, rest = child, rest[common:]
+	}
+
+	if !node.isEnd {
+		node.isEnd = true
+		t.size++
+	}
+}
+
+// find walks down along prefix. It returns the node reached and how many
+// bytes of that node's label are still unmatched (0 when prefix ends
+// exactly at the node), or nil if the path leaves the tree.
+func (t *RadixTree) find(prefix string) (*radixNode, int) {
+	node, rest := t.root, prefix
+	for rest != "" {
+		child := node.children[firstRune(rest)]
+		if child == nil {
+			return nil, 0
+		}
+		common := commonPrefixLength(rest, child.label)
+		if common == len(rest) {
+			return child, len(child.label) - common
+		}
+		if common < len(child.label) {
+			return nil, 0
+		}
+		node, rest = child, rest[common:]
+	}
+	return node, 0
+}
+
+// Search reports whether word was inserted
+// Time Complexity: O(L)
+func (t *RadixTree) Search(word string) bool {
+	node, unmatched := t.find(word)
+	return node != nil && unmatched == 0 && node.isEnd
+}
+
+// StartsWith reports whether any word begins with prefix; the prefix may
+// end part-way along an edge
+// Time Complexity: O(L)
+func (t *RadixTree) StartsWith(prefix string) bool {
+	node, _ := t.find(prefix)
+	return node != nil
+}
+
+// WordsWithPrefix returns the words beginning with prefix, sorted
+func (t *RadixTree) WordsWithPrefix(prefix string) []string {
+	words := []string{}
+	node, unmatched := t.find(prefix)
+	if node == nil {
+		return words
+	}
+	// Complete the partly matched edge before collecting below it
+	start := prefix + node.label[len(node.label)-unmatched:]
+	node.collect(start, &words)
+	sort.Strings(words)
+	return words
+}
+
+func (n *radixNode) collect(word string, words *[]string) {
+	if n.isEnd {
+		*words = append(*words, word)
+	}
+	for _, child := range n.children {
+		child.collect(word+child.label, words)
+	}
+}
+
+// Delete removes word and re-merges any node left with a single child, so
+// the tree stays as compact as if the word had never been inserted.
+// Returns false if the word was not present.
+func (t *RadixTree) Delete(word string) bool {
+	if !t.root.delete(word) {
+		return false
+	}
+	t.size--
+	return true
+}
+
+// delete removes rest below n (rest excludes n's own label)
+func (n *radixNode) delete(rest string) bool {
+	if rest == "" {
+		if !n.isEnd {
+			return false
+		}
+		n.isEnd = false
+		return true
+	}
+	key := firstRune(rest)
+	child := n.children[key]
+	if child == nil || !strings.HasPrefix(rest, child.label) {
+		return false
+	}
+	if !child.delete(rest[len(child.label):]) {
+		return false
+	}
+
+	switch {
+	case child.isEnd:
+	case len(child.children) == 0:
+		delete(n.children, key)
+	case len(child.children) == 1:
+		// Absorb the only grandchild into the child's edge
+		for _, grandchild := range child.children {
+			grandchild.label = child.label + grandchild.label
+			n.children[key] = grandchild
+		}
+	}
+	return true
+}
+
+// Size returns the number of words
+func (t *RadixTree) Size() int {
+	return t.size
+}
+
+// NodeCount returns the number of nodes, including the root
+func (t *RadixTree) NodeCount() int {
+	var count func(n *radixNode) int
+	count = func(n *radixNode) int {
+		total := 1
+		for _, child := range n.children {
+			total += count(child)
+		}
+		return total
+	}
+	return count(t.root)
+}
+
+// PrintTree displays the tree with one edge label per line
+func (t *RadixTree) PrintTree() {
+	fmt.Printf("Radix tree with %d words, %d nodes:\n", t.size, t.NodeCount())
+	t.root.print("")
+}
+
+func (n *radixNode) print(indent string) {
+	keys := sortedKeys(n.children)
+	for i, key := range keys {
+		child := n.children[key]
+		branch, next := "├── ", "│   "
+		if i == len(keys)-1 {
+			branch, next = "└── ", "    "
+		}
+		marker := ""
+		if child.isEnd {
+			marker = " ✓"
+		}
+		fmt.Printf("%s%s%q%s\n", indent, branch, child.label, marker)
+		child.print(indent + next)
+	}
+}
+
+// trieNodeCount counts the nodes of a plain Trie, including the root
+func trieNodeCount(node *TrieNode) int {
+	total := 1
+	for _, child := range node.children {
+		total += trieNodeCount(child)
+	}
+	return total
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+//go:embed *.md
+var explanationDocs embed.FS
+
+// docWords returns the distinct lowercase words of the repository's
+// Markdown documents: a word list of real English prose
+func docWords() []string {
+	seen := map[string]bool{}
+	files, _ := explanationDocs.ReadDir(".")
+	for _, file := range files {
+		text, _ := explanationDocs.ReadFile(file.Name())
+		for _, word := range strings.FieldsFunc(strings.ToLower(string(text)), func(r rune) bool {
+			return !unicode.IsLetter(r)
+		}) {
+			if len(word) > 1 {
+				seen[word] = true
+			}
+		}
+	}
+	return sortedKeys(seen)
+}
+
+// heapInUse returns the bytes of live heap objects after a collection
+func heapInUse() uint64 {
+	var stats runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&stats)
+	return stats.HeapAlloc
+}
+
+// DemoRadixTree demonstrates the compressed trie against the plain Trie
+func DemoRadixTree() {
+	fmt.Println("=== RADIX TREE (COMPRESSED TRIE) ===")
+	fmt.Println()
+
+	// Example 1: Edge splitting
+	fmt.Println("=== EXAMPLE 1: Inserting Words ===")
+	tree := NewRadixTree()
+	for _, word := range []string{"romane", "romanus", "romulus", "rubens", "ruber", "rubicon", "rubicundus"} {
+		tree.Insert(word)
+	}
+	tree.PrintTree()
+	fmt.Printf("Search(ruber)=%v Search(rub)=%v StartsWith(rubi)=%v StartsWith(rup)=%v\n",
+		tree.Search("ruber"), tree.Search("rub"), tree.StartsWith("rubi"), tree.StartsWith("rup"))
+	fmt.Printf("WordsWithPrefix(rom): %v\n", tree.WordsWithPrefix("rom"))
+	fmt.Println()
+
+	// Example 2: Deletion re-merges edges
+	fmt.Println("=== EXAMPLE 2: Deleting Words ===")
+	tree.Delete("romulus")
+	tree.Delete("rubicon")
+	fmt.Println("After deleting romulus and rubicon:")
+	tree.PrintTree()
+	fmt.Printf("Delete(roman) = %v (never inserted)\n", tree.Delete("roman"))
+	fmt.Println()
+
+	// Example 3: Compared with the plain Trie
+	words := docWords()
+	fmt.Printf("=== EXAMPLE 3: %d Words from the Markdown Docs ===\n", len(words))
+	before := heapInUse()
+	trie := NewTrie()
+	for _, word := range words {
+		trie.InsertSimple(word)
+	}
+	trieBytes := heapInUse() - before
+
+	before = heapInUse()
+	radix := NewRadixTree()
+	for _, word := range words {
+		radix.Insert(word)
+	}
+	radixBytes := heapInUse() - before
+
+	disagreements := 0
+	for _, word := range words {
+		for _, probe := range []string{word, word + "s", word[:len(word)-1]} {
+			if trie.SearchSimple(probe) != radix.Search(probe) {
+				disagreements++
+			}
+		}
+	}
+	fmt.Printf("%-10s %8s %10s\n", "Structure", "Nodes", "Heap")
+	fmt.Printf("%-10s %8d %8d KB\n", "Trie", trieNodeCount(trie.root), trieBytes/1024)
+	fmt.Printf("%-10s %8d %8d KB\n", "Radix", radix.NodeCount(), radixBytes/1024)
+	fmt.Printf("Search disagreements over %d probes: %d\n", 3*len(words), disagreements)
+	runtime.KeepAlive(trie)
+	fmt.Println()
+
+	fmt.Println("Most trie nodes sit on single-child chains near the leaves; merging")
+	fmt.Println("them leaves one node per word plus one per branching point.")
+	fmt.Println()
+}
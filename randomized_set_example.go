@@ -0,0 +1,218 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// ================================
+// RANDOMIZED SET
+// ================================
+
+// RandomizedSet supports Insert, Remove and GetRandom in O(1) average time.
+// A map alone cannot pick a uniformly random key in O(1), and a slice alone
+// cannot find a value in O(1), so the set keeps both: values in a slice and
+// each value's slice index in a map. Remove moves the last element into the
+// hole, so the slice never has gaps.
+type RandomizedSet struct {
+	values []int
+	index  map[int]int // value -> position in values
+	rng    *rand.Rand
+}
+
+// NewRandomizedSet creates an empty set drawing from rng; pass a seeded
+// source for reproducible results
+func NewRandomizedSet(rng *rand.Rand) *RandomizedSet {
+	return &RandomizedSet{index: make(map[int]int), rng: rng}
+}
+
+// Insert adds val. Returns false if it was already present.
+// Time Complexity: O(1) average
+func (rs *RandomizedSet) Insert(val int) bool {
+	if _, exists := rs.index[val]; exists {
+		return false
+	}
+	rs.index[val] = len(rs.values)
+	rs.values = append(rs.values, val)
+	return true
+}
+
+// Remove deletes val. Returns false if it was not present.
+// Time Complexity: O(1) average
+func (rs *RandomizedSet) Remove(val int) bool {
+	pos, exists := rs.index[val]
+	if !exists {
+		return false
+	}
+	last := rs.values[len(rs.values)-1]
+	rs.values[pos] = last
+	rs.index[last] = pos
+	rs.values = rs.values[:len(rs.values)-1]
+	delete(rs.index, val)
+	return true
+}
+
+// GetRandom returns a uniformly random element
+// Time Complexity: O(1)
+func (rs *RandomizedSet) GetRandom() (int, error) {
+	if len(rs.values) == 0 {
+		return 0, fmt.Errorf("GetRandom on an empty set")
+	}
+	return rs.values[rs.rng.Intn(len(rs.values))], nil
+}
+
+// Len returns the number of elements
+func (rs *RandomizedSet) Len() int {
+	return len(rs.values)
+}
+
+// ================================
+// RANDOMIZED COLLECTION (DUPLICATES ALLOWED)
+// ================================
+
+// RandomizedCollection is a RandomizedSet that allows duplicates. Every copy
+// has its own slice slot, so GetRandom returns a value with probability
+// proportional to its count. The map lists the slots of each value, and
+// slotIndex records where each slot appears in that list so it can be
+// updated in O(1) when an element moves.
+type RandomizedCollection struct {
+	values    []int
+	slotIndex []int         // slotIndex[p] = index of p in positions[values[p]]
+	positions map[int][]int // value -> positions in values
+	rng       *rand.Rand
+}
+
+// NewRandomizedCollection creates an empty collection drawing from rng
+func NewRandomizedCollection(rng *rand.Rand) *RandomizedCollection {
+	return &RandomizedCollection{positions: make(map[int][]int), rng: rng}
+}
+
+// Insert adds one copy of val. Returns true if val was not present before.
+// Time Complexity: O(1) average
+func (rc *RandomizedCollection) Insert(val int) bool {
+	_, exists := rc.positions[val]
+	rc.slotIndex = append(rc.slotIndex, len(rc.positions[val]))
+	rc.positions[val] = append(rc.positions[val], len(rc.values))
+	rc.values = append(rc.values, val)
+	return !exists
+}
+
+// Remove deletes one copy of val (its most recently added slot). Returns
+// false if val was not present.
+// Time Complexity: O(1) average
+func (rc *RandomizedCollection) Remove(val int) bool {
+	slots, exists := rc.positions[val]
+	if !exists {
+		return false
+	}
+	pos := slots[len(slots)-1]
+	rc.positions[val] = slots[:len(slots)-1]
+
+	// Move the last element into the freed slot
+	lastPos := len(rc.values) - 1
+	if pos != lastPos {
+		last := rc.values[lastPos]
+		i := rc.slotIndex[lastPos]
+		rc.values[pos] = last
+		rc.positions[last][i] = pos
+		rc.slotIndex[pos] = i
+	}
+	rc.values = rc.values[:lastPos]
+	rc.slotIndex = rc.slotIndex[:lastPos]
+
+	if len(rc.positions[val]) == 0 {
+		delete(rc.positions, val)
+	}
+	return true
+}
+
+// GetRandom returns a random element, each copy equally likely
+// Time Complexity: O(1)
+func (rc *RandomizedCollection) GetRandom() (int, error) {
+	if len(rc.values) == 0 {
+		return 0, fmt.Errorf("GetRandom on an empty collection")
+	}
+	return rc.values[rc.rng.Intn(len(rc.values))], nil
+}
+
+// Count returns the number of copies of val
+func (rc *RandomizedCollection) Count(val int) int {
+	return len(rc.positions[val])
+}
+
+// Len returns the number of elements, counting duplicates
+func (rc *RandomizedCollection) Len() int {
+	return len(rc.values)
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// sampleFrequencies draws samples times from getRandom and counts each value
+func sampleFrequencies(getRandom func() (int, error), samples int) map[int]int {
+	counts := make(map[int]int)
+	for i := 0; i < samples; i++ {
+		val, _ := getRandom()
+		counts[val]++
+	}
+	return counts
+}
+
+// DemoRandomizedSet demonstrates O(1) insert, remove and random access
+func DemoRandomizedSet() {
+	fmt.Println("=== RANDOMIZED SET ===")
+	fmt.Println()
+
+	// Example 1: Set operations
+	fmt.Println("=== EXAMPLE 1: RandomizedSet ===")
+	set := NewRandomizedSet(rand.New(rand.NewSource(1024)))
+	for _, val := range []int{10, 20, 30, 40, 20} {
+		fmt.Printf("Insert(%d) = %v\n", val, set.Insert(val))
+	}
+	fmt.Printf("Remove(20) = %v, Remove(99) = %v\n", set.Remove(20), set.Remove(99))
+	fmt.Printf("Slice after remove: %v (40 moved into the hole left by 20)\n", set.values)
+
+	counts := sampleFrequencies(set.GetRandom, 30000)
+	fmt.Printf("30000 GetRandom calls: %v (about 10000 each)\n", counts)
+
+	empty := NewRandomizedSet(rand.New(rand.NewSource(1)))
+	if _, err := empty.GetRandom(); err != nil {
+		fmt.Printf("Empty set: %v\n", err)
+	}
+	fmt.Println()
+
+	// Example 2: Duplicates
+	fmt.Println("=== EXAMPLE 2: RandomizedCollection ===")
+	collection := NewRandomizedCollection(rand.New(rand.NewSource(1024)))
+	for _, val := range []int{1, 1, 1, 2, 3, 3} {
+		collection.Insert(val)
+	}
+	fmt.Printf("Inserted 1 1 1 2 3 3; Count(1) = %d, Count(3) = %d\n", collection.Count(1), collection.Count(3))
+	counts = sampleFrequencies(collection.GetRandom, 60000)
+	fmt.Printf("60000 GetRandom calls: %v (expected 1:30000 2:10000 3:20000)\n", counts)
+
+	collection.Remove(1)
+	collection.Remove(1)
+	collection.Remove(3)
+	counts = sampleFrequencies(collection.GetRandom, 30000)
+	fmt.Printf("After removing 1, 1, 3: %v (expected about 10000 each)\n", counts)
+	fmt.Println()
+
+	// Example 3: Reproducibility
+	fmt.Println("=== EXAMPLE 3: Injected Random Source ===")
+	for run := 1; run <= 2; run++ {
+		seeded := NewRandomizedSet(rand.New(rand.NewSource(7)))
+		for val := 1; val <= 10; val++ {
+			seeded.Insert(val)
+		}
+		draws := []int{}
+		for i := 0; i < 8; i++ {
+			val, _ := seeded.GetRandom()
+			draws = append(draws, val)
+		}
+		fmt.Printf("Run %d with seed 7: %v\n", run, draws)
+	}
+	fmt.Println("The same seed gives the same draws, so randomized behaviour is testable.")
+	fmt.Println()
+}
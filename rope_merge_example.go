@@ -0,0 +1,120 @@
+package main
+
+import (
+	"container/heap"
+	"fmt"
+	"sort"
+)
+
+// ================================
+// MINIMUM COST TO CONNECT ROPES
+// ================================
+
+// intMinHeap is a min-heap of ints
+type intMinHeap []int
+
+func (h intMinHeap) Len() int           { return len(h) }
+func (h intMinHeap) Less(i, j int) bool { return h[i] < h[j] }
+func (h intMinHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *intMinHeap) Push(x any) { *h = append(*h, x.(int)) }
+
+func (h *intMinHeap) Pop() any {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[0 : n-1]
+	return item
+}
+
+// RopeMerge is one step of connecting ropes: two ropes of lengths A and B
+// become one of length A+B, which is also what the step costs
+type RopeMerge struct {
+	A, B int
+}
+
+// MinMergeCost connects all ropes into one, paying the combined length at
+// every join, and returns the minimum total cost with the joins in order.
+// Greedy: always join the two shortest ropes. Each rope's length is paid
+// once per join above it, exactly like a symbol's frequency is paid once
+// per bit of its Huffman code, and the same exchange argument shows the
+// two smallest belong deepest in the tree.
+// Time Complexity: O(n log n)
+// Space Complexity: O(n)
+func MinMergeCost(lengths []int) (int, []RopeMerge) {
+	ropes := intMinHeap(append([]int(nil), lengths...))
+	heap.Init(&ropes)
+
+	cost := 0
+	merges := []RopeMerge{}
+	for ropes.Len() > 1 {
+		a := heap.Pop(&ropes).(int)
+		b := heap.Pop(&ropes).(int)
+		cost += a + b
+		merges = append(merges, RopeMerge{a, b})
+		heap.Push(&ropes, a+b)
+	}
+	return cost, merges
+}
+
+// sequentialMergeCost joins the ropes left to right, the obvious strategy
+// the greedy is compared against
+func sequentialMergeCost(lengths []int) int {
+	if len(lengths) == 0 {
+		return 0
+	}
+	cost, current := 0, lengths[0]
+	for _, length := range lengths[1:] {
+		current += length
+		cost += current
+	}
+	return cost
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoRopeMerge demonstrates the minimum cost to connect ropes and its
+// connection to Huffman coding
+func DemoRopeMerge() {
+	fmt.Println("=== MINIMUM COST TO CONNECT ROPES ===")
+	fmt.Println()
+
+	fmt.Println("Joining ropes of lengths a and b costs a+b. Join all ropes into one")
+	fmt.Println("as cheaply as possible.")
+	fmt.Println()
+
+	lengths := []int{8, 4, 6, 12, 3}
+	cost, merges := MinMergeCost(lengths)
+	fmt.Printf("Ropes: %v\n", lengths)
+	for _, merge := range merges {
+		fmt.Printf("  join %2d + %2d -> %2d\n", merge.A, merge.B, merge.A+merge.B)
+	}
+	sorted := append([]int(nil), lengths...)
+	sort.Ints(sorted)
+	fmt.Printf("Greedy cost: %d; left to right: %d; left to right after sorting: %d\n",
+		cost, sequentialMergeCost(lengths), sequentialMergeCost(sorted))
+	fmt.Println()
+
+	// The same greedy builds Huffman codes
+	fmt.Println("Same problem as Huffman coding, with frequencies as lengths:")
+	text := "abracadabra alakazam"
+	codes, _ := BuildHuffmanCodes(text)
+	frequencies := map[rune]int{}
+	for _, char := range text {
+		frequencies[char]++
+	}
+	encodedBits, counts := 0, []int{}
+	for _, char := range sortedKeys(frequencies) {
+		encodedBits += frequencies[char] * len(codes[char])
+		counts = append(counts, frequencies[char])
+	}
+	mergeCost, _ := MinMergeCost(counts)
+	fmt.Printf("  %q: frequencies %v\n", text, counts)
+	fmt.Printf("  MinMergeCost = %d, Huffman-encoded length = %d bits\n", mergeCost, encodedBits)
+	fmt.Println()
+
+	fmt.Println("Time Complexity: O(n log n) - n-1 joins, each two pops and a push")
+	fmt.Println()
+}
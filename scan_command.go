@@ -0,0 +1,116 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// ================================
+// SCAN COMMAND
+// ================================
+
+// runScanCommand implements
+//
+//	dsa scan --patterns FILE --file FILE [--locations N]
+//
+// which streams FILE ("-" for standard input) through an Aho-Corasick
+// automaton built from the patterns file and prints per-pattern counts and
+// the first N locations of each
+func runScanCommand(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("scan", flag.ContinueOnError)
+	patternsPath := flags.String("patterns", "", "file with one pattern per line; blank lines and lines starting with # are skipped")
+	inputPath := flags.String("file", "", "file to scan, or - for standard input")
+	locations := flags.Int("locations", 10, "locations to list per pattern (0 for counts only)")
+	if err := flags.Parse(args); errors.Is(err, flag.ErrHelp) {
+		return nil // flags already printed the usage
+	} else if err != nil {
+		return err
+	}
+	if *patternsPath == "" || *inputPath == "" {
+		return fmt.Errorf("usage: dsa scan --patterns FILE --file FILE [--locations N]")
+	}
+	if *locations < 0 {
+		return fmt.Errorf("--locations must not be negative, got %d", *locations)
+	}
+
+	patterns, err := os.Open(*patternsPath)
+	if err != nil {
+		return err
+	}
+	defer patterns.Close()
+	input := io.Reader(os.Stdin)
+	if *inputPath != "-" {
+		file, err := os.Open(*inputPath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		input = file
+	}
+	return scanCommand(patterns, *patternsPath, input, *inputPath, *locations, stdout)
+}
+
+// readPatterns returns the patterns in a patterns file, one per line
+func readPatterns(r io.Reader, name string) ([]string, error) {
+	patterns := []string{}
+	lines := bufio.NewScanner(r)
+	for lines.Scan() {
+		line := strings.TrimSuffix(lines.Text(), "\r")
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		patterns = append(patterns, line)
+	}
+	if err := lines.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	if len(patterns) == 0 {
+		return nil, fmt.Errorf("%s: no patterns", name)
+	}
+	return patterns, nil
+}
+
+// scanCommand does the work of dsa scan once the files are open
+func scanCommand(patternsFile io.Reader, patternsName string, input io.Reader, inputName string, locations int, out io.Writer) error {
+	patterns, err := readPatterns(patternsFile, patternsName)
+	if err != nil {
+		return err
+	}
+	ac, err := NewAhoCorasick(patterns)
+	if err != nil {
+		return fmt.Errorf("%s: %w", patternsName, err)
+	}
+
+	counts := make([]int, len(patterns))
+	found := make([][]ScanMatch, len(patterns))
+	summary, err := ac.Scan(input, func(m ScanMatch) {
+		counts[m.Pattern]++
+		if len(found[m.Pattern]) < locations {
+			found[m.Pattern] = append(found[m.Pattern], m)
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", inputName, err)
+	}
+
+	total := 0
+	fmt.Fprintf(out, "%s: %d lines, %d bytes, %d patterns\n", inputName, summary.Lines, summary.Bytes, len(patterns))
+	fmt.Fprintf(out, "%8s  %s\n", "COUNT", "PATTERN")
+	for i, pattern := range patterns {
+		total += counts[i]
+		fmt.Fprintf(out, "%8d  %s\n", counts[i], pattern)
+		for _, m := range found[i] {
+			fmt.Fprintf(out, "%8s  %s:%d:%d (byte %d)\n", "", inputName, m.Line, m.Column, m.Offset)
+		}
+		if hidden := counts[i] - len(found[i]); hidden > 0 && locations > 0 {
+			fmt.Fprintf(out, "%8s  ... %d more\n", "", hidden)
+		}
+	}
+	fmt.Fprintf(out, "%d matches\n", total)
+	return nil
+}
@@ -0,0 +1,202 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ================================
+// STRONGLY CONNECTED COMPONENTS
+// ================================
+
+// Transpose returns a new graph with every edge reversed
+func (g *DirectedGraph) Transpose() *DirectedGraph {
+	transposed := NewDirectedGraph(g.vertices)
+	for u := 0; u < g.vertices; u++ {
+		for _, v := range g.adjList[u] {
+			transposed.AddEdge(v, u)
+		}
+	}
+	return transposed
+}
+
+// normalizeComponents sorts members of each component and orders components
+// by their smallest member, so results from different algorithms compare equal
+func normalizeComponents(components [][]int) [][]int {
+	for _, component := range components {
+		sort.Ints(component)
+	}
+	sort.Slice(components, func(i, j int) bool {
+		return components[i][0] < components[j][0]
+	})
+	return components
+}
+
+// ================================
+// TARJAN'S ALGORITHM (ONE PASS)
+// ================================
+
+// TarjanSCC finds strongly connected components with a single DFS that tracks
+// discovery times and low-links; a vertex whose low-link equals its own
+// discovery time is the root of a component
+// Time Complexity: O(V + E)
+// Space Complexity: O(V)
+func (g *DirectedGraph) TarjanSCC() [][]int {
+	index := 0
+	discovery := make([]int, g.vertices)
+	lowLink := make([]int, g.vertices)
+	onStack := make([]bool, g.vertices)
+	for i := range discovery {
+		discovery[i] = -1
+	}
+
+	stack := []int{}
+	components := [][]int{}
+
+	var strongConnect func(v int)
+	strongConnect = func(v int) {
+		discovery[v] = index
+		lowLink[v] = index
+		index++
+		stack = append(stack, v)
+		onStack[v] = true
+
+		for _, w := range g.adjList[v] {
+			if discovery[w] == -1 {
+				strongConnect(w)
+				lowLink[v] = min(lowLink[v], lowLink[w])
+			} else if onStack[w] {
+				lowLink[v] = min(lowLink[v], discovery[w])
+			}
+		}
+
+		// v is the root of a component: pop it off the stack
+		if lowLink[v] == discovery[v] {
+			component := []int{}
+			for {
+				w := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[w] = false
+				component = append(component, w)
+				if w == v {
+					break
+				}
+			}
+			components = append(components, component)
+		}
+	}
+
+	for v := 0; v < g.vertices; v++ {
+		if discovery[v] == -1 {
+			strongConnect(v)
+		}
+	}
+
+	return normalizeComponents(components)
+}
+
+// ================================
+// KOSARAJU'S ALGORITHM (TWO PASSES)
+// ================================
+
+// KosarajuSCC finds strongly connected components with two DFS passes:
+//  1. DFS on the graph, recording vertices by finish time
+//  2. DFS on the transposed graph in decreasing finish time;
+//     each tree found in this pass is one component
+//
+// Time Complexity: O(V + E)
+// Space Complexity: O(V)
+func (g *DirectedGraph) KosarajuSCC() [][]int {
+	// Pass 1: finish order is exactly the DFS topological-sort stack
+	visited := make(map[int]bool)
+	finishOrder := []int{}
+	for v := 0; v < g.vertices; v++ {
+		if !visited[v] {
+			g.topologicalSortUtil(v, visited, &finishOrder)
+		}
+	}
+
+	// Pass 2: explore the transpose from the latest-finishing vertex
+	transposed := g.Transpose()
+	assigned := make(map[int]bool)
+	components := [][]int{}
+
+	for i := len(finishOrder) - 1; i >= 0; i-- {
+		v := finishOrder[i]
+		if assigned[v] {
+			continue
+		}
+
+		component := []int{}
+		stack := []int{v}
+		assigned[v] = true
+		for len(stack) > 0 {
+			u := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			component = append(component, u)
+			for _, w := range transposed.adjList[u] {
+				if !assigned[w] {
+					assigned[w] = true
+					stack = append(stack, w)
+				}
+			}
+		}
+		components = append(components, component)
+	}
+
+	return normalizeComponents(components)
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoSCC compares Tarjan's and Kosaraju's algorithms on several graphs
+func DemoSCC() {
+	fmt.Println("=== STRONGLY CONNECTED COMPONENTS ===")
+	fmt.Println()
+
+	fmt.Println("A strongly connected component is a maximal set of vertices where")
+	fmt.Println("every vertex can reach every other vertex along directed edges.")
+	fmt.Println()
+
+	classic := NewDirectedGraph(8)
+	for _, e := range [][]int{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 4}, {4, 5}, {5, 3}, {6, 5}, {6, 7}, {7, 6}} {
+		classic.AddEdge(e[0], e[1])
+	}
+
+	dag := NewDirectedGraph(6)
+	for _, e := range [][]int{{5, 2}, {5, 0}, {4, 0}, {4, 1}, {2, 3}, {3, 1}} {
+		dag.AddEdge(e[0], e[1])
+	}
+
+	ring := NewDirectedGraph(5)
+	for i := 0; i < 5; i++ {
+		ring.AddEdge(i, (i+1)%5)
+	}
+
+	graphs := []struct {
+		name  string
+		graph *DirectedGraph
+	}{
+		{"Three cycles linked together", classic},
+		{"DAG (every vertex alone)", dag},
+		{"Single ring", ring},
+	}
+
+	for _, entry := range graphs {
+		tarjan := entry.graph.TarjanSCC()
+		kosaraju := entry.graph.KosarajuSCC()
+
+		fmt.Printf("%s:\n", entry.name)
+		fmt.Printf("  Tarjan:    %v\n", tarjan)
+		fmt.Printf("  Kosaraju:  %v\n", kosaraju)
+		fmt.Printf("  Identical: %v\n\n", fmt.Sprint(tarjan) == fmt.Sprint(kosaraju))
+	}
+
+	fmt.Println("=== ALGORITHM COMPARISON ===")
+	fmt.Println("Tarjan:   one DFS, low-link values, explicit stack of open vertices")
+	fmt.Println("Kosaraju: two DFS passes, needs the transposed graph (extra O(V + E) space)")
+	fmt.Println("Both run in O(V + E) time")
+	fmt.Println()
+}
@@ -0,0 +1,297 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// ================================
+// SQRT DECOMPOSITION
+// ================================
+
+// SqrtDecomposition splits an array into blocks of about √n elements and
+// keeps each block's sum. A query or update touches whole blocks through
+// their summaries and at most two partial blocks element by element, so
+// every operation is O(√n). Range additions covering a whole block are
+// stored as a pending per-block addend instead of touching its elements.
+type SqrtDecomposition struct {
+	values    []int
+	blockSize int
+	blockSum  []int // sum of each block, including its pending addend
+	pending   []int // added to every element of the block, not yet in values
+}
+
+// NewSqrtDecomposition builds the blocks over a copy of values
+// Time Complexity: O(n)
+func NewSqrtDecomposition(values []int) *SqrtDecomposition {
+	blockSize := max(1, int(math.Sqrt(float64(len(values)))))
+	blocks := (len(values) + blockSize - 1) / blockSize
+	sd := &SqrtDecomposition{
+		values:    append([]int(nil), values...),
+		blockSize: blockSize,
+		blockSum:  make([]int, blocks),
+		pending:   make([]int, blocks),
+	}
+	for i, value := range values {
+		sd.blockSum[i/blockSize] += value
+	}
+	return sd
+}
+
+// checkRange validates an inclusive range [left, right]
+func (sd *SqrtDecomposition) checkRange(left, right int) error {
+	if left < 0 || right >= len(sd.values) || left > right {
+		return fmt.Errorf("range [%d, %d] is invalid for length %d", left, right, len(sd.values))
+	}
+	return nil
+}
+
+// Set assigns value to position i
+// Time Complexity: O(1)
+func (sd *SqrtDecomposition) Set(i, value int) error {
+	if err := sd.checkRange(i, i); err != nil {
+		return err
+	}
+	block := i / sd.blockSize
+	current := sd.values[i] + sd.pending[block]
+	sd.values[i] += value - current
+	sd.blockSum[block] += value - current
+	return nil
+}
+
+// RangeAdd adds delta to every position in [left, right]
+// Time Complexity: O(√n)
+func (sd *SqrtDecomposition) RangeAdd(left, right, delta int) error {
+	if err := sd.checkRange(left, right); err != nil {
+		return err
+	}
+	for i := left; i <= right; {
+		block := i / sd.blockSize
+		blockStart := block * sd.blockSize
+		blockEnd := min(blockStart+sd.blockSize, len(sd.values)) - 1
+		if i == blockStart && blockEnd <= right {
+			// Whole block: record it once for all its elements
+			sd.pending[block] += delta
+			sd.blockSum[block] += delta * (blockEnd - blockStart + 1)
+			i = blockEnd + 1
+			continue
+		}
+		sd.values[i] += delta
+		sd.blockSum[block] += delta
+		i++
+	}
+	return nil
+}
+
+// RangeSum returns the sum of positions [left, right]
+// Time Complexity: O(√n)
+func (sd *SqrtDecomposition) RangeSum(left, right int) (int, error) {
+	if err := sd.checkRange(left, right); err != nil {
+		return 0, err
+	}
+	sum := 0
+	for i := left; i <= right; {
+		block := i / sd.blockSize
+		blockStart := block * sd.blockSize
+		blockEnd := min(blockStart+sd.blockSize, len(sd.values)) - 1
+		if i == blockStart && blockEnd <= right {
+			sum += sd.blockSum[block]
+			i = blockEnd + 1
+			continue
+		}
+		sum += sd.values[i] + sd.pending[block]
+		i++
+	}
+	return sum, nil
+}
+
+// ================================
+// SEGMENT TREE (FOR COMPARISON)
+// ================================
+
+// SegmentTree supports the same operations in O(log n) with a binary tree
+// of range sums and lazy propagation: a range addition that covers a node
+// is parked on it and pushed to the children only when they are visited.
+type SegmentTree struct {
+	n    int
+	sum  []int
+	lazy []int
+}
+
+// NewSegmentTree builds the tree over values
+// Time Complexity: O(n)
+func NewSegmentTree(values []int) *SegmentTree {
+	st := &SegmentTree{n: len(values), sum: make([]int, 4*len(values)), lazy: make([]int, 4*len(values))}
+	if len(values) > 0 {
+		st.build(1, 0, len(values)-1, values)
+	}
+	return st
+}
+
+func (st *SegmentTree) build(node, lo, hi int, values []int) {
+	if lo == hi {
+		st.sum[node] = values[lo]
+		return
+	}
+	mid := (lo + hi) / 2
+	st.build(2*node, lo, mid, values)
+	st.build(2*node+1, mid+1, hi, values)
+	st.sum[node] = st.sum[2*node] + st.sum[2*node+1]
+}
+
+// push hands a node's pending addition down to its two children
+func (st *SegmentTree) push(node, lo, hi int) {
+	if st.lazy[node] == 0 {
+		return
+	}
+	mid := (lo + hi) / 2
+	for _, child := range [2][3]int{{2 * node, lo, mid}, {2*node + 1, mid + 1, hi}} {
+		st.lazy[child[0]] += st.lazy[node]
+		st.sum[child[0]] += st.lazy[node] * (child[2] - child[1] + 1)
+	}
+	st.lazy[node] = 0
+}
+
+// RangeAdd adds delta to every position in [left, right]
+// Time Complexity: O(log n)
+func (st *SegmentTree) RangeAdd(left, right, delta int) error {
+	if left < 0 || right >= st.n || left > right {
+		return fmt.Errorf("range [%d, %d] is invalid for length %d", left, right, st.n)
+	}
+	st.add(1, 0, st.n-1, left, right, delta)
+	return nil
+}
+
+func (st *SegmentTree) add(node, lo, hi, left, right, delta int) {
+	if right < lo || hi < left {
+		return
+	}
+	if left <= lo && hi <= right {
+		st.sum[node] += delta * (hi - lo + 1)
+		st.lazy[node] += delta
+		return
+	}
+	st.push(node, lo, hi)
+	mid := (lo + hi) / 2
+	st.add(2*node, lo, mid, left, right, delta)
+	st.add(2*node+1, mid+1, hi, left, right, delta)
+	st.sum[node] = st.sum[2*node] + st.sum[2*node+1]
+}
+
+// Set assigns value to position i
+// Time Complexity: O(log n)
+func (st *SegmentTree) Set(i, value int) error {
+	current, err := st.RangeSum(i, i)
+	if err != nil {
+		return err
+	}
+	return st.RangeAdd(i, i, value-current)
+}
+
+// RangeSum returns the sum of positions [left, right]
+// Time Complexity: O(log n)
+func (st *SegmentTree) RangeSum(left, right int) (int, error) {
+	if left < 0 || right >= st.n || left > right {
+		return 0, fmt.Errorf("range [%d, %d] is invalid for length %d", left, right, st.n)
+	}
+	return st.query(1, 0, st.n-1, left, right), nil
+}
+
+func (st *SegmentTree) query(node, lo, hi, left, right int) int {
+	if right < lo || hi < left {
+		return 0
+	}
+	if left <= lo && hi <= right {
+		return st.sum[node]
+	}
+	st.push(node, lo, hi)
+	mid := (lo + hi) / 2
+	return st.query(2*node, lo, mid, left, right) + st.query(2*node+1, mid+1, hi, left, right)
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// rangeStructure is the interface both structures share in the demo
+type rangeStructure interface {
+	Set(i, value int) error
+	RangeAdd(left, right, delta int) error
+	RangeSum(left, right int) (int, error)
+}
+
+// runRangeOperations applies a seeded mix of sets, range additions and range
+// sums, and returns a checksum of the query answers
+func runRangeOperations(rs rangeStructure, n, operations int, seed int64) int {
+	rng := rand.New(rand.NewSource(seed))
+	checksum := 0
+	for op := 0; op < operations; op++ {
+		left := rng.Intn(n)
+		right := left + rng.Intn(n-left)
+		switch rng.Intn(3) {
+		case 0:
+			rs.Set(left, rng.Intn(1000))
+		case 1:
+			rs.RangeAdd(left, right, rng.Intn(21)-10)
+		default:
+			sum, _ := rs.RangeSum(left, right)
+			checksum = checksum*31 + sum
+		}
+	}
+	return checksum
+}
+
+// DemoSqrtDecomposition demonstrates block decomposition against a segment tree
+func DemoSqrtDecomposition() {
+	fmt.Println("=== SQRT DECOMPOSITION ===")
+	fmt.Println()
+
+	// Example 1: Blocks
+	fmt.Println("=== EXAMPLE 1: Blocks and Pending Additions ===")
+	values := []int{3, 1, 4, 1, 5, 9, 2, 6, 5, 3}
+	sd := NewSqrtDecomposition(values)
+	fmt.Printf("Values %v, block size %d, block sums %v\n", values, sd.blockSize, sd.blockSum)
+	sum, _ := sd.RangeSum(2, 8)
+	fmt.Printf("RangeSum(2, 8) = %d: element 2 (4) plus the sums of whole blocks 1 and 2 (15 + 13)\n", sum)
+	sd.RangeAdd(1, 7, 10)
+	fmt.Printf("RangeAdd(1, 7, +10): pending %v, block sums %v\n", sd.pending, sd.blockSum)
+	sd.Set(4, 0)
+	sum, _ = sd.RangeSum(0, 9)
+	fmt.Printf("Set(4, 0), then RangeSum(0, 9) = %d\n", sum)
+	if _, err := sd.RangeSum(5, 12); err != nil {
+		fmt.Printf("RangeSum(5, 12): %v\n", err)
+	}
+	fmt.Println()
+
+	// Example 2: Benchmark
+	const n, operations = 200_000, 200_000
+	fmt.Printf("=== EXAMPLE 2: %d Mixed Operations on %d Elements ===\n", operations, n)
+	rng := rand.New(rand.NewSource(1032))
+	initial := make([]int, n)
+	for i := range initial {
+		initial[i] = rng.Intn(1000)
+	}
+	structures := []struct {
+		name  string
+		build func() rangeStructure
+	}{
+		{"Sqrt decomposition", func() rangeStructure { return NewSqrtDecomposition(initial) }},
+		{"Segment tree", func() rangeStructure { return NewSegmentTree(initial) }},
+	}
+	checksums := []int{}
+	for _, s := range structures {
+		start := time.Now()
+		rs := s.build()
+		checksums = append(checksums, runRangeOperations(rs, n, operations, 7))
+		fmt.Printf("%-20s %v\n", s.name, time.Since(start).Round(time.Millisecond))
+	}
+	fmt.Printf("Same answers: %v\n", checksums[0] == checksums[1])
+	fmt.Println()
+
+	fmt.Println("O(√n) per operation loses to O(log n), but by a small constant at this")
+	fmt.Println("size: the block version is a flat loop over two arrays with no recursion")
+	fmt.Println("or lazy pushes, and is much harder to get wrong.")
+	fmt.Println()
+}
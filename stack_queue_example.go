@@ -0,0 +1,537 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand"
+	"strings"
+)
+
+// ================================
+// STACK
+// ================================
+
+// Stack is a last-in, first-out collection backed by a slice
+type Stack[T any] struct {
+	items []T
+}
+
+// Push adds item on top
+// Time Complexity: O(1) amortized
+func (s *Stack[T]) Push(item T) {
+	s.items = append(s.items, item)
+}
+
+// Pop removes and returns the top item, or false if the stack is empty
+// Time Complexity: O(1)
+func (s *Stack[T]) Pop() (T, bool) {
+	var zero T
+	if len(s.items) == 0 {
+		return zero, false
+	}
+	item := s.items[len(s.items)-1]
+	s.items[len(s.items)-1] = zero // let the garbage collector reclaim it
+	s.items = s.items[:len(s.items)-1]
+	return item, true
+}
+
+// Peek returns the top item without removing it
+func (s *Stack[T]) Peek() (T, bool) {
+	if len(s.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return s.items[len(s.items)-1], true
+}
+
+// Len returns the number of items
+func (s *Stack[T]) Len() int {
+	return len(s.items)
+}
+
+// ================================
+// DEQUE
+// ================================
+
+// Deque is a double-ended queue on a ring buffer: both ends push and pop in
+// O(1), and the buffer doubles when full
+type Deque[T any] struct {
+	items []T
+	head  int // index of the front item
+	size  int
+}
+
+// grow doubles the buffer, unrolling the ring so the front is at index 0
+func (d *Deque[T]) grow() {
+	items := make([]T, max(1, 2*len(d.items)))
+	for i := 0; i < d.size; i++ {
+		items[i] = d.items[(d.head+i)%len(d.items)]
+	}
+	d.items, d.head = items, 0
+}
+
+// PushBack adds item at the back
+// Time Complexity: O(1) amortized
+func (d *Deque[T]) PushBack(item T) {
+	if d.size == len(d.items) {
+		d.grow()
+	}
+	d.items[(d.head+d.size)%len(d.items)] = item
+	d.size++
+}
+
+// PushFront adds item at the front
+// Time Complexity: O(1) amortized
+func (d *Deque[T]) PushFront(item T) {
+	if d.size == len(d.items) {
+		d.grow()
+	}
+	d.head = (d.head - 1 + len(d.items)) % len(d.items)
+	d.items[d.head] = item
+	d.size++
+}
+
+// PopFront removes and returns the front item, or false if empty
+// Time Complexity: O(1)
+func (d *Deque[T]) PopFront() (T, bool) {
+	var zero T
+	if d.size == 0 {
+		return zero, false
+	}
+	item := d.items[d.head]
+	d.items[d.head] = zero
+	d.head = (d.head + 1) % len(d.items)
+	d.size--
+	return item, true
+}
+
+// PopBack removes and returns the back item, or false if empty
+// Time Complexity: O(1)
+func (d *Deque[T]) PopBack() (T, bool) {
+	var zero T
+	if d.size == 0 {
+		return zero, false
+	}
+	i := (d.head + d.size - 1) % len(d.items)
+	item := d.items[i]
+	d.items[i] = zero
+	d.size--
+	return item, true
+}
+
+// Front returns the front item without removing it
+func (d *Deque[T]) Front() (T, bool) {
+	if d.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.items[d.head], true
+}
+
+// Back returns the back item without removing it
+func (d *Deque[T]) Back() (T, bool) {
+	if d.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.items[(d.head+d.size-1)%len(d.items)], true
+}
+
+// Len returns the number of items
+func (d *Deque[T]) Len() int {
+	return d.size
+}
+
+// ================================
+// QUEUE
+// ================================
+
+// Queue is a first-in, first-out collection. Unlike the common
+// queue = queue[1:] idiom, it reuses its buffer instead of letting the
+// slice creep forward through memory.
+type Queue[T any] struct {
+	items Deque[T]
+}
+
+// Enqueue adds item at the back
+// Time Complexity: O(1) amortized
+func (q *Queue[T]) Enqueue(item T) {
+	q.items.PushBack(item)
+}
+
+// Dequeue removes and returns the front item, or false if empty
+// Time Complexity: O(1)
+func (q *Queue[T]) Dequeue() (T, bool) {
+	return q.items.PopFront()
+}
+
+// Peek returns the front item without removing it
+func (q *Queue[T]) Peek() (T, bool) {
+	return q.items.Front()
+}
+
+// Len returns the number of items
+func (q *Queue[T]) Len() int {
+	return q.items.Len()
+}
+
+// ================================
+// BALANCED BRACKETS
+// ================================
+
+// CheckBrackets verifies that every (, [ and { in s is closed by the
+// matching bracket in the right order; other characters are ignored.
+// The error names the first offending position.
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+func CheckBrackets(s string) error {
+	closing := map[rune]rune{')': '(', ']': '[', '}': '{'}
+	type opened struct {
+		bracket  rune
+		position int
+	}
+	stack := Stack[opened]{}
+	for i, char := range s {
+		switch char {
+		case '(', '[', '{':
+			stack.Push(opened{char, i})
+		case ')', ']', '}':
+			top, ok := stack.Pop()
+			if !ok {
+				return fmt.Errorf("unexpected %q at position %d", char, i)
+			}
+			if top.bracket != closing[char] {
+				return fmt.Errorf("%q at position %d closes %q from position %d", char, i, top.bracket, top.position)
+			}
+		}
+	}
+	if top, ok := stack.Pop(); ok {
+		return fmt.Errorf("%q at position %d is never closed", top.bracket, top.position)
+	}
+	return nil
+}
+
+// ================================
+// MIN-STACK
+// ================================
+
+// MinStack is a stack that also reports its minimum in O(1). Each entry
+// stores the minimum of itself and everything below it, so popping
+// restores the previous minimum for free.
+type MinStack[T cmp.Ordered] struct {
+	entries Stack[minStackEntry[T]]
+}
+
+type minStackEntry[T cmp.Ordered] struct {
+	value, min T
+}
+
+// Push adds value on top
+// Time Complexity: O(1) amortized
+func (ms *MinStack[T]) Push(value T) {
+	entry := minStackEntry[T]{value: value, min: value}
+	if top, ok := ms.entries.Peek(); ok {
+		entry.min = min(value, top.min)
+	}
+	ms.entries.Push(entry)
+}
+
+// Pop removes and returns the top value, or false if empty
+// Time Complexity: O(1)
+func (ms *MinStack[T]) Pop() (T, bool) {
+	entry, ok := ms.entries.Pop()
+	return entry.value, ok
+}
+
+// Top returns the top value without removing it
+func (ms *MinStack[T]) Top() (T, bool) {
+	entry, ok := ms.entries.Peek()
+	return entry.value, ok
+}
+
+// Min returns the smallest value on the stack
+// Time Complexity: O(1)
+func (ms *MinStack[T]) Min() (T, bool) {
+	entry, ok := ms.entries.Peek()
+	return entry.min, ok
+}
+
+// Len returns the number of values
+func (ms *MinStack[T]) Len() int {
+	return ms.entries.Len()
+}
+
+// ================================
+// QUEUE FROM TWO STACKS
+// ================================
+
+// TwoStackQueue is a FIFO queue built from two LIFO stacks: Enqueue pushes
+// onto inbox, and Dequeue pops from outbox, refilling it by reversing the
+// whole inbox when it runs dry. Each item is moved at most once, so every
+// operation is O(1) amortized.
+type TwoStackQueue[T any] struct {
+	inbox, outbox Stack[T]
+	moves         int // items transferred from inbox to outbox so far
+}
+
+// Enqueue adds item at the back
+// Time Complexity: O(1)
+func (q *TwoStackQueue[T]) Enqueue(item T) {
+	q.inbox.Push(item)
+}
+
+// Dequeue removes and returns the front item, or false if empty
+// Time Complexity: O(1) amortized, O(n) for the call that refills outbox
+func (q *TwoStackQueue[T]) Dequeue() (T, bool) {
+	q.refill()
+	return q.outbox.Pop()
+}
+
+// Peek returns the front item without removing it
+func (q *TwoStackQueue[T]) Peek() (T, bool) {
+	q.refill()
+	return q.outbox.Peek()
+}
+
+// refill reverses inbox into outbox when outbox is empty, putting the
+// oldest item on top
+func (q *TwoStackQueue[T]) refill() {
+	if q.outbox.Len() > 0 {
+		return
+	}
+	for {
+		item, ok := q.inbox.Pop()
+		if !ok {
+			return
+		}
+		q.outbox.Push(item)
+		q.moves++
+	}
+}
+
+// Len returns the number of items
+func (q *TwoStackQueue[T]) Len() int {
+	return q.inbox.Len() + q.outbox.Len()
+}
+
+// ================================
+// STOCK SPAN
+// ================================
+
+// StockSpanner reports, for each day's price, the span: how many
+// consecutive days up to and including today had a price <= today's.
+// It keeps a monotonic stack of (price, span) with strictly decreasing
+// prices; a new price absorbs the spans of every smaller price it pops.
+type StockSpanner struct {
+	days Stack[[2]int] // (price, span)
+}
+
+// Next records today's price and returns its span
+// Time Complexity: O(1) amortized, since each day is popped at most once
+func (ss *StockSpanner) Next(price int) int {
+	span := 1
+	for {
+		top, ok := ss.days.Peek()
+		if !ok || top[0] > price {
+			break
+		}
+		ss.days.Pop()
+		span += top[1]
+	}
+	ss.days.Push([2]int{price, span})
+	return span
+}
+
+// StockSpans returns the span of every price in the series
+// Time Complexity: O(n)
+func StockSpans(prices []int) []int {
+	spanner := StockSpanner{}
+	spans := make([]int, len(prices))
+	for i, price := range prices {
+		spans[i] = spanner.Next(price)
+	}
+	return spans
+}
+
+// ================================
+// SLIDING WINDOW MAXIMUM
+// ================================
+
+// SlidingWindowMax returns the maximum of every window of k consecutive
+// values. The deque holds indices of decreasing values; the front is the
+// current maximum, and a new value evicts every smaller value from the back
+// because none of them can be a maximum again.
+// Time Complexity: O(n)
+// Space Complexity: O(k)
+func SlidingWindowMax(nums []int, k int) ([]int, error) {
+	if k < 1 || k > len(nums) {
+		return nil, fmt.Errorf("window size %d must be between 1 and %d", k, len(nums))
+	}
+	window := Deque[int]{}
+	maxima := make([]int, 0, len(nums)-k+1)
+	for i, num := range nums {
+		for {
+			back, ok := window.Back()
+			if !ok || nums[back] > num {
+				break
+			}
+			window.PopBack()
+		}
+		window.PushBack(i)
+		if front, _ := window.Front(); front <= i-k {
+			window.PopFront()
+		}
+		if i >= k-1 {
+			front, _ := window.Front()
+			maxima = append(maxima, nums[front])
+		}
+	}
+	return maxima, nil
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoStackQueue demonstrates the generic containers and their classic uses
+func DemoStackQueue() {
+	fmt.Println("=== STACKS AND QUEUES ===")
+	fmt.Println()
+
+	// Example 1: The containers
+	fmt.Println("=== EXAMPLE 1: Stack, Queue and Deque ===")
+	stack := Stack[string]{}
+	queue := Queue[string]{}
+	for _, word := range []string{"first", "second", "third"} {
+		stack.Push(word)
+		queue.Enqueue(word)
+	}
+	top, _ := stack.Pop()
+	front, _ := queue.Dequeue()
+	fmt.Printf("Pushed and enqueued first, second, third: Pop = %s, Dequeue = %s\n", top, front)
+
+	deque := Deque[int]{}
+	for i := 1; i <= 3; i++ {
+		deque.PushBack(i)
+		deque.PushFront(-i)
+	}
+	contents := []int{}
+	for deque.Len() > 0 {
+		value, _ := deque.PopFront()
+		contents = append(contents, value)
+	}
+	fmt.Printf("PushBack(i) and PushFront(-i) for i = 1..3: %v\n", contents)
+	if _, ok := deque.PopBack(); !ok {
+		fmt.Println("PopBack on an empty deque reports false")
+	}
+	fmt.Println()
+
+	// Example 2: Balanced brackets
+	fmt.Println("=== EXAMPLE 2: Balanced Brackets ===")
+	for _, s := range []string{"{[()()]}", "func(a[i]) { return }", "([)]", "(()", "())"} {
+		if err := CheckBrackets(s); err != nil {
+			fmt.Printf("%-24q unbalanced: %v\n", s, err)
+		} else {
+			fmt.Printf("%-24q balanced\n", s)
+		}
+	}
+	fmt.Println()
+
+	// Example 3: Min-stack
+	fmt.Println("=== EXAMPLE 3: Min-Stack ===")
+	minStack := MinStack[int]{}
+	steps := []string{}
+	for _, value := range []int{5, 3, 7, 3, 1, 8} {
+		minStack.Push(value)
+		low, _ := minStack.Min()
+		steps = append(steps, fmt.Sprintf("Push(%d) min=%d", value, low))
+	}
+	fmt.Println(strings.Join(steps, "  "))
+	steps = steps[:0]
+	for minStack.Len() > 0 {
+		value, _ := minStack.Pop()
+		if low, ok := minStack.Min(); ok {
+			steps = append(steps, fmt.Sprintf("Pop()=%d min=%d", value, low))
+		} else {
+			steps = append(steps, fmt.Sprintf("Pop()=%d empty", value))
+		}
+	}
+	fmt.Println(strings.Join(steps, "  "))
+	fmt.Println()
+
+	// Example 4: Queue from two stacks
+	fmt.Println("=== EXAMPLE 4: Queue from Two Stacks ===")
+	twoStacks := TwoStackQueue[int]{}
+	order := []int{}
+	operations := 0
+	for i := 0; i < 100000; i++ {
+		twoStacks.Enqueue(i)
+		operations++
+		if i%3 == 2 {
+			value, _ := twoStacks.Dequeue()
+			operations++
+			if len(order) < 5 {
+				order = append(order, value)
+			}
+		}
+	}
+	fmt.Printf("First dequeued values: %v (FIFO order)\n", order)
+	fmt.Printf("%d operations moved %d items between stacks: %.2f moves per operation\n",
+		operations, twoStacks.moves, float64(twoStacks.moves)/float64(operations))
+	fmt.Println()
+
+	// Example 5: Stock span and sliding window maximum
+	fmt.Println("=== EXAMPLE 5: Monotonic Stack and Deque ===")
+	prices := []int{100, 80, 60, 70, 60, 75, 85}
+	fmt.Printf("Prices: %v\n", prices)
+	fmt.Printf("Spans:  %v\n", StockSpans(prices))
+	nums := []int{1, 3, -1, -3, 5, 3, 6, 7}
+	maxima, _ := SlidingWindowMax(nums, 3)
+	fmt.Printf("Maximum of each window of 3 in %v: %v\n", nums, maxima)
+	if _, err := SlidingWindowMax(nums, 10); err != nil {
+		fmt.Printf("Window of 10: %v\n", err)
+	}
+	fmt.Println()
+
+	// Example 6: 0-1 BFS
+	fmt.Println("=== EXAMPLE 6: 0-1 BFS (Fewest Roads to Reverse) ===")
+	// One-way roads cost 0 to follow and 1 to drive against, so the
+	// distance to a city is the number of roads that must be reversed
+	roads := [][2]int{{0, 1}, {2, 1}, {2, 3}, {4, 3}, {4, 5}, {0, 6}, {6, 5}}
+	cities := NewWeightedGraph(7)
+	for _, road := range roads {
+		cities.AddEdge(road[0], road[1], 0)
+		cities.AddEdge(road[1], road[0], 1)
+	}
+	reversals, _ := cities.ZeroOneBFS(0)
+	fmt.Printf("Roads: %v\n", roads)
+	fmt.Printf("Reversals needed from city 0: %v\n", formatDistances(reversals.distances))
+	fmt.Printf("Route to city 3: %v\n", reversals.GetPath(3))
+
+	rng := rand.New(rand.NewSource(1039))
+	mismatches := 0
+	for trial := 0; trial < 200; trial++ {
+		random := NewWeightedGraph(30)
+		for e := 0; e < 90; e++ {
+			random.AddEdge(rng.Intn(30), rng.Intn(30), float64(rng.Intn(2)))
+		}
+		result, _ := random.ZeroOneBFS(0)
+		for v := 0; v < 30; v++ {
+			if expected, _ := random.DijkstraWithPath(0, v); result.distances[v] != expected {
+				mismatches++
+			}
+		}
+	}
+	fmt.Printf("Distances differing from Dijkstra over 200 random graphs: %d\n", mismatches)
+	weighted := NewWeightedGraph(2)
+	weighted.AddEdge(0, 1, 2.5)
+	if _, err := weighted.ZeroOneBFS(0); err != nil {
+		fmt.Printf("Weight 2.5: %v\n", err)
+	}
+	fmt.Println()
+
+	fmt.Println("Stacks answer \"what is the most recent unresolved thing?\", queues")
+	fmt.Println("\"what has waited longest?\"; a deque answers both at once, which is")
+	fmt.Println("what lets 0-1 BFS keep its frontier sorted without a heap.")
+	fmt.Println()
+}
@@ -0,0 +1,266 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ================================
+// SUFFIX ARRAY WITH LCP (KASAI)
+// ================================
+
+// SuffixArray lists the starting positions of all suffixes of a text in
+// sorted order, next to lcp, where lcp[i] is the length of the longest
+// common prefix of the suffixes at sa[i-1] and sa[i] (lcp[0] = 0). It
+// answers the same questions as a SuffixTree with two int slices instead of
+// a node per suffix.
+type SuffixArray struct {
+	text string
+	sa   []int
+	lcp  []int
+}
+
+// NewSuffixArray builds the suffix array by prefix doubling and the LCP
+// array with Kasai's algorithm
+// Time Complexity: O(n log n)
+// Space Complexity: O(n)
+func NewSuffixArray(text string) *SuffixArray {
+	sa := buildSuffixArray(text)
+	return &SuffixArray{text: text, sa: sa, lcp: kasaiLCP(text, sa)}
+}
+
+// buildSuffixArray sorts the suffixes by their first k characters for
+// k = 1, 2, 4, ...: once suffixes are ranked by k characters, the rank of
+// suffix i by 2k characters is the pair (rank[i], rank[i+k]), so each
+// round is a radix sort of pairs of ranks. It stops once all ranks differ.
+func buildSuffixArray(text string) []int {
+	n := len(text)
+	sa, rank, next := make([]int, n), make([]int, n), make([]int, n)
+	if n == 0 {
+		return sa
+	}
+
+	// Round 0: counting sort by the first character
+	count := make([]int, max(256, n)+1)
+	for i := 0; i < n; i++ {
+		rank[i] = int(text[i])
+		count[rank[i]+1]++
+	}
+	for c := 1; c < len(count); c++ {
+		count[c] += count[c-1]
+	}
+	for i := 0; i < n; i++ {
+		sa[count[rank[i]]] = i
+		count[rank[i]]++
+	}
+	classes := 0
+	for i := range sa {
+		if i == 0 || text[sa[i]] != text[sa[i-1]] {
+			classes++
+		}
+		next[sa[i]] = classes - 1
+	}
+	rank, next = next, rank
+
+	secondKey := func(i, k int) int {
+		if i+k < n {
+			return rank[i+k]
+		}
+		return -1 // shorter suffixes sort first
+	}
+	bySecond := make([]int, n)
+	for k := 1; classes < n; k *= 2 {
+		// Order by the second half: suffixes too short to have one come
+		// first, then the rest in the order of their second half's rank
+		p := 0
+		for i := n - k; i < n; i++ {
+			bySecond[p] = i
+			p++
+		}
+		for _, j := range sa {
+			if j >= k {
+				bySecond[p] = j - k
+				p++
+			}
+		}
+		// Stable counting sort by the first half
+		clear(count)
+		for i := 0; i < n; i++ {
+			count[rank[i]+1]++
+		}
+		for c := 1; c <= classes; c++ {
+			count[c] += count[c-1]
+		}
+		for _, j := range bySecond {
+			sa[count[rank[j]]] = j
+			count[rank[j]]++
+		}
+
+		classes = 0
+		for i := range sa {
+			if i == 0 || rank[sa[i]] != rank[sa[i-1]] || secondKey(sa[i], k) != secondKey(sa[i-1], k) {
+				classes++
+			}
+			next[sa[i]] = classes - 1
+		}
+		rank, next = next, rank
+	}
+	return sa
+}
+
+// kasaiLCP computes the LCP array in O(n). It visits suffixes in text
+// order: if suffix i shares h characters with its predecessor in sa, then
+// suffix i+1 shares at least h-1 with its own predecessor, so h drops by
+// at most one per step and the total work is linear.
+func kasaiLCP(text string, sa []int) []int {
+	n := len(text)
+	lcp, rank := make([]int, n), make([]int, n)
+	for i, suffix := range sa {
+		rank[suffix] = i
+	}
+	h := 0
+	for i := 0; i < n; i++ {
+		if rank[i] == 0 {
+			h = 0
+			continue
+		}
+		j := sa[rank[i]-1]
+		for i+h < n && j+h < n && text[i+h] == text[j+h] {
+			h++
+		}
+		lcp[rank[i]] = h
+		if h > 0 {
+			h--
+		}
+	}
+	return lcp
+}
+
+// prefixAt returns up to m characters of the suffix at position i
+func (s *SuffixArray) prefixAt(i, m int) string {
+	return s.text[i:min(i+m, len(s.text))]
+}
+
+// FindAll returns every position where pattern occurs, in increasing
+// order. The suffixes starting with pattern are contiguous in sa, so two
+// binary searches find them.
+// Time Complexity: O(m log n + k log k) for k occurrences
+func (s *SuffixArray) FindAll(pattern string) []int {
+	m := len(pattern)
+	if m == 0 {
+		return []int{}
+	}
+	lo := sort.Search(len(s.sa), func(i int) bool { return s.prefixAt(s.sa[i], m) >= pattern })
+	hi := sort.Search(len(s.sa), func(i int) bool { return s.prefixAt(s.sa[i], m) > pattern })
+	positions := append([]int{}, s.sa[lo:hi]...)
+	sort.Ints(positions)
+	return positions
+}
+
+// Contains reports whether pattern occurs in the text
+// Time Complexity: O(m log n)
+func (s *SuffixArray) Contains(pattern string) bool {
+	i := sort.Search(len(s.sa), func(i int) bool { return s.prefixAt(s.sa[i], len(pattern)) >= pattern })
+	return i < len(s.sa) && s.prefixAt(s.sa[i], len(pattern)) == pattern
+}
+
+// LongestRepeatedSubstring returns the longest substring occurring at least
+// twice: the largest LCP between neighbouring suffixes
+// Time Complexity: O(n)
+func (s *SuffixArray) LongestRepeatedSubstring() string {
+	best := 0
+	for i := 1; i < len(s.lcp); i++ {
+		if s.lcp[i] > s.lcp[best] {
+			best = i
+		}
+	}
+	if len(s.lcp) == 0 {
+		return ""
+	}
+	return s.text[s.sa[best] : s.sa[best]+s.lcp[best]]
+}
+
+// DistinctSubstrings counts the distinct non-empty substrings. Suffix sa[i]
+// contributes one new substring per prefix, minus the lcp[i] prefixes
+// already counted for its predecessor.
+// Time Complexity: O(n)
+func (s *SuffixArray) DistinctSubstrings() int {
+	total := 0
+	for i, suffix := range s.sa {
+		total += len(s.text) - suffix - s.lcp[i]
+	}
+	return total
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoSuffixArray demonstrates the suffix array and compares it with KMP
+func DemoSuffixArray() {
+	fmt.Println("=== SUFFIX ARRAY WITH LCP ===")
+	fmt.Println()
+
+	// Example 1: The arrays
+	fmt.Println("=== EXAMPLE 1: banana ===")
+	banana := NewSuffixArray("banana")
+	fmt.Printf("%3s %3s %4s  %s\n", "i", "sa", "lcp", "suffix")
+	for i, suffix := range banana.sa {
+		fmt.Printf("%3d %3d %4d  %s\n", i, suffix, banana.lcp[i], banana.text[suffix:])
+	}
+	fmt.Printf("FindAll(ana) = %v, Contains(nab) = %v\n", banana.FindAll("ana"), banana.Contains("nab"))
+	fmt.Printf("Longest repeated substring: %q, distinct substrings: %d\n",
+		banana.LongestRepeatedSubstring(), banana.DistinctSubstrings())
+	fmt.Println()
+
+	// Example 2: Many queries over one text
+	text := strings.Builder{}
+	files, _ := explanationDocs.ReadDir(".")
+	for _, file := range files {
+		content, _ := explanationDocs.ReadFile(file.Name())
+		text.Write(content)
+	}
+	docs := text.String()
+	queries := docWords()
+	fmt.Printf("=== EXAMPLE 2: %d Word Queries over the Markdown Docs (%d bytes) ===\n", len(queries), len(docs))
+
+	start := time.Now()
+	index := NewSuffixArray(docs)
+	build := time.Since(start)
+	start = time.Now()
+	arrayResults := make([][]int, len(queries))
+	for i, query := range queries {
+		arrayResults[i] = index.FindAll(query)
+	}
+	arrayTime := time.Since(start)
+
+	start = time.Now()
+	kmpResults := make([][]int, len(queries))
+	for i, query := range queries {
+		kmpResults[i] = KMPSearchSimple(docs, query)
+	}
+	kmpTime := time.Since(start)
+
+	mismatches := 0
+	for i := range queries {
+		if fmt.Sprint(arrayResults[i]) != fmt.Sprint(kmpResults[i]) {
+			mismatches++
+		}
+	}
+	fmt.Printf("Suffix array: build %v, all queries %v\n", build.Round(time.Millisecond), arrayTime.Round(time.Millisecond))
+	fmt.Printf("KMP:          all queries %v\n", kmpTime.Round(time.Millisecond))
+	fmt.Printf("Queries with different answers: %d\n", mismatches)
+
+	tree, _ := NewSuffixTree(docs)
+	repeated := index.LongestRepeatedSubstring()
+	fmt.Printf("Longest repeated substring (%d bytes) matches the suffix tree: %v\n",
+		len(repeated), repeated == tree.LongestRepeatedSubstring())
+	fmt.Println()
+
+	fmt.Println("KMP pays O(n) per query; the suffix array pays O(n log n) once and then")
+	fmt.Println("O(m log n) per query, so it wins as soon as the same text is searched")
+	fmt.Println("more than a handful of times.")
+	fmt.Println()
+}
@@ -0,0 +1,367 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ================================
+// SUFFIX TREE (UKKONEN'S ALGORITHM)
+// ================================
+
+const (
+	suffixTreeTerminator = '\x00' // appended to the text, so no suffix is a prefix of another
+	suffixTreeSeparator  = '\x01' // joins the two strings of a generalized tree
+)
+
+// suffixTreeNode is a node of a SuffixTree. The edge into it is labeled
+// text[start..*end]; all leaves share one end, so extending every leaf by a
+// character is a single increment.
+type suffixTreeNode struct {
+	children map[byte]*suffixTreeNode
+	link     *suffixTreeNode // suffix link: the node for this path minus its first character
+	start    int
+	end      *int
+	suffix   int // for leaves, where its suffix starts; -1 for internal nodes
+}
+
+func (n *suffixTreeNode) edgeLength() int {
+	return *n.end - n.start + 1
+}
+
+// SuffixTree is a compressed trie of all suffixes of a text. It has at
+// most 2n nodes, and any pattern can be looked up in O(m) by walking down
+// from the root, since every substring is a prefix of some suffix.
+type SuffixTree struct {
+	text string // the input followed by suffixTreeTerminator
+	root *suffixTreeNode
+}
+
+// NewSuffixTree builds the suffix tree of text with Ukkonen's algorithm.
+// Time Complexity: O(n) for a constant alphabet
+// Space Complexity: O(n)
+func NewSuffixTree(text string) (*SuffixTree, error) {
+	if strings.ContainsAny(text, string([]byte{suffixTreeTerminator, suffixTreeSeparator})) {
+		return nil, fmt.Errorf("text contains a reserved byte (0x00 or 0x01)")
+	}
+	return buildSuffixTree(text + string(suffixTreeTerminator)), nil
+}
+
+// buildSuffixTree runs Ukkonen's algorithm on text, whose last byte must
+// occur nowhere else. Phase i adds text[i] to every suffix still in
+// progress. Suffixes that already end at a leaf grow for free through the
+// shared leaf end; the others are extended from the active point
+// (activeNode, activeEdge, activeLength), which marks where the longest
+// unfinished suffix ends, and each extension moves to the next shorter
+// suffix in O(1) amortized through suffix links. A phase stops early as
+// soon as the character is already present, since then it is present for
+// all shorter suffixes too.
+func buildSuffixTree(text string) *SuffixTree {
+	rootEnd := -1
+	root := &suffixTreeNode{children: map[byte]*suffixTreeNode{}, start: -1, end: &rootEnd, suffix: -1}
+	root.link = root
+	leafEnd := new(int)
+
+	activeNode, activeEdge, activeLength := root, 0, 0
+	remaining := 0 // suffixes still to be added explicitly
+	for i := 0; i < len(text); i++ {
+		*leafEnd = i
+		remaining++
+		var lastInternal *suffixTreeNode // waiting for its suffix link
+
+		for remaining > 0 {
+			if activeLength == 0 {
+				activeEdge = i
+			}
+			next := activeNode.children[text[activeEdge]]
+			if next == nil {
+				// No edge starts with the character: hang a new leaf here
+				activeNode.children[text[activeEdge]] = &suffixTreeNode{start: i, end: leafEnd, suffix: -1}
+				if lastInternal != nil {
+					lastInternal.link = activeNode
+					lastInternal = nil
+				}
+			} else {
+				if activeLength >= next.edgeLength() {
+					// Skip/count: hop over the whole edge
+					activeEdge += next.edgeLength()
+					activeLength -= next.edgeLength()
+					activeNode = next
+					continue
+				}
+				if text[next.start+activeLength] == text[i] {
+					// Already present: the rest of this phase is implicit
+					if lastInternal != nil && activeNode != root {
+						lastInternal.link = activeNode
+					}
+					activeLength++
+					break
+				}
+				// Split the edge and hang a new leaf off the split point
+				splitEnd := next.start + activeLength - 1
+				split := &suffixTreeNode{children: map[byte]*suffixTreeNode{}, link: root, start: next.start, end: &splitEnd, suffix: -1}
+				activeNode.children[text[activeEdge]] = split
+				split.children[text[i]] = &suffixTreeNode{start: i, end: leafEnd, suffix: -1}
+				next.start += activeLength
+				split.children[text[next.start]] = next
+				if lastInternal != nil {
+					lastInternal.link = split
+				}
+				lastInternal = split
+			}
+
+			remaining--
+			if activeNode == root && activeLength > 0 {
+				activeLength--
+				activeEdge = i - remaining + 1
+			} else if activeNode != root {
+				activeNode = activeNode.link
+			}
+		}
+	}
+
+	tree := &SuffixTree{text: text, root: root}
+	tree.labelLeaves(root, 0)
+	return tree
+}
+
+// labelLeaves records at every leaf where its suffix starts
+func (t *SuffixTree) labelLeaves(node *suffixTreeNode, depth int) {
+	if len(node.children) == 0 {
+		node.suffix = len(t.text) - depth
+		return
+	}
+	for _, child := range node.children {
+		t.labelLeaves(child, depth+child.edgeLength())
+	}
+}
+
+// locate walks pattern down from the root. It returns the node at or below
+// the end of the match, or nil if pattern does not occur.
+// Time Complexity: O(m)
+func (t *SuffixTree) locate(pattern string) *suffixTreeNode {
+	node := t.root
+	for i := 0; i < len(pattern); {
+		child := node.children[pattern[i]]
+		if child == nil {
+			return nil
+		}
+		for k := child.start; k <= *child.end && i < len(pattern); k++ {
+			if t.text[k] != pattern[i] {
+				return nil
+			}
+			i++
+		}
+		node = child
+	}
+	return node
+}
+
+// Contains reports whether pattern occurs in the text
+// Time Complexity: O(m)
+func (t *SuffixTree) Contains(pattern string) bool {
+	return t.locate(pattern) != nil
+}
+
+// FindAll returns every position where pattern occurs, in increasing order
+// Time Complexity: O(m + k log k) for k occurrences
+func (t *SuffixTree) FindAll(pattern string) []int {
+	positions := []int{}
+	node := t.locate(pattern)
+	if node == nil || pattern == "" {
+		return positions
+	}
+	stack := []*suffixTreeNode{node}
+	for len(stack) > 0 {
+		node, stack = stack[len(stack)-1], stack[:len(stack)-1]
+		if node.suffix >= 0 {
+			positions = append(positions, node.suffix)
+		}
+		for _, child := range node.children {
+			stack = append(stack, child)
+		}
+	}
+	sort.Ints(positions)
+	return positions
+}
+
+// LongestRepeatedSubstring returns the longest substring occurring at least
+// twice (possibly overlapping); the earliest one if several tie. It is the
+// label of the deepest internal node, since an internal node is exactly a
+// place where two suffixes share a prefix.
+// Time Complexity: O(n)
+func (t *SuffixTree) LongestRepeatedSubstring() string {
+	best, bestStart := 0, 0
+	var visit func(node *suffixTreeNode, depth int) int
+	// visit returns the smallest suffix start below node
+	visit = func(node *suffixTreeNode, depth int) int {
+		if node.suffix >= 0 {
+			return node.suffix
+		}
+		first := len(t.text)
+		for _, child := range node.children {
+			first = min(first, visit(child, depth+child.edgeLength()))
+		}
+		if depth > best || depth == best && first < bestStart {
+			best, bestStart = depth, first
+		}
+		return first
+	}
+	visit(t.root, 0)
+	return t.text[bestStart : bestStart+best]
+}
+
+// LongestCommonSubstring returns the longest string occurring in both a and
+// b; the earliest in a if several tie. It builds one generalized suffix tree
+// of a + separator + b and looks for the deepest internal node with leaves
+// from both strings below it.
+// Time Complexity: O(|a| + |b|)
+func LongestCommonSubstring(a, b string) (string, error) {
+	reserved := string([]byte{suffixTreeTerminator, suffixTreeSeparator})
+	if strings.ContainsAny(a, reserved) || strings.ContainsAny(b, reserved) {
+		return "", fmt.Errorf("input contains a reserved byte (0x00 or 0x01)")
+	}
+	t := buildSuffixTree(a + string(suffixTreeSeparator) + b + string(suffixTreeTerminator))
+
+	const fromA, fromB = 1, 2
+	best, bestStart := 0, 0
+	var visit func(node *suffixTreeNode, depth int) (int, int)
+	// visit returns which strings have suffixes below node, and the
+	// smallest start in a among them
+	visit = func(node *suffixTreeNode, depth int) (int, int) {
+		if node.suffix >= 0 {
+			switch {
+			case node.suffix < len(a):
+				return fromA, node.suffix
+			case node.suffix > len(a):
+				return fromB, len(t.text)
+			}
+			return 0, len(t.text) // the suffix that starts with the separator
+		}
+		sides, first := 0, len(t.text)
+		for _, child := range node.children {
+			childSides, childFirst := visit(child, depth+child.edgeLength())
+			sides |= childSides
+			first = min(first, childFirst)
+		}
+		if sides == fromA|fromB && (depth > best || depth == best && first < bestStart) {
+			best, bestStart = depth, first
+		}
+		return sides, first
+	}
+	visit(t.root, 0)
+	return a[bestStart : bestStart+best], nil
+}
+
+// NodeCount returns the number of nodes, including the root
+func (t *SuffixTree) NodeCount() int {
+	count := 0
+	stack := []*suffixTreeNode{t.root}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		count++
+		for _, child := range node.children {
+			stack = append(stack, child)
+		}
+	}
+	return count
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// randomDNA returns a random strand over ACGT
+func randomDNA(n int, rng *rand.Rand) string {
+	strand := make([]byte, n)
+	for i := range strand {
+		strand[i] = "ACGT"[rng.Intn(4)]
+	}
+	return string(strand)
+}
+
+// DemoSuffixTree demonstrates Ukkonen's suffix tree on DNA
+func DemoSuffixTree() {
+	fmt.Println("=== SUFFIX TREE (UKKONEN) ===")
+	fmt.Println()
+
+	// Example 1: The KMP DNA example, answered by one tree
+	fmt.Println("=== EXAMPLE 1: Genetic Patterns ===")
+	dna := "ATCGATCGATCGTAGCTAGCTATCGATCGTAGCT"
+	patterns := map[string]string{
+		"Start Codon": "ATG",
+		"Stop Codon":  "TAG",
+		"Promoter":    "ATCG",
+		"Enhancer":    "GCTA",
+	}
+	tree, _ := NewSuffixTree(dna)
+	fmt.Printf("DNA Sequence: %s (%d nodes)\n", dna, tree.NodeCount())
+	for _, name := range sortedKeys(patterns) {
+		pattern := patterns[name]
+		fmt.Printf("%-12s (%s): positions %v, KMP agrees: %v\n", name, pattern, tree.FindAll(pattern),
+			fmt.Sprint(tree.FindAll(pattern)) == fmt.Sprint(KMPSearchSimple(dna, pattern)))
+	}
+	fmt.Printf("Longest repeated substring: %q\n", tree.LongestRepeatedSubstring())
+	common, _ := LongestCommonSubstring(dna, "GGTAGCTATCGAA")
+	fmt.Printf("Longest common substring with GGTAGCTATCGAA: %q\n", common)
+	if _, err := NewSuffixTree("AC\x00GT"); err != nil {
+		fmt.Printf("NewSuffixTree with a NUL byte: %v\n", err)
+	}
+	fmt.Println()
+
+	// Example 2: Brute-force cross-check
+	fmt.Println("=== EXAMPLE 2: 2000 Random Strands vs Brute Force ===")
+	rng := rand.New(rand.NewSource(1036))
+	mismatches := 0
+	for trial := 0; trial < 2000; trial++ {
+		a, b := randomDNA(1+rng.Intn(30), rng), randomDNA(1+rng.Intn(30), rng)
+		t, _ := NewSuffixTree(a)
+
+		pattern := randomDNA(1+rng.Intn(3), rng)
+		if fmt.Sprint(t.FindAll(pattern)) != fmt.Sprint(KMPSearchSimple(a, pattern)) {
+			mismatches++
+		}
+		repeated := ""
+		for length := len(a) - 1; length > 0 && repeated == ""; length-- {
+			for i := 0; i+length <= len(a); i++ {
+				if strings.Index(a[i+1:], a[i:i+length]) >= 0 {
+					repeated = a[i : i+length]
+					break
+				}
+			}
+		}
+		shared := ""
+		for i := 0; i < len(a); i++ {
+			for j := i + len(shared) + 1; j <= len(a) && strings.Contains(b, a[i:j]); j++ {
+				shared = a[i:j]
+			}
+		}
+		lcs, _ := LongestCommonSubstring(a, b)
+		if t.LongestRepeatedSubstring() != repeated || lcs != shared {
+			mismatches++
+		}
+	}
+	fmt.Printf("Mismatches in FindAll, longest repeated and longest common substring: %d\n", mismatches)
+	fmt.Println()
+
+	// Example 3: Linear construction
+	fmt.Println("=== EXAMPLE 3: Construction Time ===")
+	for _, n := range []int{50_000, 100_000, 200_000} {
+		strand := randomDNA(n, rng)
+		start := time.Now()
+		t, _ := NewSuffixTree(strand)
+		elapsed := time.Since(start)
+		fmt.Printf("n = %-7d %9v  %7d nodes  %4.0f ns per character\n",
+			n, elapsed.Round(time.Millisecond), t.NodeCount(), float64(elapsed.Nanoseconds())/float64(n))
+	}
+	fmt.Println()
+
+	fmt.Println("Once built, the tree answers any pattern in O(m), where KMP rescans the")
+	fmt.Println("whole text per pattern; the price is a large constant in memory, with")
+	fmt.Println("up to 2n nodes each holding a child map.")
+	fmt.Println()
+}
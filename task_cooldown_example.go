@@ -0,0 +1,169 @@
+package main
+
+import (
+	"container/heap"
+	"fmt"
+)
+
+// ================================
+// TASK SCHEDULING WITH COOLDOWN
+// ================================
+
+// idleSlot marks a time unit where the CPU waits for a cooldown to expire.
+// It is not a valid task, so schedules can be read unambiguously.
+const idleSlot byte = '-'
+
+// taskCount is a task type with its remaining number of executions
+type taskCount struct {
+	task      byte
+	remaining int
+}
+
+// taskHeap is a max-heap by remaining count; ties go to the smaller task letter
+// so schedules are deterministic
+type taskHeap []taskCount
+
+func (h taskHeap) Len() int { return len(h) }
+
+func (h taskHeap) Less(i, j int) bool {
+	if h[i].remaining != h[j].remaining {
+		return h[i].remaining > h[j].remaining
+	}
+	return h[i].task < h[j].task
+}
+
+func (h taskHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *taskHeap) Push(x interface{}) { *h = append(*h, x.(taskCount)) }
+
+func (h *taskHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[0 : n-1]
+	return item
+}
+
+// LeastIntervalSchedule finds the minimum number of time units needed to run
+// all tasks when two runs of the same task must be at least cooldown units
+// apart, and returns one schedule achieving it (idleSlot marks idle time).
+// Greedy: at each step run the available task with the most runs left, since
+// it is the one most likely to force idle time later.
+// Returns an error for a negative cooldown or a task equal to idleSlot.
+// Time Complexity: O(T log k) where T = total time and k = distinct tasks
+// Space Complexity: O(k)
+func LeastIntervalSchedule(tasks []byte, cooldown int) (int, []byte, error) {
+	if cooldown < 0 {
+		return 0, nil, fmt.Errorf("cooldown must not be negative, got %d", cooldown)
+	}
+	counts := make(map[byte]int)
+	for i, task := range tasks {
+		if task == idleSlot {
+			return 0, nil, fmt.Errorf("task %d is %q, which marks idle time", i, idleSlot)
+		}
+		counts[task]++
+	}
+
+	available := &taskHeap{}
+	for _, task := range sortedKeys(counts) {
+		heap.Push(available, taskCount{task, counts[task]})
+	}
+
+	// Tasks cooling down, in the order they become available again
+	type waiting struct {
+		readyAt int
+		taskCount
+	}
+	cooling := Queue[waiting]{}
+
+	schedule := []byte{}
+	for time := 0; available.Len() > 0 || cooling.Len() > 0; time++ {
+		if front, ok := cooling.Peek(); ok && front.readyAt == time {
+			heap.Push(available, front.taskCount)
+			cooling.Dequeue()
+		}
+
+		if available.Len() == 0 {
+			schedule = append(schedule, idleSlot)
+			continue
+		}
+
+		next := heap.Pop(available).(taskCount)
+		schedule = append(schedule, next.task)
+		if next.remaining--; next.remaining > 0 {
+			cooling.Enqueue(waiting{time + cooldown + 1, next})
+		}
+	}
+
+	return len(schedule), schedule, nil
+}
+
+// leastIntervalFormula computes the same minimum time in O(n) by counting:
+// the most frequent task forms maxCount-1 frames of length cooldown+1,
+// followed by one slot per task that shares the maximum count
+func leastIntervalFormula(tasks []byte, cooldown int) int {
+	counts := make(map[byte]int)
+	maxCount := 0
+	for _, task := range tasks {
+		counts[task]++
+		maxCount = max(maxCount, counts[task])
+	}
+
+	tiedForMax := 0
+	for _, count := range counts {
+		if count == maxCount {
+			tiedForMax++
+		}
+	}
+
+	framed := (maxCount-1)*(cooldown+1) + tiedForMax
+	if framed > len(tasks) {
+		return framed
+	}
+	return len(tasks)
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoTaskCooldown demonstrates scheduling identical tasks with a cooldown
+func DemoTaskCooldown() {
+	fmt.Println("=== TASK SCHEDULING WITH COOLDOWN ===")
+	fmt.Println()
+
+	fmt.Println("TaskScheduler orders tasks by dependencies. Here there are none;")
+	fmt.Println("instead a resource needs cooldown units of rest between runs of")
+	fmt.Println("the same task type, and the goal is the shortest total time.")
+	fmt.Println()
+
+	examples := []struct {
+		tasks    string
+		cooldown int
+	}{
+		{"AAABBB", 2},
+		{"AAABBB", 0},
+		{"AAAAAABCDEFG", 2},
+		{"AAABBBCCDDEE", 2},
+		{"AAAB", 3},
+		{"AAB", -1},
+		{"A-B", 1},
+	}
+
+	for _, example := range examples {
+		total, schedule, err := LeastIntervalSchedule([]byte(example.tasks), example.cooldown)
+		if err != nil {
+			fmt.Printf("Tasks %s, cooldown %d: %v\n\n", example.tasks, example.cooldown, err)
+			continue
+		}
+		formula := leastIntervalFormula([]byte(example.tasks), example.cooldown)
+
+		fmt.Printf("Tasks %s, cooldown %d:\n", example.tasks, example.cooldown)
+		fmt.Printf("  Schedule: %s\n", schedule)
+		fmt.Printf("  Time: %d (counting formula agrees: %v)\n\n", total, total == formula)
+	}
+
+	fmt.Println("'-' marks idle time. When there are enough distinct tasks to fill")
+	fmt.Println("every cooldown gap, the answer is simply the number of tasks.")
+	fmt.Println()
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestLeastIntervalScheduleRejectsBadInput(t *testing.T) {
+	for _, test := range []struct {
+		tasks    string
+		cooldown int
+	}{{"AAB", -1}, {"", -5}, {"A-B", 1}, {"-", 0}} {
+		if _, _, err := LeastIntervalSchedule([]byte(test.tasks), test.cooldown); err == nil {
+			t.Errorf("LeastIntervalSchedule(%q, %d) succeeded", test.tasks, test.cooldown)
+		}
+	}
+}
+
+// TestLeastIntervalScheduleValid checks random schedules against the
+// counting formula and checks that they respect the cooldown
+func TestLeastIntervalScheduleValid(t *testing.T) {
+	rng := rand.New(rand.NewSource(1013))
+	for trial := 0; trial < 500; trial++ {
+		tasks := make([]byte, rng.Intn(30))
+		for i := range tasks {
+			tasks[i] = byte('A' + rng.Intn(5))
+		}
+		cooldown := rng.Intn(5)
+		total, schedule, err := LeastIntervalSchedule(tasks, cooldown)
+		if err != nil {
+			t.Fatalf("LeastIntervalSchedule(%s, %d): %v", tasks, cooldown, err)
+		}
+		if want := leastIntervalFormula(tasks, cooldown); total != want || len(schedule) != total {
+			t.Fatalf("LeastIntervalSchedule(%s, %d) = %d (%s), want %d", tasks, cooldown, total, schedule, want)
+		}
+
+		lastRun, ran := map[byte]int{}, map[byte]int{}
+		for time, task := range schedule {
+			if task == idleSlot {
+				continue
+			}
+			if last, ok := lastRun[task]; ok && time-last <= cooldown {
+				t.Fatalf("schedule %s for cooldown %d runs %c at %d and %d", schedule, cooldown, task, last, time)
+			}
+			lastRun[task] = time
+			ran[task]++
+		}
+		for _, task := range tasks {
+			ran[task]--
+		}
+		for task, extra := range ran {
+			if extra != 0 {
+				t.Fatalf("schedule %s for %s runs %c %+d times too often", schedule, tasks, task, extra)
+			}
+		}
+	}
+}
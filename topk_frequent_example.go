@@ -0,0 +1,306 @@
+package main
+
+import (
+	"container/heap"
+	"fmt"
+	"math/rand"
+	"slices"
+	"sort"
+	"time"
+)
+
+// ================================
+// TOP-K FREQUENT ELEMENTS
+// ================================
+
+// valueCount is a distinct value and how often it occurs
+type valueCount struct {
+	value, count int
+}
+
+// moreFrequent orders values by count, most frequent first, and by value
+// among equal counts, so every method picks the same k values on ties
+func moreFrequent(a, b valueCount) bool {
+	if a.count != b.count {
+		return a.count > b.count
+	}
+	return a.value < b.value
+}
+
+// countFrequencies counts every distinct value of nums
+func countFrequencies(nums []int) []valueCount {
+	counts := map[int]int{}
+	for _, num := range nums {
+		counts[num]++
+	}
+	entries := make([]valueCount, 0, len(counts))
+	for value, count := range counts {
+		entries = append(entries, valueCount{value, count})
+	}
+	return entries
+}
+
+// topKValues checks k against the number of distinct values and returns
+// the values of the first k entries
+func topKValues(entries []valueCount, k int) ([]int, error) {
+	if k < 1 || k > len(entries) {
+		return nil, fmt.Errorf("k = %d out of range [1, %d]", k, len(entries))
+	}
+	values := make([]int, k)
+	for i := range values {
+		values[i] = entries[i].value
+	}
+	return values, nil
+}
+
+// TopKFrequent returns the k most frequent values of nums, most frequent
+// first and smaller values first among equal counts. It uses the bucket
+// method; TopKFrequentHeap and TopKFrequentQuickSelect give the same answer.
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+func TopKFrequent(nums []int, k int) ([]int, error) {
+	return TopKFrequentBucket(nums, k)
+}
+
+// TopKFrequentBucket puts every value into a bucket indexed by its count,
+// which is at most n, then reads buckets from the highest count down.
+// Only the buckets it reads are sorted (for the tie order).
+// Time Complexity: O(n) plus sorting the ties it reads
+// Space Complexity: O(n)
+func TopKFrequentBucket(nums []int, k int) ([]int, error) {
+	entries := countFrequencies(nums)
+	if k < 1 || k > len(entries) {
+		return topKValues(entries, k)
+	}
+	buckets := make([][]int, len(nums)+1)
+	for _, entry := range entries {
+		buckets[entry.count] = append(buckets[entry.count], entry.value)
+	}
+	values := make([]int, 0, k)
+	for count := len(nums); len(values) < k; count-- {
+		sort.Ints(buckets[count])
+		values = append(values, buckets[count][:min(len(buckets[count]), k-len(values))]...)
+	}
+	return values, nil
+}
+
+// frequencyHeap is a min-heap with the least frequent value on top, so the
+// heap keeps the k most frequent seen so far
+type frequencyHeap []valueCount
+
+func (h frequencyHeap) Len() int           { return len(h) }
+func (h frequencyHeap) Less(i, j int) bool { return moreFrequent(h[j], h[i]) }
+func (h frequencyHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *frequencyHeap) Push(x interface{}) { *h = append(*h, x.(valueCount)) }
+
+func (h *frequencyHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[0 : n-1]
+	return item
+}
+
+// TopKFrequentHeap streams the distinct values through a heap of size k,
+// evicting the least frequent whenever a better one arrives
+// Time Complexity: O(n + d log k) for d distinct values
+// Space Complexity: O(d + k)
+func TopKFrequentHeap(nums []int, k int) ([]int, error) {
+	entries := countFrequencies(nums)
+	if k < 1 || k > len(entries) {
+		return topKValues(entries, k)
+	}
+	top := &frequencyHeap{}
+	for _, entry := range entries {
+		if top.Len() < k {
+			heap.Push(top, entry)
+		} else if moreFrequent(entry, (*top)[0]) {
+			(*top)[0] = entry
+			heap.Fix(top, 0)
+		}
+	}
+	best := make([]valueCount, k)
+	for i := k - 1; i >= 0; i-- {
+		best[i] = heap.Pop(top).(valueCount)
+	}
+	return topKValues(best, k)
+}
+
+// TopKFrequentQuickSelect partitions the distinct values around random
+// pivots until the k most frequent occupy the front, then sorts only those
+// Time Complexity: O(n + d + k log k) expected, O(n + d²) worst case
+// Space Complexity: O(d)
+func TopKFrequentQuickSelect(nums []int, k int) ([]int, error) {
+	entries := countFrequencies(nums)
+	if k < 1 || k > len(entries) {
+		return topKValues(entries, k)
+	}
+	left, right := 0, len(entries)-1
+	for left < right {
+		pivotIndex := left + rand.Intn(right-left+1)
+		entries[pivotIndex], entries[right] = entries[right], entries[pivotIndex]
+		store := left
+		for i := left; i < right; i++ {
+			if moreFrequent(entries[i], entries[right]) {
+				entries[i], entries[store] = entries[store], entries[i]
+				store++
+			}
+		}
+		entries[store], entries[right] = entries[right], entries[store]
+		switch {
+		case store == k-1:
+			left = right // the front k are the answer
+		case store < k-1:
+			left = store + 1
+		default:
+			right = store - 1
+		}
+	}
+	best := entries[:k]
+	slices.SortFunc(best, func(a, b valueCount) int {
+		if moreFrequent(a, b) {
+			return -1
+		}
+		return 1
+	})
+	return topKValues(best, k)
+}
+
+// ================================
+// TIMING COMPARISON
+// ================================
+
+// TopKTiming is how one method fared on one input
+type TopKTiming struct {
+	Method     string
+	PerCall    time.Duration
+	Mismatches int // 1 if the answer differs from the first method's
+}
+
+// topKMethods lists the implementations in the order they are compared
+var topKMethods = []struct {
+	name string
+	run  func(nums []int, k int) ([]int, error)
+}{
+	{"Heap", TopKFrequentHeap},
+	{"Bucket sort", TopKFrequentBucket},
+	{"QuickSelect", TopKFrequentQuickSelect},
+}
+
+// CompareTopKFrequent times every method on the same input, repeating
+// each until it has run for at least minDuration, and compares answers
+// with the first method's. Counting dominates all three for small k, so
+// the differences show mostly when there are many distinct values.
+func CompareTopKFrequent(nums []int, k int, minDuration time.Duration) ([]TopKTiming, error) {
+	results := []TopKTiming{}
+	var expected []int
+	for _, method := range topKMethods {
+		answer, err := method.run(nums, k)
+		if err != nil {
+			return nil, err
+		}
+		if expected == nil {
+			expected = answer
+		}
+		result := TopKTiming{Method: method.name}
+		if !slices.Equal(answer, expected) {
+			result.Mismatches = 1
+		}
+
+		calls := 0
+		start := time.Now()
+		for calls == 0 || time.Since(start) < minDuration {
+			method.run(nums, k)
+			calls++
+		}
+		result.PerCall = time.Since(start) / time.Duration(calls)
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// topKInput is a named input for timing the top-k methods
+type topKInput struct {
+	name  string
+	shape string // what the counts look like
+	nums  []int
+}
+
+// topKTimingInputs returns the inputs DemoTopKFrequent times and
+// BenchmarkTopKFrequent runs: a few heavy values and many values with
+// similar counts. The seed is fixed so every run sees the same numbers.
+func topKTimingInputs() []topKInput {
+	rng := rand.New(rand.NewSource(1058))
+	const n = 200_000
+	zipf := rand.NewZipf(rng, 1.2, 1, 1_000_000)
+	skewed, flat := make([]int, n), make([]int, n)
+	for i := range skewed {
+		skewed[i] = int(zipf.Uint64())
+		flat[i] = rng.Intn(n)
+	}
+	return []topKInput{
+		{name: "zipf", shape: "few heavy values", nums: skewed},
+		{name: "uniform", shape: "many values", nums: flat},
+	}
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoTopKFrequent demonstrates three ways to find the k most frequent
+// values and benchmarks them on skewed and flat inputs
+func DemoTopKFrequent() {
+	fmt.Println("=== TOP-K FREQUENT ELEMENTS ===")
+	fmt.Println()
+
+	// Example 1: Three methods, one answer
+	fmt.Println("=== EXAMPLE 1: Heap, Buckets and QuickSelect ===")
+	nums := []int{1, 1, 1, 2, 2, 3, 4, 4, 5, 5, 5, 5}
+	fmt.Printf("nums = %v\n", nums)
+	for _, k := range []int{1, 2, 3} {
+		fmt.Printf("k=%d:", k)
+		for _, method := range topKMethods {
+			top, _ := method.run(nums, k)
+			fmt.Printf("  %s %v", method.name, top)
+		}
+		fmt.Println()
+	}
+	if _, err := TopKFrequent(nums, 6); err != nil {
+		fmt.Println("k=6: error:", err)
+	}
+	fmt.Println("2 and 4 both occur twice; the smaller value wins the tie.")
+	fmt.Println()
+
+	// Example 2: Timing
+	fmt.Println("=== EXAMPLE 2: Timing ===")
+	fmt.Printf("%-28s %8s %8s %12s %12s %12s\n", "Input", "Distinct", "k", "Heap", "Bucket sort", "QuickSelect")
+	for _, test := range topKTimingInputs() {
+		distinct := len(countFrequencies(test.nums))
+		for _, k := range []int{10, distinct / 2} {
+			results, err := CompareTopKFrequent(test.nums, k, 50*time.Millisecond)
+			if err != nil {
+				fmt.Println("Error:", err)
+				return
+			}
+			fmt.Printf("%-28s %8d %8d", test.name+" ("+test.shape+")", distinct, k)
+			for _, result := range results {
+				fmt.Printf(" %12v", result.PerCall.Round(10*time.Microsecond))
+				if result.Mismatches > 0 {
+					fmt.Print("!")
+				}
+			}
+			fmt.Println()
+		}
+	}
+	fmt.Println()
+
+	fmt.Println("All three start by counting in a hash map, which is most of the work.")
+	fmt.Println("After that the heap pays O(log k) per distinct value, QuickSelect is")
+	fmt.Println("linear on average but sorts the k it keeps, and the buckets are linear")
+	fmt.Println("in n, not in the distinct values: n+1 buckets are allocated however")
+	fmt.Println("few are used, which is why they lose for small k. The heap is the only")
+	fmt.Println("one that could also work on a stream of counts without holding them all.")
+	fmt.Println()
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestTopKFrequentMethodsAgree(t *testing.T) {
+	rng := rand.New(rand.NewSource(58))
+	for trial := 0; trial < 200; trial++ {
+		nums := make([]int, 1+rng.Intn(60))
+		for i := range nums {
+			nums[i] = rng.Intn(12)
+		}
+		distinct := len(countFrequencies(nums))
+		for k := 1; k <= distinct; k++ {
+			want, err := TopKFrequent(nums, k)
+			if err != nil {
+				t.Fatalf("TopKFrequent(%v, %d): %v", nums, k, err)
+			}
+			for _, method := range topKMethods {
+				if got, err := method.run(nums, k); err != nil || !slices.Equal(got, want) {
+					t.Fatalf("%s(%v, %d) = %v, %v; want %v", method.name, nums, k, got, err, want)
+				}
+			}
+		}
+		for _, method := range topKMethods {
+			if _, err := method.run(nums, distinct+1); err == nil {
+				t.Errorf("%s with k > distinct values succeeded", method.name)
+			}
+		}
+	}
+}
+
+func BenchmarkTopKFrequent(b *testing.B) {
+	for _, input := range topKTimingInputs() {
+		nums := input.nums
+		distinct := len(countFrequencies(nums))
+		for _, k := range []int{10, distinct / 2} {
+			for _, method := range topKMethods {
+				b.Run(fmt.Sprintf("%s/k=%d/%s", input.name, k, method.name), func(b *testing.B) {
+					b.ReportAllocs()
+					for i := 0; i < b.N; i++ {
+						method.run(nums, k)
+					}
+				})
+			}
+		}
+	}
+}
@@ -1,7 +1,11 @@
 package main
 
 import (
+	"errors"
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
 )
 
 // DirectedGraph represents a directed graph using adjacency list
@@ -82,26 +86,25 @@ func (g *DirectedGraph) TopologicalSortKahn() []int {
 	}
 
 	// Find all vertices with 0 in-degree
-	queue := []int{}
+	queue := Queue[int]{}
 	for vertex := 0; vertex < g.vertices; vertex++ {
 		if inDegree[vertex] == 0 {
-			queue = append(queue, vertex)
+			queue.Enqueue(vertex)
 		}
 	}
 
 	result := []int{}
 
 	// Process vertices with 0 in-degree
-	for len(queue) > 0 {
-		vertex := queue[0]
-		queue = queue[1:]
+	for queue.Len() > 0 {
+		vertex, _ := queue.Dequeue()
 		result = append(result, vertex)
 
 		// Reduce in-degree of all adjacent vertices
 		for _, neighbor := range g.adjList[vertex] {
 			inDegree[neighbor]--
 			if inDegree[neighbor] == 0 {
-				queue = append(queue, neighbor)
+				queue.Enqueue(neighbor)
 			}
 		}
 	}
@@ -115,39 +118,58 @@ func (g *DirectedGraph) TopologicalSortKahn() []int {
 	return result
 }
 
-// ================================
-// CYCLE DETECTION
-// ================================
-
-// HasCycle detects if the directed graph has a cycle using DFS
-func (g *DirectedGraph) HasCycle() bool {
-	visited := make(map[int]bool)
-	recStack := make(map[int]bool)
-
+// TopologicalLevels runs Kahn's algorithm one round at a time: each round
+// removes every vertex whose in-degree is currently zero. Returns an error if
+// the graph has a cycle.
+// Time Complexity: O(V + E)
+// Space Complexity: O(V)
+func (g *DirectedGraph) TopologicalLevels() ([][]int, error) {
+	inDegree := make([]int, g.vertices)
 	for vertex := 0; vertex < g.vertices; vertex++ {
-		if !visited[vertex] {
-			if g.hasCycleUtil(vertex, visited, recStack) {
-				return true
-			}
+		for _, neighbor := range g.adjList[vertex] {
+			inDegree[neighbor]++
 		}
 	}
-	return false
-}
 
-func (g *DirectedGraph) hasCycleUtil(vertex int, visited, recStack map[int]bool) bool {
-	visited[vertex] = true
-	recStack[vertex] = true
+	current := []int{}
+	for vertex := 0; vertex < g.vertices; vertex++ {
+		if inDegree[vertex] == 0 {
+			current = append(current, vertex)
+		}
+	}
 
-	for _, neighbor := range g.adjList[vertex] {
-		if !visited[neighbor] && g.hasCycleUtil(neighbor, visited, recStack) {
-			return true
-		} else if recStack[neighbor] {
-			return true
+	levels := [][]int{}
+	processed := 0
+	for len(current) > 0 {
+		levels = append(levels, current)
+		processed += len(current)
+
+		next := []int{}
+		for _, vertex := range current {
+			for _, neighbor := range g.adjList[vertex] {
+				inDegree[neighbor]--
+				if inDegree[neighbor] == 0 {
+					next = append(next, neighbor)
+				}
+			}
 		}
+		sort.Ints(next)
+		current = next
 	}
 
-	recStack[vertex] = false
-	return false
+	if processed != g.vertices {
+		return nil, fmt.Errorf("graph contains a cycle")
+	}
+	return levels, nil
+}
+
+// ================================
+// CYCLE DETECTION
+// ================================
+
+// HasCycle detects if the directed graph has a cycle using DFS
+func (g *DirectedGraph) HasCycle() bool {
+	return GraphHasCycle(g)
 }
 
 // ================================
@@ -156,52 +178,35 @@ func (g *DirectedGraph) hasCycleUtil(vertex int, visited, recStack map[int]bool)
 
 // CourseSchedule represents a course scheduling problem
 type CourseSchedule struct {
-	courses []string
-	graph   *DirectedGraph
+	prerequisites map[string][]string // course -> courses that must come first
 }
 
 // NewCourseSchedule creates a new course scheduling system
 func NewCourseSchedule(courses []string) *CourseSchedule {
-	return &CourseSchedule{
-		courses: courses,
-		graph:   NewDirectedGraph(len(courses)),
+	cs := &CourseSchedule{prerequisites: make(map[string][]string, len(courses))}
+	for _, course := range courses {
+		cs.prerequisites[course] = nil
 	}
+	return cs
 }
 
 // AddPrerequisite adds a prerequisite relationship (prerequisite -> course)
 func (cs *CourseSchedule) AddPrerequisite(prerequisite, course string) {
-	prereqIndex := cs.findCourseIndex(prerequisite)
-	courseIndex := cs.findCourseIndex(course)
-
-	if prereqIndex != -1 && courseIndex != -1 {
-		cs.graph.AddEdge(prereqIndex, courseIndex)
+	_, knownPrerequisite := cs.prerequisites[prerequisite]
+	_, knownCourse := cs.prerequisites[course]
+	if knownPrerequisite && knownCourse {
+		cs.prerequisites[course] = append(cs.prerequisites[course], prerequisite)
 	}
 }
 
-func (cs *CourseSchedule) findCourseIndex(course string) int {
-	for i, c := range cs.courses {
-		if c == course {
-			return i
-		}
-	}
-	return -1
-}
-
 // GetOptimalOrder returns the optimal order to take courses
 func (cs *CourseSchedule) GetOptimalOrder() []string {
-	if cs.graph.HasCycle() {
-		fmt.Println("Circular dependency detected! Cannot schedule courses.")
+	order, err := TopoSort(cs.prerequisites)
+	if err != nil {
+		fmt.Printf("Cannot schedule courses: %v\n", err)
 		return nil
 	}
-
-	order := cs.graph.TopologicalSortDFS()
-	result := make([]string, len(order))
-
-	for i, courseIndex := range order {
-		result[i] = cs.courses[courseIndex]
-	}
-
-	return result
+	return order
 }
 
 // ================================
@@ -210,55 +215,157 @@ func (cs *CourseSchedule) GetOptimalOrder() []string {
 
 // TaskScheduler represents a task scheduling system
 type TaskScheduler struct {
-	tasks []string
-	graph *DirectedGraph
+	dependencies map[string][]string // task -> tasks that must finish first
 }
 
 // NewTaskScheduler creates a new task scheduler
 func NewTaskScheduler(tasks []string) *TaskScheduler {
-	return &TaskScheduler{
-		tasks: tasks,
-		graph: NewDirectedGraph(len(tasks)),
+	ts := &TaskScheduler{dependencies: make(map[string][]string, len(tasks))}
+	for _, task := range tasks {
+		ts.dependencies[task] = nil
 	}
+	return ts
 }
 
 // AddDependency adds a task dependency (dependency -> task)
 func (ts *TaskScheduler) AddDependency(dependency, task string) {
-	depIndex := ts.findTaskIndex(dependency)
-	taskIndex := ts.findTaskIndex(task)
+	_, knownDependency := ts.dependencies[dependency]
+	_, knownTask := ts.dependencies[task]
+	if knownDependency && knownTask {
+		ts.dependencies[task] = append(ts.dependencies[task], dependency)
+	}
+}
 
-	if depIndex != -1 && taskIndex != -1 {
-		ts.graph.AddEdge(depIndex, taskIndex)
+// GetExecutionOrder returns the optimal order to execute tasks
+func (ts *TaskScheduler) GetExecutionOrder() []string {
+	order, err := TopoSort(ts.dependencies)
+	if err != nil {
+		fmt.Printf("Cannot schedule tasks: %v\n", err)
+		return nil
 	}
+	return order
 }
 
-func (ts *TaskScheduler) findTaskIndex(task string) int {
-	for i, t := range ts.tasks {
-		if t == task {
-			return i
+// GetExecutionLevels groups tasks into waves: every task in a wave depends
+// only on tasks in earlier waves, so each wave can run concurrently. If the
+// dependencies contain a cycle, the error wraps TopoSort's *CycleError.
+func (ts *TaskScheduler) GetExecutionLevels() ([][]string, error) {
+	order, err := TopoSort(ts.dependencies)
+	if err != nil {
+		return nil, fmt.Errorf("cannot schedule tasks: %w", err)
+	}
+
+	// A task's wave is one after the latest wave among its dependencies
+	wave := make(map[string]int, len(order))
+	result := [][]string{}
+	for _, task := range order {
+		for _, dependency := range ts.dependencies[task] {
+			wave[task] = max(wave[task], wave[dependency]+1)
+		}
+		if wave[task] == len(result) {
+			result = append(result, nil)
 		}
+		result[wave[task]] = append(result[wave[task]], task)
 	}
-	return -1
+	return result, nil
 }
 
-// GetExecutionOrder returns the optimal order to execute tasks
-func (ts *TaskScheduler) GetExecutionOrder() []string {
-	if ts.graph.HasCycle() {
-		fmt.Println("Circular dependency detected! Cannot schedule tasks.")
-		return nil
+// Makespan returns the shortest total time to finish all tasks when
+// independent tasks run in parallel, along with each task's earliest start
+// time. durations must contain every task.
+// Time Complexity: O(V log V + E)
+func (ts *TaskScheduler) Makespan(durations map[string]int) (int, map[string]int, error) {
+	for task := range ts.dependencies {
+		if _, ok := durations[task]; !ok {
+			return 0, nil, fmt.Errorf("no duration for task %q", task)
+		}
 	}
 
-	order := ts.graph.TopologicalSortKahn()
-	if order == nil {
-		return nil
+	order, err := TopoSort(ts.dependencies)
+	if err != nil {
+		return 0, nil, err
 	}
 
-	result := make([]string, len(order))
-	for i, taskIndex := range order {
-		result[i] = ts.tasks[taskIndex]
+	// A task starts once its slowest dependency has finished
+	startTimes := make(map[string]int, len(order))
+	makespan := 0
+	for _, task := range order {
+		start := 0
+		for _, dependency := range ts.dependencies[task] {
+			start = max(start, startTimes[dependency]+durations[dependency])
+		}
+		startTimes[task] = start
+		makespan = max(makespan, start+durations[task])
 	}
+	return makespan, startTimes, nil
+}
 
-	return result
+// ParseTaskScheduler reads dependencies in a Makefile-like format, one
+// task per line:
+//
+//	# comments and blank lines are ignored
+//	test: compile-core compile-ui
+//	package: test docs
+//
+// A task may appear on several lines and its dependencies accumulate.
+// Names that only appear as dependencies become tasks without any, like
+// source files in a Makefile. Cycles are not rejected here; the ordering
+// methods report them.
+func ParseTaskScheduler(text string) (*TaskScheduler, error) {
+	ts := NewTaskScheduler(nil)
+	for number, line := range strings.Split(text, "\n") {
+		if comment := strings.IndexByte(line, '#'); comment >= 0 {
+			line = line[:comment]
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		task, deps, found := strings.Cut(line, ":")
+		task = strings.TrimSpace(task)
+		if !found {
+			return nil, fmt.Errorf("line %d: expected \"task: dependencies\", got %q", number+1, strings.TrimSpace(line))
+		}
+		if task == "" || strings.ContainsAny(task, " \t") {
+			return nil, fmt.Errorf("line %d: invalid task name %q", number+1, task)
+		}
+		ts.addTask(task)
+		for _, dependency := range strings.Fields(deps) {
+			if dependency == task {
+				return nil, fmt.Errorf("line %d: %q depends on itself", number+1, task)
+			}
+			ts.addTask(dependency)
+			if !slices.Contains(ts.dependencies[task], dependency) {
+				ts.AddDependency(dependency, task)
+			}
+		}
+	}
+	return ts, nil
+}
+
+// addTask registers a task with no dependencies if it is not known yet
+func (ts *TaskScheduler) addTask(task string) {
+	if _, known := ts.dependencies[task]; !known {
+		ts.dependencies[task] = nil
+	}
+}
+
+// Export writes the scheduler in the format ParseTaskScheduler reads, one
+// line per task, tasks in execution order (by name if there is a cycle)
+// and dependencies in the order they were added
+func (ts *TaskScheduler) Export() string {
+	order, err := TopoSort(ts.dependencies)
+	if err != nil {
+		order = sortedKeys(ts.dependencies)
+	}
+	var sb strings.Builder
+	for _, task := range order {
+		sb.WriteString(task + ":")
+		for _, dependency := range ts.dependencies[task] {
+			sb.WriteString(" " + dependency)
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
 }
 
 // ================================
@@ -266,10 +373,12 @@ func (ts *TaskScheduler) GetExecutionOrder() []string {
 // ================================
 
 func DemoTopologicalSort() {
-	fmt.Println("=== TOPOLOGICAL SORT EXPLANATION ===\n")
+	fmt.Println("=== TOPOLOGICAL SORT EXPLANATION ===")
+	fmt.Println()
 
 	fmt.Println("Topological Sort is a linear ordering of vertices in a Directed Acyclic Graph (DAG)")
-	fmt.Println("such that for every directed edge (u,v), vertex u comes before v in the ordering.\n")
+	fmt.Println("such that for every directed edge (u,v), vertex u comes before v in the ordering.")
+	fmt.Println()
 
 	// Example 1: Simple DAG
 	fmt.Println("=== EXAMPLE 1: Simple DAG ===")
@@ -348,7 +457,8 @@ func DemoTopologicalSort() {
 	fmt.Println("\nTrying topological sort on cyclic graph:")
 	cyclicResult := cyclicGraph.TopologicalSortKahn()
 	if cyclicResult == nil {
-		fmt.Println("Topological sort failed due to cycle detection.\n")
+		fmt.Println("Topological sort failed due to cycle detection.")
+		fmt.Println()
 	}
 
 	// Example 5: Complex DAG
@@ -373,6 +483,94 @@ func DemoTopologicalSort() {
 	fmt.Printf("\nTopological Sort (DFS):   %v\n", complexDFS)
 	fmt.Printf("Topological Sort (Kahn): %v\n", complexKahn)
 
+	// Example 6: Parallel build waves
+	fmt.Println("\n=== EXAMPLE 6: Parallel Build Waves ===")
+	buildTasks := []string{"fetch", "configure", "compile-core", "compile-ui", "test", "docs", "package"}
+	build := NewTaskScheduler(buildTasks)
+	build.AddDependency("fetch", "configure")
+	build.AddDependency("configure", "compile-core")
+	build.AddDependency("configure", "compile-ui")
+	build.AddDependency("compile-core", "test")
+	build.AddDependency("compile-ui", "test")
+	build.AddDependency("fetch", "docs")
+	build.AddDependency("test", "package")
+	build.AddDependency("docs", "package")
+
+	waves, err := build.GetExecutionLevels()
+	if err != nil {
+		fmt.Println(err)
+	}
+	for i, wave := range waves {
+		fmt.Printf("Wave %d: %v\n", i+1, wave)
+	}
+
+	durations := map[string]int{
+		"fetch": 2, "configure": 1, "compile-core": 8, "compile-ui": 5,
+		"test": 4, "docs": 3, "package": 1,
+	}
+	makespan, startTimes, err := build.Makespan(durations)
+	if err != nil {
+		fmt.Printf("Makespan error: %v\n", err)
+	} else {
+		sequential := 0
+		for _, task := range buildTasks {
+			sequential += durations[task]
+			fmt.Printf("  %-12s starts at t=%d (takes %d)\n", task, startTimes[task], durations[task])
+		}
+		fmt.Printf("Makespan with unlimited workers: %d (sequential: %d)\n", makespan, sequential)
+	}
+
+	// Example 7: Cycles between labeled nodes
+	fmt.Println("\n=== EXAMPLE 7: Reporting the Offending Cycle ===")
+	loop := NewCourseSchedule([]string{"Algebra", "Calculus", "Statistics", "Probability"})
+	loop.AddPrerequisite("Algebra", "Calculus")
+	loop.AddPrerequisite("Calculus", "Probability")
+	loop.AddPrerequisite("Probability", "Statistics")
+	loop.AddPrerequisite("Statistics", "Probability")
+	loop.GetOptimalOrder()
+
+	_, err = TopoSort(map[int][]int{1: {2}, 2: {3}, 3: {1}, 4: {1}})
+	var cycle *CycleError[int]
+	if errors.As(err, &cycle) {
+		fmt.Printf("Integer labels work too; cycle nodes: %v\n", cycle.Cycle)
+	}
+
+	// Example 8: A build graph read from text
+	fmt.Println("\n=== EXAMPLE 8: Build Graph from a Makefile-like File ===")
+	buildFile := `
+# release pipeline
+configure: fetch
+compile-core: configure
+compile-ui: configure
+test: compile-core compile-ui
+package: test docs   # docs only needs the sources
+docs: fetch
+test: lint
+`
+	parsed, err := ParseTaskScheduler(buildFile)
+	if err != nil {
+		fmt.Println("Parse error:", err)
+		return
+	}
+	fmt.Printf("Execution order: %v\n", parsed.GetExecutionOrder())
+	waves, err = parsed.GetExecutionLevels()
+	if err != nil {
+		fmt.Println(err)
+	}
+	for i, wave := range waves {
+		fmt.Printf("Wave %d: %v\n", i+1, wave)
+	}
+	exported := parsed.Export()
+	fmt.Print("Exported:\n" + exported)
+	reparsed, _ := ParseTaskScheduler(exported)
+	fmt.Printf("Export parses back to the same file: %v\n", reparsed.Export() == exported)
+	for _, bad := range []string{"configure fetch", "test: test", "compile core: configure"} {
+		_, err := ParseTaskScheduler(bad)
+		fmt.Println("Rejected:", err)
+	}
+	cyclic, _ := ParseTaskScheduler("a: b\nb: a")
+	cyclic.GetExecutionOrder()
+
 	fmt.Println("\n=== ALGORITHM COMPARISON ===")
 	fmt.Println("DFS-based Topological Sort:")
 	fmt.Println("- Uses recursion and stack")
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestTaskSchedulerWavesAndMakespan(t *testing.T) {
+	tests := []struct {
+		name      string
+		file      string
+		durations map[string]int
+		waves     [][]string
+		makespan  int
+		starts    map[string]int
+		cycle     bool
+	}{
+		{
+			name:      "diamond",
+			file:      "b: a\nc: a\nd: b c",
+			durations: map[string]int{"a": 2, "b": 3, "c": 5, "d": 1},
+			waves:     [][]string{{"a"}, {"b", "c"}, {"d"}},
+			makespan:  8,
+			starts:    map[string]int{"a": 0, "b": 2, "c": 2, "d": 7},
+		},
+		{
+			name:      "cycle",
+			file:      "a: c\nb: a\nc: b\nd: a",
+			durations: map[string]int{"a": 1, "b": 1, "c": 1, "d": 1},
+			cycle:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts, err := ParseTaskScheduler(tt.file)
+			if err != nil {
+				t.Fatalf("ParseTaskScheduler: %v", err)
+			}
+			waves, err := ts.GetExecutionLevels()
+			makespan, starts, makespanErr := ts.Makespan(tt.durations)
+			if tt.cycle {
+				var cycle *CycleError[string]
+				if !errors.As(err, &cycle) || waves != nil {
+					t.Errorf("GetExecutionLevels() = %v, %v; want a *CycleError", waves, err)
+				}
+				if !errors.As(makespanErr, &cycle) {
+					t.Errorf("Makespan error = %v, want a *CycleError", makespanErr)
+				}
+				return
+			}
+			if err != nil || !reflect.DeepEqual(waves, tt.waves) {
+				t.Errorf("GetExecutionLevels() = %v, %v; want %v", waves, err, tt.waves)
+			}
+			if makespanErr != nil || makespan != tt.makespan || !reflect.DeepEqual(starts, tt.starts) {
+				t.Errorf("Makespan() = %d, %v, %v; want %d, %v", makespan, starts, makespanErr, tt.makespan, tt.starts)
+			}
+		})
+	}
+}
+
+func TestTaskSchedulerMakespanMissingDuration(t *testing.T) {
+	ts, _ := ParseTaskScheduler("b: a")
+	if _, _, err := ts.Makespan(map[string]int{"b": 1}); err == nil {
+		t.Error("Makespan succeeded without a duration for a")
+	}
+}
+
+func TestTaskSchedulerExportRoundTrip(t *testing.T) {
+	file := `
+# release pipeline
+configure: fetch
+compile: configure
+test: compile lint   # lint is only a dependency
+package: test docs
+docs: fetch
+test: compile
+`
+	parsed, err := ParseTaskScheduler(file)
+	if err != nil {
+		t.Fatalf("ParseTaskScheduler: %v", err)
+	}
+	exported := parsed.Export()
+	reparsed, err := ParseTaskScheduler(exported)
+	if err != nil {
+		t.Fatalf("ParseTaskScheduler(Export()): %v\n%s", err, exported)
+	}
+	if !reflect.DeepEqual(reparsed.dependencies, parsed.dependencies) {
+		t.Errorf("round trip changed dependencies:\nbefore: %v\nafter:  %v", parsed.dependencies, reparsed.dependencies)
+	}
+	if again := reparsed.Export(); again != exported {
+		t.Errorf("second Export differs:\n%s\nwant:\n%s", again, exported)
+	}
+}
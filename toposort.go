@@ -0,0 +1,121 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ================================
+// TOPOLOGICAL SORT OVER LABELED NODES
+// ================================
+
+// CycleError reports a dependency cycle found by TopoSort. Cycle lists the
+// nodes in dependency order: each node depends on the one after it, and the
+// last depends on the first.
+type CycleError[T comparable] struct {
+	Cycle []T
+}
+
+func (e *CycleError[T]) Error() string {
+	parts := make([]string, 0, len(e.Cycle)+1)
+	for _, node := range e.Cycle {
+		parts = append(parts, fmt.Sprint(node))
+	}
+	parts = append(parts, fmt.Sprint(e.Cycle[0]))
+	return "dependency cycle: " + strings.Join(parts, " -> ")
+}
+
+// TopoSort orders the nodes of deps so that every node comes after the
+// nodes it depends on; deps[x] lists the dependencies of x. Nodes that only
+// appear as dependencies are included too. It runs Kahn's algorithm over
+// the labels directly, so callers need no index mapping. Nodes that become
+// ready together are taken in the order of their printed form, making the
+// result reproducible even though deps is a map. If the dependencies
+// contain a cycle, the error is a *CycleError holding one such cycle.
+// Time Complexity: O(V log V + E)
+// Space Complexity: O(V + E)
+func TopoSort[T comparable](deps map[T][]T) ([]T, error) {
+	dependents := make(map[T][]T)
+	waiting := make(map[T]int) // number of unfinished dependencies
+	for node, nodeDeps := range deps {
+		waiting[node] += len(nodeDeps)
+		for _, dep := range nodeDeps {
+			dependents[dep] = append(dependents[dep], node)
+			if _, known := waiting[dep]; !known {
+				waiting[dep] = 0
+			}
+		}
+	}
+
+	initial := []T{}
+	for node, count := range waiting {
+		if count == 0 {
+			initial = append(initial, node)
+		}
+	}
+	sortByLabel(initial)
+	ready := Queue[T]{}
+	for _, node := range initial {
+		ready.Enqueue(node)
+	}
+
+	order := make([]T, 0, len(waiting))
+	for ready.Len() > 0 {
+		node, _ := ready.Dequeue()
+		order = append(order, node)
+
+		unlocked := []T{}
+		for _, dependent := range dependents[node] {
+			if waiting[dependent]--; waiting[dependent] == 0 {
+				unlocked = append(unlocked, dependent)
+			}
+		}
+		sortByLabel(unlocked)
+		for _, dependent := range unlocked {
+			ready.Enqueue(dependent)
+		}
+	}
+
+	if len(order) == len(waiting) {
+		return order, nil
+	}
+	return nil, &CycleError[T]{Cycle: findDependencyCycle(deps, waiting)}
+}
+
+// sortByLabel orders nodes by their printed form
+func sortByLabel[T any](nodes []T) {
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return fmt.Sprint(nodes[i]) < fmt.Sprint(nodes[j])
+	})
+}
+
+// findDependencyCycle returns a cycle among the nodes Kahn's algorithm could
+// not finish. Each of them still waits on an unfinished dependency, so
+// following those dependencies from any of them must eventually revisit a
+// node, and the walk from that node's first visit is a cycle.
+func findDependencyCycle[T comparable](deps map[T][]T, waiting map[T]int) []T {
+	unfinished := []T{}
+	for node, count := range waiting {
+		if count > 0 {
+			unfinished = append(unfinished, node)
+		}
+	}
+	sortByLabel(unfinished)
+
+	path := []T{}
+	seenAt := map[T]int{}
+	for node := unfinished[0]; ; {
+		if i, seen := seenAt[node]; seen {
+			return path[i:]
+		}
+		seenAt[node] = len(path)
+		path = append(path, node)
+		for _, dep := range deps[node] {
+			if waiting[dep] > 0 {
+				node = dep
+				break
+			}
+		}
+	}
+}
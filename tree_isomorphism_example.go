@@ -0,0 +1,358 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ================================
+// TREE CANONICAL FORMS (AHU)
+// ================================
+
+// TreeCanonizer assigns every subtree shape a small integer id (the
+// Aho-Hopcroft-Ullman algorithm). Children are unordered: a node's id
+// depends only on the sorted ids of its children, so mirrored and
+// reshuffled trees get the same id. Ids come from one dictionary shared by
+// every tree given to the canonizer, which makes them comparable across
+// trees and, unlike a hash, free of collisions: equal ids mean isomorphic.
+type TreeCanonizer struct {
+	ids    map[string]int
+	values bool // whether node values are part of the shape
+}
+
+// NewTreeCanonizer creates a canonizer. With withValues, two subtrees only
+// match if their values match too, which finds repeated data rather than
+// repeated shape.
+func NewTreeCanonizer(withValues bool) *TreeCanonizer {
+	return &TreeCanonizer{ids: map[string]int{}, values: withValues}
+}
+
+// ID returns the canonical id of the tree rooted at root; the empty tree
+// has id 0
+// Time Complexity: O(n log n) for the sorting of child ids
+func (c *TreeCanonizer) ID(root *TreeNode) int {
+	return c.label(root, func(*TreeNode, int, int) {})
+}
+
+// label computes ids bottom-up and calls visit with each node, its id and
+// its subtree size
+func (c *TreeCanonizer) label(node *TreeNode, visit func(node *TreeNode, id, size int)) int {
+	id, _ := c.labelSized(node, visit)
+	return id
+}
+
+func (c *TreeCanonizer) labelSized(node *TreeNode, visit func(node *TreeNode, id, size int)) (int, int) {
+	if node == nil {
+		return 0, 0
+	}
+	childIDs, size := []int{}, 1
+	for _, child := range []*TreeNode{node.Left, node.Right} {
+		if child != nil {
+			id, childSize := c.labelSized(child, visit)
+			childIDs, size = append(childIDs, id), size+childSize
+		}
+	}
+	id := c.intern(node.Val, childIDs)
+	visit(node, id, size)
+	return id, size
+}
+
+// intern returns the id for a node with the given value and child ids,
+// creating a new one for a shape not seen before
+func (c *TreeCanonizer) intern(val int, childIDs []int) int {
+	slices.Sort(childIDs)
+	var key strings.Builder
+	if c.values {
+		key.WriteString(strconv.Itoa(val))
+	}
+	for _, id := range childIDs {
+		key.WriteByte(',')
+		key.WriteString(strconv.Itoa(id))
+	}
+	id, exists := c.ids[key.String()]
+	if !exists {
+		id = len(c.ids) + 1 // 0 is the empty tree
+		c.ids[key.String()] = id
+	}
+	return id
+}
+
+// AreIsomorphic reports whether t1 can be turned into t2 by swapping the
+// children of some nodes, ignoring node values
+// Time Complexity: O(n log n)
+func AreIsomorphic(t1, t2 *TreeNode) bool {
+	c := NewTreeCanonizer(false)
+	return c.ID(t1) == c.ID(t2)
+}
+
+// CanonicalForm returns the AHU string of the tree's shape: every node is
+// a pair of parentheses around its children's strings in sorted order.
+// Readable where ids are compact: isomorphic trees give the same string.
+// Time Complexity: O(n²) in the worst case, for the string copying
+func CanonicalForm(root *TreeNode) string {
+	if root == nil {
+		return ""
+	}
+	children := []string{}
+	for _, child := range []*TreeNode{root.Left, root.Right} {
+		if child != nil {
+			children = append(children, CanonicalForm(child))
+		}
+	}
+	slices.Sort(children)
+	return "(" + strings.Join(children, "") + ")"
+}
+
+// FindIsomorphicSubtrees groups the subtrees of root that share a shape
+// (and values, with withValues), keeping groups of at least two subtrees
+// of more than one node; single leaves would match everywhere. Larger
+// subtrees come first and nodes within a group are in preorder.
+// Time Complexity: O(n log n)
+func FindIsomorphicSubtrees(root *TreeNode, withValues bool) [][]*TreeNode {
+	c := NewTreeCanonizer(withValues)
+	groups, sizes := map[int][]*TreeNode{}, map[int]int{}
+	order := map[*TreeNode]int{} // preorder position
+	var number func(node *TreeNode)
+	number = func(node *TreeNode) {
+		if node != nil {
+			order[node] = len(order)
+			number(node.Left)
+			number(node.Right)
+		}
+	}
+	number(root)
+	c.label(root, func(node *TreeNode, id, size int) {
+		groups[id], sizes[id] = append(groups[id], node), size
+	})
+
+	repeated := []int{}
+	for id, nodes := range groups {
+		if len(nodes) >= 2 && sizes[id] > 1 {
+			slices.SortFunc(nodes, func(a, b *TreeNode) int { return order[a] - order[b] })
+			repeated = append(repeated, id)
+		}
+	}
+	slices.SortFunc(repeated, func(a, b int) int {
+		if sizes[a] != sizes[b] {
+			return sizes[b] - sizes[a]
+		}
+		return order[groups[a][0]] - order[groups[b][0]]
+	})
+	result := make([][]*TreeNode, len(repeated))
+	for i, id := range repeated {
+		result[i] = groups[id]
+	}
+	return result
+}
+
+// ================================
+// FREE TREE ISOMORPHISM
+// ================================
+
+// treeCenters returns the one or two middle vertices of a longest path,
+// found by peeling leaves layer by layer. Any isomorphism maps centers to
+// centers, which turns an unrooted tree into a rooted one.
+func treeCenters(adj [][]int) []int {
+	n := len(adj)
+	degree := make([]int, n)
+	leaves := []int{}
+	for v := range adj {
+		if degree[v] = len(adj[v]); degree[v] <= 1 {
+			leaves = append(leaves, v)
+		}
+	}
+	for remaining := n; remaining > 2; {
+		remaining -= len(leaves)
+		next := []int{}
+		for _, leaf := range leaves {
+			for _, neighbor := range adj[leaf] {
+				if degree[neighbor]--; degree[neighbor] == 1 {
+					next = append(next, neighbor)
+				}
+			}
+		}
+		leaves = next
+	}
+	return leaves
+}
+
+// rootedID computes the AHU id of the tree rooted at v, reached from parent
+func (c *TreeCanonizer) rootedID(adj [][]int, v, parent int) int {
+	childIDs := []int{}
+	for _, neighbor := range adj[v] {
+		if neighbor != parent {
+			childIDs = append(childIDs, c.rootedID(adj, neighbor, v))
+		}
+	}
+	return c.intern(0, childIDs)
+}
+
+// FreeTreesIsomorphic reports whether two unrooted trees on n vertices,
+// given as edge lists, are isomorphic: the graph isomorphism problem,
+// which has no known polynomial algorithm in general, restricted to trees
+// where it is easy. Root both trees at their centers and compare AHU ids;
+// with two centers, either one may map to the first center of the other.
+// Time Complexity: O(n log n)
+func FreeTreesIsomorphic(n int, edges1, edges2 [][2]int) (bool, error) {
+	adjs := [2][][]int{}
+	for i, edges := range [][][2]int{edges1, edges2} {
+		if len(edges) != n-1 {
+			return false, fmt.Errorf("a tree on %d vertices has %d edges, got %d", n, n-1, len(edges))
+		}
+		adjs[i] = make([][]int, n)
+		uf := NewUnionFind(n)
+		for _, edge := range edges {
+			u, v := edge[0], edge[1]
+			if u < 0 || u >= n || v < 0 || v >= n {
+				return false, fmt.Errorf("edge (%d, %d) out of range [0, %d)", u, v, n)
+			}
+			if !uf.Union(u, v) {
+				return false, fmt.Errorf("edge (%d, %d) closes a cycle", u, v)
+			}
+			adjs[i][u] = append(adjs[i][u], v)
+			adjs[i][v] = append(adjs[i][v], u)
+		}
+	}
+	if n <= 1 {
+		return true, nil
+	}
+
+	c := NewTreeCanonizer(false)
+	centers1, centers2 := treeCenters(adjs[0]), treeCenters(adjs[1])
+	if len(centers1) != len(centers2) {
+		return false, nil
+	}
+	id1 := c.rootedID(adjs[0], centers1[0], -1)
+	for _, center := range centers2 {
+		if c.rootedID(adjs[1], center, -1) == id1 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// treeFromLevels builds a binary tree from level-order values, with -1
+// marking a missing child
+func treeFromLevels(values []int) *TreeNode {
+	if len(values) == 0 || values[0] == -1 {
+		return nil
+	}
+	root := &TreeNode{Val: values[0]}
+	queue := Queue[*TreeNode]{}
+	queue.Enqueue(root)
+	for i := 1; i < len(values); i += 2 {
+		node, ok := queue.Dequeue()
+		if !ok {
+			break // values left over after the last node
+		}
+		for j, child := range []**TreeNode{&node.Left, &node.Right} {
+			if i+j < len(values) && values[i+j] != -1 {
+				*child = &TreeNode{Val: values[i+j]}
+				queue.Enqueue(*child)
+			}
+		}
+	}
+	return root
+}
+
+// DemoTreeIsomorphism demonstrates AHU canonical ids on binary trees and
+// isomorphism of unrooted trees
+func DemoTreeIsomorphism() {
+	fmt.Println("=== TREE ISOMORPHISM (AHU CANONICAL FORMS) ===")
+	fmt.Println()
+
+	// Example 1: Same shape up to child swaps
+	fmt.Println("=== EXAMPLE 1: Isomorphic Binary Trees ===")
+	a := treeFromLevels([]int{1, 2, 3, 4, 5, -1, 6})
+	b := treeFromLevels([]int{7, 8, 9, 10, -1, 11, 12})
+	d := treeFromLevels([]int{1, 2, 3, 4, 5, 6, 7})
+	for _, pair := range []struct {
+		name   string
+		t1, t2 *TreeNode
+	}{{"a, b", a, b}, {"a, d", a, d}} {
+		fmt.Printf("%s: %s vs %s, isomorphic: %v\n", pair.name, CanonicalForm(pair.t1), CanonicalForm(pair.t2), AreIsomorphic(pair.t1, pair.t2))
+	}
+	fmt.Println("a:     1          b:     7")
+	fmt.Println("      / \\               / \\")
+	fmt.Println("     2   3             8   9")
+	fmt.Println("    / \\   \\           /   / \\")
+	fmt.Println("   4   5   6         10  11  12")
+	fmt.Println("b is a with the children of 1 and of 3 swapped; values play no part.")
+	fmt.Println()
+
+	// Example 2: Repeated subtrees
+	fmt.Println("=== EXAMPLE 2: Repeated Subtrees ===")
+	tree := treeFromLevels([]int{1, 2, 3, 4, 5, 2, 5, 6, -1, -1, -1, 4, 5, 9, -1, -1, -1, 6})
+	for _, withValues := range []bool{false, true} {
+		fmt.Printf("With values: %v\n", withValues)
+		for _, group := range FindIsomorphicSubtrees(tree, withValues) {
+			roots := []int{}
+			for _, node := range group {
+				roots = append(roots, node.Val)
+			}
+			fmt.Printf("  shape %-10s rooted at values %v\n", CanonicalForm(group[0]), roots)
+		}
+	}
+	fmt.Println("Both copies of 2 -> (4 -> 6, 5) are found either way; by shape alone the")
+	fmt.Println("chain 5 -> 9 also matches the two chains 4 -> 6.")
+	fmt.Println()
+
+	// Example 3: Unrooted trees
+	fmt.Println("=== EXAMPLE 3: Unrooted Trees ===")
+	path := [][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 4}}
+	star := [][2]int{{0, 1}, {0, 2}, {0, 3}, {0, 4}}
+	relabeledPath := [][2]int{{3, 0}, {4, 2}, {0, 1}, {2, 3}}
+	for _, test := range []struct {
+		name   string
+		e1, e2 [][2]int
+	}{{"path vs star", path, star}, {"path vs relabeled path", path, relabeledPath}} {
+		same, _ := FreeTreesIsomorphic(5, test.e1, test.e2)
+		fmt.Printf("%-24s %v\n", test.name, same)
+	}
+	_, err := FreeTreesIsomorphic(5, path, path[:3])
+	fmt.Println("Missing an edge:", err)
+	_, err = FreeTreesIsomorphic(5, path, [][2]int{{0, 1}, {1, 2}, {2, 0}, {3, 4}})
+	fmt.Println("A cycle instead:", err)
+
+	rng := rand.New(rand.NewSource(1059))
+	const n, trials = 200, 200
+	relabeledMatches, differentMatches := 0, 0
+	for trial := 0; trial < trials; trial++ {
+		edges := make([][2]int, n-1)
+		for v := 1; v < n; v++ {
+			edges[v-1] = [2]int{rng.Intn(v), v} // random recursive tree
+		}
+		perm := rng.Perm(n)
+		relabeled := make([][2]int, n-1)
+		for i, edge := range edges {
+			relabeled[i] = [2]int{perm[edge[1]], perm[edge[0]]}
+		}
+		rng.Shuffle(len(relabeled), func(i, j int) { relabeled[i], relabeled[j] = relabeled[j], relabeled[i] })
+		if same, _ := FreeTreesIsomorphic(n, edges, relabeled); same {
+			relabeledMatches++
+		}
+		moved := slices.Clone(edges) // reattach one leaf elsewhere
+		moved[n-2] = [2]int{rng.Intn(n - 1), n - 1}
+		if same, _ := FreeTreesIsomorphic(n, edges, moved); same {
+			differentMatches++
+		}
+	}
+	fmt.Printf("%d random %d-vertex trees: %d/%d match after relabeling, %d/%d still match\n",
+		trials, n, relabeledMatches, trials, differentMatches, trials)
+	fmt.Println("after moving one leaf (moving it to an equivalent spot keeps the shape).")
+	fmt.Println()
+
+	fmt.Println("Comparing values node by node cannot see that two trees differ only")
+	fmt.Println("by the order of children. AHU names every shape bottom-up from its")
+	fmt.Println("sorted children's names, so equal names mean equal shapes, and one")
+	fmt.Println("shared dictionary also finds every repeated subtree in a single pass.")
+	fmt.Println("Rooting at the center extends this to unrooted trees.")
+	fmt.Println()
+}
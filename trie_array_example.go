@@ -0,0 +1,247 @@
+package main
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// ================================
+// ARRAY-CHILDREN TRIE
+// ================================
+
+// TrieAlphabet selects the characters an ArrayTrie accepts, and so the
+// length of every node's child array
+type TrieAlphabet int
+
+const (
+	LowercaseAlphabet TrieAlphabet = iota // 'a'..'z', 26 children per node
+	ASCIIAlphabet                         // 0..127, 128 children per node
+)
+
+// size returns the number of child slots per node
+func (a TrieAlphabet) size() int {
+	if a == LowercaseAlphabet {
+		return 26
+	}
+	return 128
+}
+
+// index maps a character to its child slot, or -1 if it is outside the
+// alphabet
+func (a TrieAlphabet) index(char rune) int {
+	switch {
+	case a == LowercaseAlphabet && char >= 'a' && char <= 'z':
+		return int(char - 'a')
+	case a == ASCIIAlphabet && char >= 0 && char < 128:
+		return int(char)
+	}
+	return -1
+}
+
+func (a TrieAlphabet) String() string {
+	if a == LowercaseAlphabet {
+		return "lowercase a-z"
+	}
+	return "ASCII"
+}
+
+// arrayTrieNode is a TrieNode whose children live in a fixed-length array
+// indexed by character, instead of a map
+type arrayTrieNode struct {
+	children []*arrayTrieNode // length alphabet.size(), nil for absent children
+	isEnd    bool
+	count    int
+}
+
+// ArrayTrie is a Trie over a small fixed alphabet. Following a child is a
+// single array index rather than a map lookup, at the cost of storing a
+// slot for every possible character in every node.
+type ArrayTrie struct {
+	alphabet TrieAlphabet
+	root     *arrayTrieNode
+	size     int
+}
+
+// NewArrayTrie creates an empty trie over alphabet
+func NewArrayTrie(alphabet TrieAlphabet) *ArrayTrie {
+	t := &ArrayTrie{alphabet: alphabet}
+	t.root = t.newNode()
+	return t
+}
+
+func (t *ArrayTrie) newNode() *arrayTrieNode {
+	return &arrayTrieNode{children: make([]*arrayTrieNode, t.alphabet.size())}
+}
+
+// Insert adds word, counting repeats like Trie.InsertSimple. Words with
+// characters outside the alphabet are rejected before anything is added.
+// Time Complexity: O(L) for a word of length L
+func (t *ArrayTrie) Insert(word string) error {
+	for _, char := range word {
+		if t.alphabet.index(char) < 0 {
+			return fmt.Errorf("%q contains %q, which is outside the %v alphabet", word, char, t.alphabet)
+		}
+	}
+	current := t.root
+	for _, char := range word {
+		i := t.alphabet.index(char)
+		if current.children[i] == nil {
+			current.children[i] = t.newNode()
+		}
+		current = current.children[i]
+	}
+	if !current.isEnd {
+		current.isEnd = true
+		t.size++
+	}
+	current.count++
+	return nil
+}
+
+// find returns the node reached by key, or nil
+func (t *ArrayTrie) find(key string) *arrayTrieNode {
+	current := t.root
+	for _, char := range key {
+		i := t.alphabet.index(char)
+		if i < 0 || current.children[i] == nil {
+			return nil
+		}
+		current = current.children[i]
+	}
+	return current
+}
+
+// Search reports whether word was inserted
+// Time Complexity: O(L)
+func (t *ArrayTrie) Search(word string) bool {
+	node := t.find(word)
+	return node != nil && node.isEnd
+}
+
+// StartsWith reports whether any word begins with prefix
+// Time Complexity: O(L)
+func (t *ArrayTrie) StartsWith(prefix string) bool {
+	return t.find(prefix) != nil
+}
+
+// Count returns how many times word was inserted
+func (t *ArrayTrie) Count(word string) int {
+	if node := t.find(word); node != nil {
+		return node.count
+	}
+	return 0
+}
+
+// Size returns the number of distinct words
+func (t *ArrayTrie) Size() int {
+	return t.size
+}
+
+// NodeCount returns the number of nodes, including the root
+func (t *ArrayTrie) NodeCount() int {
+	var count func(node *arrayTrieNode) int
+	count = func(node *arrayTrieNode) int {
+		total := 1
+		for _, child := range node.children {
+			if child != nil {
+				total += count(child)
+			}
+		}
+		return total
+	}
+	return count(t.root)
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoArrayTrie demonstrates the array-children trie and benchmarks it
+// against the map-based Trie
+func DemoArrayTrie() {
+	fmt.Println("=== ARRAY-CHILDREN TRIE ===")
+	fmt.Println()
+
+	// Example 1: Basic operations
+	fmt.Println("=== EXAMPLE 1: Insert, Search, Alphabet Checks ===")
+	trie := NewArrayTrie(LowercaseAlphabet)
+	for _, word := range []string{"tree", "trie", "try", "tree", "algo"} {
+		trie.Insert(word)
+	}
+	fmt.Printf("%d distinct words in %d nodes\n", trie.Size(), trie.NodeCount())
+	fmt.Printf("Search(trie)=%v Search(tr)=%v StartsWith(tr)=%v Count(tree)=%d\n",
+		trie.Search("trie"), trie.Search("tr"), trie.StartsWith("tr"), trie.Count("tree"))
+	if err := trie.Insert("Tree"); err != nil {
+		fmt.Printf("Insert(Tree): %v\n", err)
+	}
+	ascii := NewArrayTrie(ASCIIAlphabet)
+	fmt.Printf("The ASCII trie accepts it: Insert(Tree) error = %v\n", ascii.Insert("Tree"))
+	fmt.Println()
+
+	// Example 2: Benchmark
+	words := []string{}
+	for _, word := range docWords() {
+		if strings.Trim(word, "abcdefghijklmnopqrstuvwxyz") == "" {
+			words = append(words, word)
+		}
+	}
+	const rounds = 200
+	fmt.Printf("=== EXAMPLE 2: %d Lowercase Words from the Markdown Docs ===\n", len(words))
+	fmt.Printf("%-10s %8s %10s %14s %14s\n", "Children", "Nodes", "Heap", "Insert all", "Search all")
+
+	before := heapInUse()
+	start := time.Now()
+	mapTrie := NewTrie()
+	for _, word := range words {
+		mapTrie.InsertSimple(word)
+	}
+	insertTime := time.Since(start)
+	mapBytes := heapInUse() - before
+	start = time.Now()
+	for round := 0; round < rounds; round++ {
+		for _, word := range words {
+			mapTrie.SearchSimple(word)
+		}
+	}
+	fmt.Printf("%-10s %8d %7d KB %14v %14v\n", "map[rune]", trieNodeCount(mapTrie.root), mapBytes/1024,
+		insertTime.Round(time.Microsecond), (time.Since(start) / rounds).Round(time.Microsecond))
+	runtime.KeepAlive(mapTrie)
+
+	disagreements := 0
+	for _, alphabet := range []TrieAlphabet{LowercaseAlphabet, ASCIIAlphabet} {
+		before = heapInUse()
+		start = time.Now()
+		arrayTrie := NewArrayTrie(alphabet)
+		for _, word := range words {
+			arrayTrie.Insert(word)
+		}
+		insertTime = time.Since(start)
+		arrayBytes := heapInUse() - before
+		start = time.Now()
+		for round := 0; round < rounds; round++ {
+			for _, word := range words {
+				arrayTrie.Search(word)
+			}
+		}
+		fmt.Printf("%-10s %8d %7d KB %14v %14v\n", fmt.Sprintf("[%d]", alphabet.size()), arrayTrie.NodeCount(),
+			arrayBytes/1024, insertTime.Round(time.Microsecond), (time.Since(start) / rounds).Round(time.Microsecond))
+
+		for _, word := range words {
+			for _, probe := range []string{word, word + "s", word[:len(word)-1]} {
+				if mapTrie.SearchSimple(probe) != arrayTrie.Search(probe) {
+					disagreements++
+				}
+			}
+		}
+	}
+	fmt.Printf("Search disagreements with the map trie: %d\n", disagreements)
+	fmt.Println()
+
+	fmt.Println("Indexing an array is several times faster than hashing a rune, but each")
+	fmt.Println("node pays for every slot: most nodes have one or two children, so even")
+	fmt.Println("[26] outweighs the small maps, and [128] spends 1 KB per node, nearly all")
+	fmt.Println("of it nil. Inserting into [128] is slow for the same reason: allocation.")
+	fmt.Println()
+}
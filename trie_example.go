@@ -2,7 +2,12 @@ package main
 
 import (
 	"fmt"
-	"strings"
+	"math/rand"
+	"runtime"
+	"sort"
+	"time"
+	"unicode/utf8"
+	"unsafe"
 )
 
 // ================================
@@ -14,6 +19,10 @@ type TrieNode struct {
 	children map[rune]*TrieNode // Map of character to child node
 	isEnd    bool               // Marks end of a word
 	count    int                // Number of words ending at this node
+
+	// prefixCount is the number of words (with repeats) ending at or
+	// below this node, kept up to date by insert and delete
+	prefixCount int
 }
 
 // NewTrieNode creates a new Trie node
@@ -48,6 +57,7 @@ func (t *Trie) Insert(word string) {
 	fmt.Printf("=== INSERTING WORD: '%s' ===\n", word)
 
 	current := t.root
+	current.prefixCount++
 	fmt.Printf("Starting at root node\n")
 
 	for i, char := range word {
@@ -61,6 +71,7 @@ func (t *Trie) Insert(word string) {
 		}
 
 		current = current.children[char]
+		current.prefixCount++
 		fmt.Printf("  Moved to node for character '%c'\n", char)
 	}
 
@@ -81,12 +92,14 @@ func (t *Trie) Insert(word string) {
 // InsertSimple adds a word to the Trie without tracing
 func (t *Trie) InsertSimple(word string) {
 	current := t.root
+	current.prefixCount++
 
 	for _, char := range word {
 		if current.children[char] == nil {
 			current.children[char] = NewTrieNode()
 		}
 		current = current.children[char]
+		current.prefixCount++
 	}
 
 	if !current.isEnd {
@@ -189,22 +202,90 @@ func (t *Trie) GetWordsWithPrefix(prefix string) []string {
 
 	// Collect all words starting from this node
 	var words []string
-	t.collectWords(current, prefix, &words)
+	t.collectWords(current, []byte(prefix), &words)
 
 	fmt.Printf("Found %d words with prefix '%s': %v\n\n", len(words), prefix, words)
 	return words
 }
 
-// collectWords is a helper function for DFS traversal
-func (t *Trie) collectWords(node *TrieNode, currentWord string, words *[]string) {
+// WordsWithPrefix returns the words starting with prefix in sorted order,
+// without tracing
+// Time Complexity: O(P + k) for prefix length P and k nodes below it
+func (t *Trie) WordsWithPrefix(prefix string) []string {
+	words := []string{}
+	current := t.root
+	for _, char := range prefix {
+		if current = current.children[char]; current == nil {
+			return words
+		}
+	}
+	t.collectWords(current, []byte(prefix), &words)
+	return words
+}
+
+// CountWordsWithPrefix returns how many words start with prefix, counting
+// repeated insertions as GetWordsWithPrefix lists them. It reads the
+// prefix count of the prefix's node instead of visiting the words.
+// Time Complexity: O(P) for prefix length P
+func (t *Trie) CountWordsWithPrefix(prefix string) int {
+	current := t.root
+	for _, char := range prefix {
+		if current = current.children[char]; current == nil {
+			return 0
+		}
+	}
+	return current.prefixCount
+}
+
+// LongestCommonPrefix returns the longest prefix shared by every word in
+// the Trie: the path from the root down to the first node that branches
+// or ends a word
+// Time Complexity: O(shortest word)
+func (t *Trie) LongestCommonPrefix() string {
+	var prefix []rune
+	current := t.root
+	for t.size > 0 && !current.isEnd && len(current.children) == 1 {
+		for char, child := range current.children {
+			prefix = append(prefix, char)
+			current = child
+		}
+	}
+	return string(prefix)
+}
+
+// LongestPrefixOf returns the longest word in the Trie that is a prefix of
+// query, as a routing table matches an address against its routes
+// Time Complexity: O(len(query))
+func (t *Trie) LongestPrefixOf(query string) (string, bool) {
+	current := t.root
+	bestLength, found := 0, current.isEnd
+	for i := 0; i < len(query); {
+		char, width := utf8.DecodeRuneInString(query[i:])
+		if current = current.children[char]; current == nil {
+			break
+		}
+		i += width
+		if current.isEnd {
+			bestLength, found = i, true
+		}
+	}
+	return query[:bestLength], found
+}
+
+// collectWords is a helper function for DFS traversal.
+// Children are visited in rune order so words come out lexicographically sorted.
+// The current word is built in one shared byte buffer that grows and
+// shrinks with the recursion, so only complete words allocate a string.
+func (t *Trie) collectWords(node *TrieNode, currentWord []byte, words *[]string) {
 	if node.isEnd {
+		word := string(currentWord)
 		for i := 0; i < node.count; i++ {
-			*words = append(*words, currentWord)
+			*words = append(*words, word)
 		}
 	}
 
-	for char, child := range node.children {
-		t.collectWords(child, currentWord+string(char), words)
+	for _, char := range sortedKeys(node.children) {
+		t.collectWords(node.children[char], utf8.AppendRune(currentWord, char), words)
 	}
 }
 
@@ -212,12 +293,35 @@ func (t *Trie) collectWords(node *TrieNode, currentWord string, words *[]string)
 func (t *Trie) Delete(word string) bool {
 	fmt.Printf("=== DELETING WORD: '%s' ===\n", word)
 
-	return t.deleteHelper(t.root, word, 0)
+	if path := t.wordPath(word); path != nil {
+		for _, node := range path {
+			node.prefixCount--
+		}
+	}
+	return t.deleteHelper(t.root, word, []rune(word), 0)
 }
 
-// deleteHelper is a recursive helper for deletion
-func (t *Trie) deleteHelper(node *TrieNode, word string, index int) bool {
-	if index == len(word) {
+// wordPath returns the nodes from the root to the end of word, or nil if
+// word is not stored
+func (t *Trie) wordPath(word string) []*TrieNode {
+	path := []*TrieNode{t.root}
+	for _, char := range word {
+		next := path[len(path)-1].children[char]
+		if next == nil {
+			return nil
+		}
+		path = append(path, next)
+	}
+	if !path[len(path)-1].isEnd {
+		return nil
+	}
+	return path
+}
+
+// deleteHelper is a recursive helper for deletion; word is chars as a string,
+// converted once by the caller for the messages
+func (t *Trie) deleteHelper(node *TrieNode, word string, chars []rune, index int) bool {
+	if index == len(chars) {
 		// Reached end of word
 		if !node.isEnd {
 			fmt.Printf("Word '%s' not found in Trie\n\n", word)
@@ -239,7 +343,7 @@ func (t *Trie) deleteHelper(node *TrieNode, word string, index int) bool {
 		return len(node.children) == 0
 	}
 
-	char := rune(word[index])
+	char := chars[index]
 	child := node.children[char]
 
 	if child == nil {
@@ -247,7 +351,7 @@ func (t *Trie) deleteHelper(node *TrieNode, word string, index int) bool {
 		return false
 	}
 
-	shouldDeleteChild := t.deleteHelper(child, word, index+1)
+	shouldDeleteChild := t.deleteHelper(child, word, chars, index+1)
 
 	if shouldDeleteChild {
 		delete(node.children, char)
@@ -258,6 +362,90 @@ func (t *Trie) deleteHelper(node *TrieNode, word string, index int) bool {
 	return false
 }
 
+// DeleteSimple removes one occurrence of a word without tracing and prunes
+// nodes that no longer lead to any word. Returns false if the word was absent.
+func (t *Trie) DeleteSimple(word string) bool {
+	path := t.wordPath(word)
+	if path == nil {
+		return false
+	}
+	for _, node := range path {
+		node.prefixCount--
+	}
+
+	last := path[len(path)-1]
+	chars := []rune(word)
+	if last.count > 1 {
+		last.count--
+		return true
+	}
+	last.isEnd = false
+	last.count = 0
+	t.size--
+
+	// Remove now-empty nodes from the bottom up
+	for i := len(chars) - 1; i >= 0; i-- {
+		node := path[i+1]
+		if node.isEnd || len(node.children) > 0 {
+			break
+		}
+		delete(path[i].children, chars[i])
+	}
+	return true
+}
+
+// DeletePrefix removes every word starting with prefix by unlinking the
+// prefix's subtree from its parent, and returns how many words were
+// removed, counting repeats as CountWordsWithPrefix does. Ancestors left
+// without words are pruned like in DeleteSimple.
+// Time Complexity: O(P + k) for the k nodes removed, which are only
+// counted to keep Size exact, not deleted one by one
+func (t *Trie) DeletePrefix(prefix string) int {
+	path := []*TrieNode{t.root}
+	chars := []rune(prefix)
+	for _, char := range chars {
+		next := path[len(path)-1].children[char]
+		if next == nil {
+			return 0
+		}
+		path = append(path, next)
+	}
+
+	subtree := path[len(path)-1]
+	removed := subtree.prefixCount
+	t.size -= countWordNodes(subtree)
+	if len(chars) == 0 {
+		t.root = NewTrieNode()
+		return removed
+	}
+	for _, node := range path[:len(path)-1] {
+		node.prefixCount -= removed
+	}
+	delete(path[len(path)-2].children, chars[len(chars)-1])
+
+	// Remove ancestors that no longer lead to any word
+	for i := len(chars) - 2; i >= 0; i-- {
+		node := path[i+1]
+		if node.isEnd || len(node.children) > 0 {
+			break
+		}
+		delete(path[i].children, chars[i])
+	}
+	return removed
+}
+
+// countWordNodes returns the number of distinct words ending at or below node
+func countWordNodes(node *TrieNode) int {
+	words := 0
+	if node.isEnd {
+		words++
+	}
+	for _, child := range node.children {
+		words += countWordNodes(child)
+	}
+	return words
+}
+
 // ================================
 // VISUALIZATION AND UTILITY
 // ================================
@@ -277,10 +465,7 @@ func (t *Trie) printTrieHelper(node *TrieNode, prefix, indent string) {
 		fmt.Printf("%s'%s' (count: %d) ✓\n", indent, prefix, node.count)
 	}
 
-	chars := make([]rune, 0, len(node.children))
-	for char := range node.children {
-		chars = append(chars, char)
-	}
+	chars := sortedKeys(node.children)
 
 	for i, char := range chars {
 		isLast := i == len(chars)-1
@@ -299,7 +484,7 @@ func (t *Trie) printTrieHelper(node *TrieNode, prefix, indent string) {
 // GetAllWords returns all words in the Trie
 func (t *Trie) GetAllWords() []string {
 	var words []string
-	t.collectWords(t.root, "", &words)
+	t.collectWords(t.root, nil, &words)
 	return words
 }
 
@@ -313,6 +498,216 @@ func (t *Trie) IsEmpty() bool {
 	return t.size == 0
 }
 
+// ================================
+// STATISTICS
+// ================================
+
+// TrieStats describes the shape and approximate memory use of a Trie
+type TrieStats struct {
+	Nodes          int     // including the root
+	Edges          int     // parent-child links, always Nodes-1
+	Words          int     // distinct words
+	Leaves         int     // nodes without children
+	MaxDepth       int     // characters in the longest word
+	AvgBranching   float64 // children per node that has any
+	EstimatedBytes int     // node structs plus child maps
+}
+
+// Approximate sizes of Go's map layout: a header, then groups of 8 slots
+// with one control byte each. A group holding rune keys and pointer values
+// is 8 + 8*(4+4+8) bytes, counting the padding after each key.
+const (
+	mapHeaderBytes = 48
+	mapGroupSlots  = 8
+	mapGroupBytes  = 8 + mapGroupSlots*16
+)
+
+// estimatedMapBytes estimates the heap used by a map with n entries. A
+// small map is a single group that may fill up; larger ones keep their
+// groups at most 7/8 full, with a power-of-two number of groups.
+func estimatedMapBytes(n int) int {
+	switch {
+	case n == 0:
+		return mapHeaderBytes
+	case n <= mapGroupSlots:
+		return mapHeaderBytes + mapGroupBytes
+	}
+	groups := 1
+	for groups*mapGroupSlots*7/8 < n {
+		groups *= 2
+	}
+	return mapHeaderBytes + groups*mapGroupBytes
+}
+
+// Stats walks the whole trie and reports its shape. EstimatedBytes is a
+// model of the runtime's layout, not a measurement; DemoTrieComplexity
+// compares it with the heap.
+// Time Complexity: O(nodes)
+func (t *Trie) Stats() TrieStats {
+	stats := TrieStats{Words: t.size}
+	branching := 0
+	var walk func(node *TrieNode, depth int)
+	walk = func(node *TrieNode, depth int) {
+		stats.Nodes++
+		stats.Edges += len(node.children)
+		stats.EstimatedBytes += int(unsafe.Sizeof(*node)) + estimatedMapBytes(len(node.children))
+		if node.isEnd {
+			stats.MaxDepth = max(stats.MaxDepth, depth)
+		}
+		if len(node.children) == 0 {
+			stats.Leaves++
+			return
+		}
+		branching++
+		for _, child := range node.children {
+			walk(child, depth+1)
+		}
+	}
+	walk(t.root, 0)
+	if branching > 0 {
+		stats.AvgBranching = float64(stats.Edges) / float64(branching)
+	}
+	return stats
+}
+
+// ================================
+// LEXICOGRAPHIC ITERATOR
+// ================================
+
+// trieIterFrame is one node on the iterator's explicit DFS stack
+type trieIterFrame struct {
+	node    *TrieNode
+	keys    []rune // children in rune order
+	next    int    // next child to descend into
+	length  int    // bytes of the word buffer that spell this node
+	visited bool   // whether the node's own word has been considered
+}
+
+// TrieIterator walks the words of a Trie in sorted order, one at a time.
+// It keeps only the path to the current word, so stopping after the first
+// few matches costs nothing for the rest of the dictionary.
+//
+//	it := trie.Iter("pre")
+//	for it.Next() {
+//		fmt.Println(it.Word(), it.Count())
+//	}
+type TrieIterator struct {
+	stack []trieIterFrame
+	word  []byte
+	count int
+}
+
+// Iter returns an iterator over the words starting with prefix. The trie
+// must not be modified while the iterator is in use.
+func (t *Trie) Iter(prefix string) *TrieIterator {
+	it := &TrieIterator{word: []byte(prefix)}
+	current := t.root
+	for _, char := range prefix {
+		if current = current.children[char]; current == nil {
+			return it
+		}
+	}
+	it.push(current)
+	return it
+}
+
+func (it *TrieIterator) push(node *TrieNode) {
+	it.stack = append(it.stack, trieIterFrame{node: node, keys: sortedKeys(node.children), length: len(it.word)})
+}
+
+// Next advances to the next word and reports whether there is one
+// Time Complexity: O(nodes between this word and the next) amortized
+func (it *TrieIterator) Next() bool {
+	for len(it.stack) > 0 {
+		top := &it.stack[len(it.stack)-1]
+		if !top.visited {
+			top.visited = true
+			if top.node.isEnd {
+				it.word, it.count = it.word[:top.length], top.node.count
+				return true
+			}
+		}
+		if top.next < len(top.keys) {
+			char := top.keys[top.next]
+			top.next++
+			it.word = utf8.AppendRune(it.word[:top.length], char)
+			it.push(top.node.children[char])
+			continue
+		}
+		it.stack = it.stack[:len(it.stack)-1]
+	}
+	return false
+}
+
+// Word returns the current word
+func (it *TrieIterator) Word() string {
+	return string(it.word)
+}
+
+// Count returns how many times the current word was inserted; unlike
+// GetAllWords, the iterator yields a repeated word once
+func (it *TrieIterator) Count() int {
+	return it.count
+}
+
+// ================================
+// FUZZY SEARCH
+// ================================
+
+// FuzzySearch returns the words within Levenshtein distance maxDist of word
+// (insertions, deletions and substitutions), closest first and
+// alphabetically among equals. It walks the trie depth-first carrying one
+// row of the edit-distance table: a child's row extends its parent's by the
+// child's character, so words sharing a prefix share the work, and a
+// branch is abandoned once every entry of its row exceeds maxDist.
+// Time Complexity: O(visited nodes * len(word))
+func (t *Trie) FuzzySearch(word string, maxDist int) []string {
+	target := []rune(word)
+	type match struct {
+		word     string
+		distance int
+	}
+	matches := []match{}
+
+	// row[i] = edit distance between the current prefix and target[:i]
+	var walk func(node *TrieNode, prefix []byte, row []int)
+	walk = func(node *TrieNode, prefix []byte, row []int) {
+		if node.isEnd && row[len(target)] <= maxDist {
+			matches = append(matches, match{string(prefix), row[len(target)]})
+		}
+		for _, char := range sortedKeys(node.children) {
+			next := make([]int, len(target)+1)
+			next[0] = row[0] + 1
+			closest := next[0]
+			for i := 1; i <= len(target); i++ {
+				substitution := row[i-1]
+				if target[i-1] != char {
+					substitution++
+				}
+				next[i] = min(next[i-1]+1, row[i]+1, substitution)
+				closest = min(closest, next[i])
+			}
+			if closest <= maxDist {
+				walk(node.children[char], utf8.AppendRune(prefix, char), next)
+			}
+		}
+	}
+	first := make([]int, len(target)+1)
+	for i := range first {
+		first[i] = i
+	}
+	walk(t.root, nil, first)
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].distance < matches[j].distance
+	})
+	words := make([]string, len(matches))
+	for i, m := range matches {
+		words[i] = m.word
+	}
+	return words
+}
+
 // ================================
 // ADVANCED APPLICATIONS
 // ================================
@@ -321,24 +716,32 @@ func (t *Trie) IsEmpty() bool {
 type AutoComplete struct {
 	trie           *Trie
 	maxSuggestions int
+	normalizer     Normalizer
 }
 
 // NewAutoComplete creates a new autocomplete system
 func NewAutoComplete(maxSuggestions int) *AutoComplete {
+	return NewAutoCompleteWithNormalizer(maxSuggestions, DefaultNormalizer)
+}
+
+// NewAutoCompleteWithNormalizer creates an autocomplete system that stores
+// and looks up words as normalizer.Normalize returns them
+func NewAutoCompleteWithNormalizer(maxSuggestions int, normalizer Normalizer) *AutoComplete {
 	return &AutoComplete{
 		trie:           NewTrie(),
 		maxSuggestions: maxSuggestions,
+		normalizer:     normalizer,
 	}
 }
 
 // AddWord adds a word to the autocomplete dictionary
 func (ac *AutoComplete) AddWord(word string) {
-	ac.trie.InsertSimple(strings.ToLower(word))
+	ac.trie.InsertSimple(ac.normalizer.Normalize(word))
 }
 
 // GetSuggestions returns word suggestions for a prefix
 func (ac *AutoComplete) GetSuggestions(prefix string) []string {
-	prefix = strings.ToLower(prefix)
+	prefix = ac.normalizer.Normalize(prefix)
 	words := ac.trie.GetWordsWithPrefix(prefix)
 
 	// Limit suggestions
@@ -349,53 +752,98 @@ func (ac *AutoComplete) GetSuggestions(prefix string) []string {
 	return words
 }
 
+// Suggestions is GetSuggestions without tracing
+func (ac *AutoComplete) Suggestions(prefix string) []string {
+	words := ac.trie.WordsWithPrefix(ac.normalizer.Normalize(prefix))
+	return words[:min(len(words), ac.maxSuggestions)]
+}
+
 // SpellChecker provides spell checking functionality
 type SpellChecker struct {
-	trie *Trie
+	trie        *Trie
+	normalizer  Normalizer
+	filter      *BloomFilter // optional pre-filter, see EnableBloomFilter
+	filterStats SpellFilterStats
+}
+
+// SpellFilterStats counts what the Bloom filter did for CheckSpelling
+type SpellFilterStats struct {
+	Rejected       int // lookups the filter answered alone
+	Passed         int // lookups passed on to the trie
+	FalsePositives int // passed lookups the trie rejected
 }
 
 // NewSpellChecker creates a new spell checker
 func NewSpellChecker() *SpellChecker {
+	return NewSpellCheckerWithNormalizer(DefaultNormalizer)
+}
+
+// NewSpellCheckerWithNormalizer creates a spell checker that compares words
+// after normalizer.Normalize
+func NewSpellCheckerWithNormalizer(normalizer Normalizer) *SpellChecker {
 	return &SpellChecker{
-		trie: NewTrie(),
+		trie:       NewTrie(),
+		normalizer: normalizer,
 	}
 }
 
 // AddToDictionary adds a word to the spell checker dictionary
 func (sc *SpellChecker) AddToDictionary(word string) {
-	sc.trie.InsertSimple(strings.ToLower(word))
+	key := sc.normalizer.Normalize(word)
+	sc.trie.InsertSimple(key)
+	if sc.filter != nil {
+		sc.filter.Add(key)
+	}
 }
 
-// CheckSpelling checks if a word is spelled correctly
-func (sc *SpellChecker) CheckSpelling(word string) bool {
-	return sc.trie.SearchSimple(strings.ToLower(word))
+// EnableBloomFilter puts a Bloom filter sized for expectedWords (or the
+// current dictionary, if larger) in front of the trie, so most misspelled
+// words are rejected without walking it. Words added later go into the
+// filter too, raising its false positive rate past expectedWords.
+func (sc *SpellChecker) EnableBloomFilter(expectedWords int, falsePositiveRate float64) error {
+	filter, err := NewBloomFilter(max(expectedWords, max(sc.trie.size, 1)), falsePositiveRate)
+	if err != nil {
+		return err
+	}
+	for it := sc.trie.Iter(""); it.Next(); {
+		filter.Add(it.Word())
+	}
+	sc.filter, sc.filterStats = filter, SpellFilterStats{}
+	return nil
 }
 
-// GetSuggestions provides spelling suggestions (simplified)
-func (sc *SpellChecker) GetSuggestions(word string) []string {
-	word = strings.ToLower(word)
-
-	// Try removing one character
-	suggestions := []string{}
+// FilterStats returns what the Bloom filter has done since it was enabled
+func (sc *SpellChecker) FilterStats() SpellFilterStats {
+	return sc.filterStats
+}
 
-	for i := 0; i < len(word); i++ {
-		candidate := word[:i] + word[i+1:]
-		if candidate != "" && sc.trie.SearchSimple(candidate) {
-			suggestions = append(suggestions, candidate)
-		}
+// CheckSpelling checks if a word is spelled correctly. With a Bloom filter
+// enabled, a "definitely not" answer is final and a "possibly" answer
+// falls back to the trie, which also catches the filter's false positives.
+func (sc *SpellChecker) CheckSpelling(word string) bool {
+	key := sc.normalizer.Normalize(word)
+	if sc.filter == nil {
+		return sc.trie.SearchSimple(key)
 	}
-
-	// Try prefix matching
-	if len(suggestions) < 5 {
-		prefixSuggestions := sc.trie.GetWordsWithPrefix(word[:len(word)/2])
-		for _, suggestion := range prefixSuggestions {
-			if len(suggestions) >= 5 {
-				break
-			}
-			suggestions = append(suggestions, suggestion)
-		}
+	if !sc.filter.MayContain(key) {
+		sc.filterStats.Rejected++
+		return false
 	}
+	sc.filterStats.Passed++
+	found := sc.trie.SearchSimple(key)
+	if !found {
+		sc.filterStats.FalsePositives++
+	}
+	return found
+}
 
+// GetSuggestions returns up to 5 dictionary words within edit distance 2
+// of word, closest first
+func (sc *SpellChecker) GetSuggestions(word string) []string {
+	suggestions := sc.trie.FuzzySearch(sc.normalizer.Normalize(word), 2)
+	if len(suggestions) > 5 {
+		suggestions = suggestions[:5]
+	}
 	return suggestions
 }
 
@@ -405,7 +853,8 @@ func (sc *SpellChecker) GetSuggestions(word string) []string {
 
 // DemoTrieBasics demonstrates basic Trie operations
 func DemoTrieBasics() {
-	fmt.Println("=== TRIE DATA STRUCTURE BASICS ===\n")
+	fmt.Println("=== TRIE DATA STRUCTURE BASICS ===")
+	fmt.Println()
 
 	fmt.Println("A Trie (Prefix Tree) is a tree-like data structure that:")
 	fmt.Println("✓ Stores strings efficiently")
@@ -442,7 +891,8 @@ func DemoTrieBasics() {
 
 // DemoTrieAdvanced demonstrates advanced Trie operations
 func DemoTrieAdvanced() {
-	fmt.Println("=== ADVANCED TRIE OPERATIONS ===\n")
+	fmt.Println("=== ADVANCED TRIE OPERATIONS ===")
+	fmt.Println()
 
 	trie := NewTrie()
 
@@ -482,11 +932,63 @@ func DemoTrieAdvanced() {
 		fmt.Printf("  Success: %v\n", deleted)
 		fmt.Printf("  Remaining size: %d\n\n", trie.Size())
 	}
+
+	// Fuzzy search
+	fmt.Println("=== FUZZY SEARCH ===")
+	for _, query := range []struct {
+		word    string
+		maxDist int
+	}{{"dgo", 1}, {"dgo", 2}, {"bandanna", 1}, {"catepilar", 2}} {
+		fmt.Printf("FuzzySearch(%q, %d): %v\n", query.word, query.maxDist, trie.FuzzySearch(query.word, query.maxDist))
+	}
+	fmt.Println()
+
+	// Longest common prefix and longest stored prefix
+	fmt.Println("=== LONGEST PREFIXES ===")
+	for _, words := range [][]string{{"flower", "flow", "flight"}, {"interview", "internet", "internal", "interval"}, {"dog", "racecar"}, {"app", "apple"}} {
+		group := NewTrie()
+		for _, word := range words {
+			group.InsertSimple(word)
+		}
+		fmt.Printf("LongestCommonPrefix(%v): %q\n", words, group.LongestCommonPrefix())
+	}
+
+	// Routes are address prefixes in bits, so the longest stored prefix of
+	// an address is its most specific route
+	bits := func(address string, length int) string {
+		return fmt.Sprintf("%032b", parseIPv4(address))[:length]
+	}
+	routes := NewTrie()
+	names := map[string]string{}
+	for _, route := range []struct {
+		network string
+		length  int
+	}{{"0.0.0.0", 0}, {"10.0.0.0", 8}, {"10.1.0.0", 16}, {"10.1.2.0", 24}, {"192.168.0.0", 16}} {
+		routes.InsertSimple(bits(route.network, route.length))
+		names[bits(route.network, route.length)] = fmt.Sprintf("%s/%d", route.network, route.length)
+	}
+	for _, address := range []string{"10.1.2.3", "10.1.9.9", "10.200.0.1", "192.168.4.20", "8.8.8.8"} {
+		route, _ := routes.LongestPrefixOf(bits(address, 32))
+		fmt.Printf("LongestPrefixOf(%s): %s\n", address, names[route])
+	}
+	fmt.Println()
+
+	// Removing a whole subtree at once
+	fmt.Println("=== DELETE PREFIX ===")
+	trie.InsertSimple("bandana")
+	for _, prefix := range []string{"ban", "dogg", "xyz", "ca"} {
+		before := trie.CountWordsWithPrefix(prefix)
+		removed := trie.DeletePrefix(prefix)
+		fmt.Printf("DeletePrefix(%q): removed %d of %d, size now %d, words left: %v\n",
+			prefix, removed, before, trie.Size(), trie.WordsWithPrefix(""))
+	}
+	fmt.Println()
 }
 
 // DemoAutoComplete demonstrates autocomplete functionality
 func DemoAutoComplete() {
-	fmt.Println("=== AUTOCOMPLETE SYSTEM ===\n")
+	fmt.Println("=== AUTOCOMPLETE SYSTEM ===")
+	fmt.Println()
 
 	ac := NewAutoComplete(5) // Maximum 5 suggestions
 
@@ -517,7 +1019,8 @@ func DemoAutoComplete() {
 
 // DemoSpellChecker demonstrates spell checking functionality
 func DemoSpellChecker() {
-	fmt.Println("=== SPELL CHECKER SYSTEM ===\n")
+	fmt.Println("=== SPELL CHECKER SYSTEM ===")
+	fmt.Println()
 
 	sc := NewSpellChecker()
 
@@ -558,15 +1061,70 @@ func DemoSpellChecker() {
 	}
 }
 
+// DemoTrieIterator streams words instead of collecting them all
+func DemoTrieIterator() {
+	fmt.Println("=== TRIE ITERATOR ===")
+	fmt.Println()
+
+	// Example 1: Sorted streaming
+	fmt.Println("=== EXAMPLE 1: Words with Prefix 'ca' ===")
+	trie := NewTrie()
+	for _, word := range []string{"cat", "car", "cart", "care", "card", "cab", "dog", "car"} {
+		trie.InsertSimple(word)
+	}
+	for it := trie.Iter("ca"); it.Next(); {
+		fmt.Printf("  %-5s x%d\n", it.Word(), it.Count())
+	}
+	fmt.Printf("Iter(\"x\") yields anything: %v\n", trie.Iter("x").Next())
+	fmt.Println()
+
+	// Example 2: First 10 of a large dictionary
+	words := append(docWords(), syntheticWords(100_000, rand.New(rand.NewSource(1043)))...)
+	dictionary := NewTrie()
+	for _, word := range words {
+		dictionary.InsertSimple(word)
+	}
+	fmt.Printf("=== EXAMPLE 2: First 10 Words of %d ===\n", dictionary.Size())
+	first := []string{}
+	for it := dictionary.Iter(""); it.Next() && len(first) < 10; {
+		first = append(first, it.Word())
+	}
+	fmt.Printf("Iterator: %v\n", first)
+	fmt.Printf("Same as GetAllWords()[:10]: %v\n", fmt.Sprint(first) == fmt.Sprint(dictionary.GetAllWords()[:10]))
+	row := func(label string, p allocationProfile) {
+		fmt.Printf("  %-26s %10.0f allocs %12.0f bytes %12v\n", label, p.allocs, p.bytes, p.elapsed.Round(time.Microsecond))
+	}
+	row("GetAllWords()[:10]", profileAllocations(5, func() { _ = dictionary.GetAllWords()[:10] }))
+	row("Iter, stop after 10", profileAllocations(5, func() {
+		taken := 0
+		for it := dictionary.Iter(""); taken < 10 && it.Next(); taken++ {
+			_ = it.Word()
+		}
+	}))
+	full := 0
+	for it := dictionary.Iter(""); it.Next(); {
+		full++
+	}
+	fmt.Printf("A full pass yields every distinct word: %v\n", full == dictionary.Size())
+	fmt.Println()
+
+	fmt.Println("The iterator holds one stack frame per character of the current word,")
+	fmt.Println("so memory is bounded by the longest word rather than the dictionary,")
+	fmt.Println("and a caller that only needs the first matches stops paying right there.")
+	fmt.Println()
+}
+
 // DemoTrieComplexity demonstrates Trie complexity characteristics
 func DemoTrieComplexity() {
-	fmt.Println("=== COMPLEXITY ANALYSIS ===\n")
+	fmt.Println("=== COMPLEXITY ANALYSIS ===")
+	fmt.Println()
 
 	fmt.Println("Time Complexity:")
 	fmt.Println("- Insert: O(m) where m = length of word")
 	fmt.Println("- Search: O(m) where m = length of word")
 	fmt.Println("- Delete: O(m) where m = length of word")
 	fmt.Println("- Prefix search: O(p + n) where p = prefix length, n = results")
+	fmt.Println("- Prefix count: O(p) using the per-node prefix counts")
 	fmt.Println()
 
 	fmt.Println("Space Complexity:")
@@ -607,4 +1165,71 @@ func DemoTrieComplexity() {
 
 	fmt.Println("\nTrie structure (notice shared prefixes):")
 	trie.PrintTrie()
+
+	characters := 0
+	for _, word := range efficientWords {
+		characters += len(word)
+	}
+	stats := trie.Stats()
+	fmt.Printf("\n%d characters stored in %d nodes (%d edges, %d leaves)\n",
+		characters, stats.Nodes, stats.Edges, stats.Leaves)
+	fmt.Printf("Max depth %d, average branching %.2f, about %d bytes\n",
+		stats.MaxDepth, stats.AvgBranching, stats.EstimatedBytes)
+
+	// Measured numbers for a real dictionary
+	fmt.Println("\n=== MEASURED: THE WORDS OF THE REPOSITORY'S DOCS ===")
+	words := docWords()
+	before := heapInUse()
+	dictionary := NewTrie()
+	for _, word := range words {
+		dictionary.InsertSimple(word)
+	}
+	measured := heapInUse() - before
+	stats = dictionary.Stats()
+	characters = 0
+	for _, word := range words {
+		characters += len(word)
+	}
+	fmt.Printf("Words: %d, characters: %d, nodes: %d (%.0f%% of characters saved by sharing)\n",
+		stats.Words, characters, stats.Nodes, 100*(1-float64(stats.Nodes-1)/float64(characters)))
+	fmt.Printf("Leaves: %d, max depth: %d, average branching: %.2f\n",
+		stats.Leaves, stats.MaxDepth, stats.AvgBranching)
+	fmt.Printf("Estimated: %d bytes, measured heap growth: %d bytes (%.0f bytes per character)\n",
+		stats.EstimatedBytes, measured, float64(measured)/float64(characters))
+	fmt.Println("Sharing prefixes saves nodes, but every node carries its own child map,")
+	fmt.Println("so a map-based trie still costs far more than the raw strings.")
+	runtime.KeepAlive(dictionary)
+
+	// Counting by prefix without collecting the words
+	fmt.Println("\n=== PREFIX COUNTS: O(p) vs COLLECTING ===")
+	for _, prefix := range []string{"", "s", "co", "tri", "zz"} {
+		start := time.Now()
+		collected := len(dictionary.WordsWithPrefix(prefix))
+		collectTime := time.Since(start)
+		start = time.Now()
+		counted := dictionary.CountWordsWithPrefix(prefix)
+		countTime := time.Since(start)
+		fmt.Printf("  %-5q collected %5d in %-10v counted %5d in %v\n",
+			prefix, collected, collectTime.Round(time.Microsecond), counted, countTime)
+	}
+
+	// The counts must survive repeated inserts and deletes
+	rng := rand.New(rand.NewSource(1048))
+	for i := 0; i < 5000; i++ {
+		word := words[rng.Intn(len(words))]
+		if rng.Intn(2) == 0 {
+			dictionary.InsertSimple(word)
+		} else {
+			dictionary.DeleteSimple(word)
+		}
+	}
+	mismatches := 0
+	for _, word := range words {
+		for end := 0; end <= len(word); end++ {
+			if dictionary.CountWordsWithPrefix(word[:end]) != len(dictionary.WordsWithPrefix(word[:end])) {
+				mismatches++
+			}
+		}
+	}
+	fmt.Printf("After 5000 random inserts and deletes, prefixes whose count disagrees: %d\n", mismatches)
 }
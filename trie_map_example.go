@@ -0,0 +1,317 @@
+package main
+
+import (
+	"fmt"
+	"iter"
+	"sort"
+	"strings"
+)
+
+// ================================
+// GENERIC TRIE MAP
+// ================================
+
+// trieMapNode is a node of a TrieMap; value is only meaningful when
+// hasValue is set, so zero values can be stored like any other
+type trieMapNode[V any] struct {
+	children map[rune]*trieMapNode[V]
+	value    V
+	hasValue bool
+}
+
+func newTrieMapNode[V any]() *trieMapNode[V] {
+	return &trieMapNode[V]{children: make(map[rune]*trieMapNode[V])}
+}
+
+// TrieMap is a Trie that associates a value with each key instead of just
+// counting words, like a map[string]V that can also enumerate keys by
+// prefix and find the longest stored prefix of a key. (The name Trie is
+// taken by the word-counting trie in trie_example.go.)
+type TrieMap[V any] struct {
+	root       *trieMapNode[V]
+	size       int
+	restricted bool // whether keys must use alphabet
+	alphabet   TrieAlphabet
+}
+
+// NewTrieMap creates an empty trie map accepting any characters
+func NewTrieMap[V any]() *TrieMap[V] {
+	return &TrieMap[V]{root: newTrieMapNode[V]()}
+}
+
+// NewTrieMapOver creates an empty trie map whose keys must use alphabet,
+// one of the alphabets ArrayTrie supports
+func NewTrieMapOver[V any](alphabet TrieAlphabet) *TrieMap[V] {
+	return &TrieMap[V]{root: newTrieMapNode[V](), restricted: true, alphabet: alphabet}
+}
+
+// Insert stores value under key like Put, but reports why a key was
+// rejected: on a map created with NewTrieMapOver, keys with characters
+// outside the alphabet are refused with an error.
+// Time Complexity: O(L) for a key of length L
+func (t *TrieMap[V]) Insert(key string, value V) error {
+	if err := t.checkKey(key); err != nil {
+		return err
+	}
+	t.put(key, value)
+	return nil
+}
+
+// Put stores value under key, replacing any previous value. Returns true if
+// the key is new. Put has no way to report a rejected key, so on a map
+// created with NewTrieMapOver it panics for a key outside the alphabet;
+// use Insert there unless keys are known to be valid.
+// Time Complexity: O(L) for a key of length L
+func (t *TrieMap[V]) Put(key string, value V) bool {
+	if err := t.checkKey(key); err != nil {
+		panic("trie map: " + err.Error())
+	}
+	return t.put(key, value)
+}
+
+// checkKey returns an error if the map is restricted and key uses a
+// character outside its alphabet
+func (t *TrieMap[V]) checkKey(key string) error {
+	if !t.restricted {
+		return nil
+	}
+	for _, char := range key {
+		if t.alphabet.index(char) < 0 {
+			return fmt.Errorf("%q contains %q, which is outside the %v alphabet", key, char, t.alphabet)
+		}
+	}
+	return nil
+}
+
+// put stores value under key without checking the alphabet
+func (t *TrieMap[V]) put(key string, value V) bool {
+	node := t.root
+	for _, char := range key {
+		child, exists := node.children[char]
+		if !exists {
+			child = newTrieMapNode[V]()
+			node.children[char] = child
+		}
+		node = child
+	}
+	added := !node.hasValue
+	if added {
+		t.size++
+	}
+	node.value, node.hasValue = value, true
+	return added
+}
+
+// find returns the node for key, or nil
+func (t *TrieMap[V]) find(key string) *trieMapNode[V] {
+	node := t.root
+	for _, char := range key {
+		if node = node.children[char]; node == nil {
+			return nil
+		}
+	}
+	return node
+}
+
+// Get returns the value stored under key
+// Time Complexity: O(L)
+func (t *TrieMap[V]) Get(key string) (V, bool) {
+	node := t.find(key)
+	if node == nil || !node.hasValue {
+		var zero V
+		return zero, false
+	}
+	return node.value, true
+}
+
+// Delete removes key and prunes nodes that no longer lead to any value.
+// Returns false if the key was not present.
+// Time Complexity: O(L)
+func (t *TrieMap[V]) Delete(key string) bool {
+	if !t.root.delete([]rune(key)) {
+		return false
+	}
+	t.size--
+	return true
+}
+
+// delete removes the key rest below n
+func (n *trieMapNode[V]) delete(rest []rune) bool {
+	if len(rest) == 0 {
+		if !n.hasValue {
+			return false
+		}
+		var zero V
+		n.value, n.hasValue = zero, false
+		return true
+	}
+	child := n.children[rest[0]]
+	if child == nil || !child.delete(rest[1:]) {
+		return false
+	}
+	if !child.hasValue && len(child.children) == 0 {
+		delete(n.children, rest[0])
+	}
+	return true
+}
+
+// Range calls fn for every key starting with prefix, in sorted key order,
+// until fn returns false
+// Time Complexity: O(P + k log k) for prefix length P and k visited nodes
+func (t *TrieMap[V]) Range(prefix string, fn func(key string, value V) bool) {
+	if node := t.find(prefix); node != nil {
+		node.walk([]rune(prefix), fn)
+	}
+}
+
+// walk visits n and its descendants in key order; it returns false once fn
+// has asked to stop
+func (n *trieMapNode[V]) walk(key []rune, fn func(string, V) bool) bool {
+	if n.hasValue && !fn(string(key), n.value) {
+		return false
+	}
+	for _, char := range sortedKeys(n.children) {
+		if !n.children[char].walk(append(key, char), fn) {
+			return false
+		}
+	}
+	return true
+}
+
+// Keys returns the keys starting with prefix as a sorted sequence
+func (t *TrieMap[V]) Keys(prefix string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		t.Range(prefix, func(key string, _ V) bool {
+			return yield(key)
+		})
+	}
+}
+
+// LongestPrefix returns the longest stored key that is a prefix of key,
+// the lookup a routing table performs
+// Time Complexity: O(L)
+func (t *TrieMap[V]) LongestPrefix(key string) (string, V, bool) {
+	node := t.root
+	bestLength, best, found := 0, node.value, node.hasValue
+	for i, char := range key {
+		if node = node.children[char]; node == nil {
+			break
+		}
+		if node.hasValue {
+			bestLength, best, found = i+len(string(char)), node.value, true
+		}
+	}
+	if !found {
+		var zero V
+		return "", zero, false
+	}
+	return key[:bestLength], best, true
+}
+
+// Len returns the number of keys
+func (t *TrieMap[V]) Len() int {
+	return t.size
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoTrieMap demonstrates the generic trie map as a routing table, a
+// config store, a ranked autocomplete and a glossary over a fixed alphabet
+func DemoTrieMap() {
+	fmt.Println("=== GENERIC TRIE MAP ===")
+	fmt.Println()
+
+	// Example 1: Routing table
+	fmt.Println("=== EXAMPLE 1: Routing by Longest Prefix ===")
+	routes := NewTrieMap[string]()
+	routes.Put("/", "home")
+	routes.Put("/api/", "api gateway")
+	routes.Put("/api/users/", "user service")
+	routes.Put("/static/", "file server")
+	for _, path := range []string{"/api/users/42", "/api/orders/7", "/static/logo.png", "/about"} {
+		prefix, handler, _ := routes.LongestPrefix(path)
+		fmt.Printf("%-18s -> %-13s (matched %q)\n", path, handler, prefix)
+	}
+	fmt.Println()
+
+	// Example 2: Config lookup
+	fmt.Println("=== EXAMPLE 2: Config Keys by Section ===")
+	config := NewTrieMap[any]()
+	config.Put("db.host", "localhost")
+	config.Put("db.port", 5432)
+	config.Put("db.pool.size", 10)
+	config.Put("cache.ttl", "5m")
+	config.Put("debug", false)
+	port, _ := config.Get("db.port")
+	_, hasUser := config.Get("db.user")
+	fmt.Printf("Get(db.port) = %v, Get(db.user) found = %v\n", port, hasUser)
+	fmt.Println("Range(\"db.\"):")
+	config.Range("db.", func(key string, value any) bool {
+		fmt.Printf("  %-12s = %v\n", key, value)
+		return true
+	})
+	debug, _ := config.Get("debug")
+	fmt.Printf("Get(debug) = %v (a stored zero value, not a missing key)\n", debug)
+	config.Delete("db.pool.size")
+	fmt.Printf("After Delete(db.pool.size): %d keys, Delete again = %v\n", config.Len(), config.Delete("db.pool.size"))
+	fmt.Println()
+
+	// Example 3: Autocomplete with metadata
+	fmt.Println("=== EXAMPLE 3: Autocomplete Ranked by Frequency ===")
+	frequencies := NewTrieMap[int]()
+	for _, word := range strings.Fields("the program printed the progress of the project " +
+		"then the problem was fixed so the program printed progress again and the project shipped") {
+		count, _ := frequencies.Get(word)
+		frequencies.Put(word, count+1)
+	}
+	for _, prefix := range []string{"pro", "th", "x"} {
+		type suggestion struct {
+			word  string
+			count int
+		}
+		suggestions := []suggestion{}
+		frequencies.Range(prefix, func(word string, count int) bool {
+			suggestions = append(suggestions, suggestion{word, count})
+			return true
+		})
+		sort.SliceStable(suggestions, func(i, j int) bool {
+			return suggestions[i].count > suggestions[j].count
+		})
+		fmt.Printf("%-4s:", prefix)
+		for _, s := range suggestions {
+			fmt.Printf(" %s(%d)", s.word, s.count)
+		}
+		fmt.Println()
+	}
+	first := ""
+	frequencies.Range("p", func(word string, _ int) bool {
+		first = word
+		return false
+	})
+	fmt.Printf("First key under \"p\", stopping early: %s\n", first)
+	fmt.Println()
+
+	// Example 4: Word -> definition over a fixed alphabet
+	fmt.Println("=== EXAMPLE 4: Glossary over Lowercase Letters ===")
+	glossary := NewTrieMapOver[string](LowercaseAlphabet)
+	for _, entry := range [][2]string{
+		{"heap", "tree where each parent orders before its children"},
+		{"hash", "fixed-size digest of a key"},
+		{"trie", "tree of keys sharing prefixes"},
+		{"Tree", "connected graph without cycles"},
+		{"b-tree", "balanced tree with many keys per node"},
+	} {
+		if err := glossary.Insert(entry[0], entry[1]); err != nil {
+			fmt.Println("Rejected:", err)
+		}
+	}
+	fmt.Printf("Put(\"hash\") added a new key: %v\n", glossary.Put("hash", "digest of a key"))
+	glossary.Range("h", func(word, definition string) bool {
+		fmt.Printf("  %-5s %s\n", word, definition)
+		return true
+	})
+	fmt.Printf("%d words stored\n", glossary.Len())
+	fmt.Println()
+}
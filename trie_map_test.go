@@ -0,0 +1,49 @@
+package main
+
+import "testing"
+
+func TestTrieMapOverRejectsKeysOutsideAlphabet(t *testing.T) {
+	glossary := NewTrieMapOver[int](LowercaseAlphabet)
+	if err := glossary.Insert("heap", 1); err != nil {
+		t.Fatalf("Insert(heap): %v", err)
+	}
+	for _, key := range []string{"Heap", "b-tree", "café", "heap "} {
+		if err := glossary.Insert(key, 2); err == nil {
+			t.Errorf("Insert(%q) succeeded", key)
+		}
+		if !putPanics(glossary, key, 2) {
+			t.Errorf("Put(%q) accepted a key outside the alphabet", key)
+		}
+		if _, ok := glossary.Get(key); ok {
+			t.Errorf("Get(%q) found a rejected key", key)
+		}
+	}
+	if !glossary.Put("hash", 3) || glossary.Put("hash", 4) {
+		t.Error("Put of a valid key did not report new, then existing")
+	}
+	if value, _ := glossary.Get("hash"); value != 4 {
+		t.Errorf("Get(hash) = %d, want 4", value)
+	}
+	if glossary.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", glossary.Len())
+	}
+}
+
+// putPanics reports whether m.Put(key, value) panicked
+func putPanics[V any](m *TrieMap[V], key string, value V) (panicked bool) {
+	defer func() { panicked = recover() != nil }()
+	m.Put(key, value)
+	return false
+}
+
+func TestTrieMapAcceptsAnyKey(t *testing.T) {
+	m := NewTrieMap[int]()
+	for i, key := range []string{"Heap", "b-tree", "café", ""} {
+		if err := m.Insert(key, i); err != nil {
+			t.Errorf("Insert(%q): %v", key, err)
+		}
+		if value, ok := m.Get(key); !ok || value != i {
+			t.Errorf("Get(%q) = %d, %v; want %d, true", key, value, ok, i)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"strings"
+	"testing"
+)
+
+// trieFile builds a saved trie from raw uvarints after the header
+func trieFile(values ...uint64) []byte {
+	data := []byte(trieFileMagic)
+	for _, value := range values {
+		data = binary.AppendUvarint(data, value)
+	}
+	return data
+}
+
+func TestLoadTrieRoundTrip(t *testing.T) {
+	trie := NewTrie()
+	for _, word := range []string{"car", "card", "care", "cat", "car", "über"} {
+		trie.InsertSimple(word)
+	}
+	var saved bytes.Buffer
+	if err := trie.Save(&saved); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	loaded, err := LoadTrie(&saved)
+	if err != nil {
+		t.Fatalf("LoadTrie: %v", err)
+	}
+	if got, want := strings.Join(loaded.GetAllWords(), " "), strings.Join(trie.GetAllWords(), " "); got != want {
+		t.Errorf("words = %s, want %s", got, want)
+	}
+	if loaded.root.prefixCount != trie.root.prefixCount || loaded.Size() != trie.Size() {
+		t.Errorf("prefixCount, size = %d, %d; want %d, %d",
+			loaded.root.prefixCount, loaded.Size(), trie.root.prefixCount, trie.Size())
+	}
+
+	empty, err := LoadTrie(bytes.NewReader(trieFile(0, 0)))
+	if err != nil || empty.Size() != 0 {
+		t.Errorf("empty trie: size %v, err %v", empty, err)
+	}
+}
+
+func TestLoadTrieRejectsDamagedInput(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		data []byte
+	}{
+		{"count beyond int", trieFile(1<<63, 0)},
+		{"childless node without a word", trieFile(0, 1, 'a', 0, 0)},
+		{"prefix counts overflow", trieFile(0, 2, 'a', math.MaxInt, 0, 'b', math.MaxInt, 0)},
+		{"repeated character", trieFile(0, 2, 'a', 1, 0, 'a', 1, 0)},
+		{"invalid character", trieFile(0, 1, 0xD800, 1, 0)},
+	} {
+		if _, err := LoadTrie(bytes.NewReader(test.data)); err == nil {
+			t.Errorf("%s: LoadTrie succeeded", test.name)
+		}
+	}
+}
+
+func TestLoadTrieDeepInput(t *testing.T) {
+	// One word of 100,000 letters: far deeper than recursion should go
+	const depth = 100_000
+	values := make([]uint64, 0, 3*depth+2)
+	for i := 0; i < depth; i++ {
+		values = append(values, 0, 1, 'a')
+	}
+	data := trieFile(append(values, 1, 0)...)
+
+	loaded, err := LoadTrie(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("LoadTrie: %v", err)
+	}
+	if loaded.Size() != 1 || loaded.root.prefixCount != 1 {
+		t.Errorf("size, prefixCount = %d, %d; want 1, 1", loaded.Size(), loaded.root.prefixCount)
+	}
+
+	_, err = LoadTrie(bytes.NewReader(data[:len(data)-1]))
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("truncated: err = %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+}
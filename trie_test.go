@@ -0,0 +1,25 @@
+package main
+
+import "testing"
+
+func TestLongestPrefixOfInvalidUTF8(t *testing.T) {
+	trie := NewTrie()
+	trie.InsertSimple("a\xff")
+	trie.InsertSimple("a")
+	tests := []struct {
+		query string
+		want  string
+		found bool
+	}{
+		{"a\xff", "a\xff", true},
+		{"a\xffb", "a\xff", true},
+		{"ab", "a", true},
+		{"\xff", "", false},
+	}
+	for _, tt := range tests {
+		got, found := trie.LongestPrefixOf(tt.query)
+		if got != tt.want || found != tt.found {
+			t.Errorf("LongestPrefixOf(%q) = %q, %v; want %q, %v", tt.query, got, found, tt.want, tt.found)
+		}
+	}
+}
@@ -0,0 +1,281 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// ================================
+// TRAVELING SALESMAN PROBLEM
+// ================================
+
+// maxHeldKarpVertices bounds the exact solver: its table has 2^(n-1) * n entries
+const maxHeldKarpVertices = 20
+
+// distanceMatrix returns direct edge weights, +Inf where there is no edge.
+// Parallel edges keep the cheapest weight.
+func (g *WeightedGraph) distanceMatrix() [][]float64 {
+	matrix := make([][]float64, g.vertices)
+	for u := range matrix {
+		matrix[u] = make([]float64, g.vertices)
+		for v := range matrix[u] {
+			if u != v {
+				matrix[u][v] = math.Inf(1)
+			}
+		}
+		for _, edge := range g.adjList[u] {
+			matrix[u][edge.to] = math.Min(matrix[u][edge.to], edge.weight)
+		}
+	}
+	return matrix
+}
+
+// MetricClosure returns a complete graph where the edge u -> v weighs the
+// shortest path distance from u to v. A tour in the closure corresponds to
+// a closed walk in the original graph that may pass through cities twice.
+// Time Complexity: O(V * (V + E) log V)
+func (g *WeightedGraph) MetricClosure() *WeightedGraph {
+	closure := NewWeightedGraph(g.vertices)
+	for u := 0; u < g.vertices; u++ {
+		for v, distance := range DijkstraHeap(g, u).distances {
+			if u != v && !math.IsInf(distance, 1) {
+				closure.AddEdge(u, v, distance)
+			}
+		}
+	}
+	return closure
+}
+
+// HeldKarpTSP finds the cheapest tour that starts at start, visits every
+// vertex exactly once using direct edges, and returns to start.
+// cost[mask][j] is the cheapest path from start through the vertices in mask
+// ending at j; the start vertex is left out of the mask to halve the table.
+// Time Complexity: O(2^n * n²)
+// Space Complexity: O(2^n * n)
+func (g *WeightedGraph) HeldKarpTSP(start int) (float64, []int, error) {
+	n := g.vertices
+	if n > maxHeldKarpVertices {
+		return 0, nil, fmt.Errorf("held-karp supports at most %d vertices, got %d", maxHeldKarpVertices, n)
+	}
+	if start < 0 || start >= n {
+		return 0, nil, fmt.Errorf("start vertex %d out of range", start)
+	}
+	if n == 1 {
+		return 0, []int{start, start}, nil
+	}
+
+	dist := g.distanceMatrix()
+
+	// Relabel so the other vertices are bits 0..n-2
+	others := []int{}
+	for v := 0; v < n; v++ {
+		if v != start {
+			others = append(others, v)
+		}
+	}
+	m := len(others)
+	full := 1<<m - 1
+
+	cost := make([][]float64, 1<<m)
+	parent := make([][]int8, 1<<m)
+	for mask := range cost {
+		cost[mask] = make([]float64, m)
+		parent[mask] = make([]int8, m)
+		for j := range cost[mask] {
+			cost[mask][j] = math.Inf(1)
+			parent[mask][j] = -1
+		}
+	}
+	for j, v := range others {
+		cost[1<<j][j] = dist[start][v]
+	}
+
+	for mask := 1; mask <= full; mask++ {
+		for j := 0; j < m; j++ {
+			if mask&(1<<j) == 0 || math.IsInf(cost[mask][j], 1) {
+				continue
+			}
+			for k := 0; k < m; k++ {
+				if mask&(1<<k) != 0 {
+					continue
+				}
+				next := mask | 1<<k
+				if candidate := cost[mask][j] + dist[others[j]][others[k]]; candidate < cost[next][k] {
+					cost[next][k] = candidate
+					parent[next][k] = int8(j)
+				}
+			}
+		}
+	}
+
+	best, last := math.Inf(1), -1
+	for j, v := range others {
+		if total := cost[full][j] + dist[v][start]; total < best {
+			best, last = total, j
+		}
+	}
+	if last == -1 {
+		return 0, nil, fmt.Errorf("no tour visits every vertex")
+	}
+
+	// Walk the parent table back from the last vertex
+	tour := []int{start}
+	for mask, j := full, last; j != -1; {
+		tour = append(tour, others[j])
+		previous := int(parent[mask][j])
+		mask &^= 1 << j
+		j = previous
+	}
+	tour = append(tour, start)
+	for i, k := 1, len(tour)-2; i < k; i, k = i+1, k-1 {
+		tour[i], tour[k] = tour[k], tour[i]
+	}
+
+	return best, tour, nil
+}
+
+// NearestNeighborTSP builds a tour by always moving to the closest unvisited
+// vertex. It is fast but can be far from optimal; use it when the graph is
+// too large for HeldKarpTSP.
+// Time Complexity: O(V²)
+// Space Complexity: O(V²) for the distance matrix
+func (g *WeightedGraph) NearestNeighborTSP(start int) (float64, []int, error) {
+	if start < 0 || start >= g.vertices {
+		return 0, nil, fmt.Errorf("start vertex %d out of range", start)
+	}
+
+	dist := g.distanceMatrix()
+	visited := make([]bool, g.vertices)
+	visited[start] = true
+	tour := []int{start}
+	total := 0.0
+
+	for current := start; len(tour) < g.vertices; {
+		next := -1
+		for v := 0; v < g.vertices; v++ {
+			if !visited[v] && (next == -1 || dist[current][v] < dist[current][next]) {
+				next = v
+			}
+		}
+		if math.IsInf(dist[current][next], 1) {
+			return 0, nil, fmt.Errorf("nearest neighbor got stuck at vertex %d", current)
+		}
+		visited[next] = true
+		tour = append(tour, next)
+		total += dist[current][next]
+		current = next
+	}
+
+	last := tour[len(tour)-1]
+	if math.IsInf(dist[last][start], 1) {
+		return 0, nil, fmt.Errorf("no edge back from vertex %d to %d", last, start)
+	}
+	return total + dist[last][start], append(tour, start), nil
+}
+
+// FindOptimalTour prints the shortest round trip from a city through every
+// other city. Cities not joined by a direct road are connected through the
+// shortest road route, so a city may be passed through more than once.
+func (cm *CityMap) FindOptimalTour(start string) {
+	startIndex, found := cm.roads.Index(start)
+	if !found {
+		fmt.Printf("City not found\n")
+		return
+	}
+
+	fmt.Printf("=== ROUND TRIP FROM %s ===\n\n", start)
+
+	closure := cm.roads.Graph().MetricClosure()
+	distance, tour, err := closure.HeldKarpTSP(startIndex)
+	if err != nil {
+		fmt.Printf("No round trip: %v\n\n", err)
+		return
+	}
+
+	fmt.Println("Optimal round trip, leg by leg:")
+	for i := 0; i+1 < len(tour); i++ {
+		legDistance, legPath := cm.roads.Graph().DijkstraWithPath(tour[i], tour[i+1])
+		fmt.Printf("  %s", cm.roads.Key(legPath[0]))
+		for _, city := range legPath[1:] {
+			fmt.Printf(" -> %s", cm.roads.Key(city))
+		}
+		fmt.Printf(" (%.1f km)\n", legDistance)
+	}
+	fmt.Printf("Total distance: %.1f km\n", distance)
+
+	if heuristic, _, err := closure.NearestNeighborTSP(startIndex); err == nil {
+		fmt.Printf("Nearest-neighbor heuristic: %.1f km\n", heuristic)
+	}
+	fmt.Println()
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// randomPointGraph builds a complete graph on n random points in a square
+func randomPointGraph(n int, rng *rand.Rand) *WeightedGraph {
+	xs, ys := make([]float64, n), make([]float64, n)
+	for i := range xs {
+		xs[i], ys[i] = rng.Float64()*100, rng.Float64()*100
+	}
+
+	graph := NewWeightedGraph(n)
+	for u := 0; u < n; u++ {
+		for v := u + 1; v < n; v++ {
+			graph.AddUndirectedEdge(u, v, math.Hypot(xs[u]-xs[v], ys[u]-ys[v]))
+		}
+	}
+	return graph
+}
+
+// DemoTSP compares the exact Held-Karp solver with the nearest-neighbor heuristic
+func DemoTSP() {
+	fmt.Println("=== TRAVELING SALESMAN PROBLEM ===")
+	fmt.Println()
+
+	// Example 1: Small textbook instance
+	fmt.Println("=== EXAMPLE 1: Four Cities ===")
+	graph := NewWeightedGraph(4)
+	graph.AddUndirectedEdge(0, 1, 10)
+	graph.AddUndirectedEdge(0, 2, 15)
+	graph.AddUndirectedEdge(0, 3, 20)
+	graph.AddUndirectedEdge(1, 2, 35)
+	graph.AddUndirectedEdge(1, 3, 25)
+	graph.AddUndirectedEdge(2, 3, 30)
+
+	cost, tour, _ := graph.HeldKarpTSP(0)
+	fmt.Printf("Held-Karp:        %v cost %.0f\n", tour, cost)
+	cost, tour, _ = graph.NearestNeighborTSP(0)
+	fmt.Printf("Nearest neighbor: %v cost %.0f\n\n", tour, cost)
+
+	// Example 2: Random points
+	fmt.Println("=== EXAMPLE 2: Random Points ===")
+	rng := rand.New(rand.NewSource(3))
+	for _, n := range []int{8, 12, 16} {
+		points := randomPointGraph(n, rng)
+
+		start := time.Now()
+		exact, _, _ := points.HeldKarpTSP(0)
+		exactTime := time.Since(start)
+		heuristic, _, _ := points.NearestNeighborTSP(0)
+
+		fmt.Printf("n=%2d: optimal %.1f (%v), nearest neighbor %.1f (+%.1f%%)\n",
+			n, exact, exactTime, heuristic, (heuristic/exact-1)*100)
+	}
+
+	large := randomPointGraph(500, rng)
+	start := time.Now()
+	heuristic, _, _ := large.NearestNeighborTSP(0)
+	fmt.Printf("n=500: nearest neighbor %.1f (%v); Held-Karp would need 2^499 states\n\n",
+		heuristic, time.Since(start))
+
+	fmt.Println("See DemoDijkstraApplications for a round trip on the CityMap road network.")
+	fmt.Println()
+
+	fmt.Println("Held-Karp:        exact, O(2^n * n²) time - practical up to ~20 cities")
+	fmt.Println("Nearest neighbor: heuristic, O(n²) time - no optimality guarantee")
+	fmt.Println()
+}
@@ -0,0 +1,294 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ================================
+// UNDO LOG
+// ================================
+
+// undoEntry is a recorded mutation and the action that reverses it
+type undoEntry struct {
+	description string
+	undo        func()
+}
+
+// UndoLog records mutations as they happen so the most recent ones can be
+// reversed. Each structure supplies its own inverse operations, so the log
+// itself works for any mutable structure.
+type UndoLog struct {
+	entries []undoEntry
+	limit   int // maximum entries kept, 0 = unlimited
+}
+
+// NewUndoLog creates a log that remembers at most limit mutations (0 = unlimited)
+func NewUndoLog(limit int) *UndoLog {
+	return &UndoLog{limit: limit}
+}
+
+// Record adds a mutation and its inverse to the log
+func (l *UndoLog) Record(description string, undo func()) {
+	l.entries = append(l.entries, undoEntry{description, undo})
+	if l.limit > 0 && len(l.entries) > l.limit {
+		l.entries = l.entries[len(l.entries)-l.limit:]
+	}
+}
+
+// Undo reverses the last n mutations, newest first, and returns their
+// descriptions. Fewer are undone if the log is shorter.
+func (l *UndoLog) Undo(n int) []string {
+	undone := []string{}
+	for ; n > 0 && len(l.entries) > 0; n-- {
+		last := l.entries[len(l.entries)-1]
+		l.entries = l.entries[:len(l.entries)-1]
+		last.undo()
+		undone = append(undone, last.description)
+	}
+	return undone
+}
+
+// Len returns the number of mutations that can be undone
+func (l *UndoLog) Len() int {
+	return len(l.entries)
+}
+
+// ================================
+// UNDOABLE TRIE
+// ================================
+
+// UndoableTrie wraps a Trie; Insert and Delete are inverses of each other.
+// The Trie is not exposed so every mutation goes through the log.
+type UndoableTrie struct {
+	trie *Trie
+	log  *UndoLog
+}
+
+// NewUndoableTrie creates an empty Trie with an undo log
+func NewUndoableTrie(limit int) *UndoableTrie {
+	return &UndoableTrie{trie: NewTrie(), log: NewUndoLog(limit)}
+}
+
+// Insert adds one occurrence of word
+func (ut *UndoableTrie) Insert(word string) {
+	ut.trie.InsertSimple(word)
+	ut.log.Record("insert "+word, func() { ut.trie.DeleteSimple(word) })
+}
+
+// Delete removes one occurrence of word. Nothing is recorded if it was absent.
+func (ut *UndoableTrie) Delete(word string) bool {
+	if !ut.trie.DeleteSimple(word) {
+		return false
+	}
+	ut.log.Record("delete "+word, func() { ut.trie.InsertSimple(word) })
+	return true
+}
+
+// Search reports whether word is in the Trie
+func (ut *UndoableTrie) Search(word string) bool {
+	return ut.trie.SearchSimple(word)
+}
+
+// Words returns all words in sorted order
+func (ut *UndoableTrie) Words() []string {
+	return ut.trie.GetAllWords()
+}
+
+// Undo reverses the last n mutations
+func (ut *UndoableTrie) Undo(n int) []string {
+	return ut.log.Undo(n)
+}
+
+// ================================
+// UNDOABLE BST
+// ================================
+
+// UndoableBST wraps a BST; Insert and Delete are inverses of each other.
+// The BST is not exposed so every mutation goes through the log.
+type UndoableBST struct {
+	tree *BST
+	log  *UndoLog
+}
+
+// NewUndoableBST creates an empty BST with an undo log
+func NewUndoableBST(limit int) *UndoableBST {
+	return &UndoableBST{tree: NewBST(), log: NewUndoLog(limit)}
+}
+
+// Insert adds val. Nothing is recorded if it was already present.
+func (ub *UndoableBST) Insert(val int) bool {
+	if !ub.tree.Insert(val) {
+		return false
+	}
+	ub.log.Record(fmt.Sprintf("insert %d", val), func() { ub.tree.Delete(val) })
+	return true
+}
+
+// Delete removes val. Nothing is recorded if it was absent.
+func (ub *UndoableBST) Delete(val int) bool {
+	if !ub.tree.Delete(val) {
+		return false
+	}
+	ub.log.Record(fmt.Sprintf("delete %d", val), func() { ub.tree.Insert(val) })
+	return true
+}
+
+// Contains reports whether val is in the tree
+func (ub *UndoableBST) Contains(val int) bool {
+	return ub.tree.Contains(val)
+}
+
+// InOrder returns all values in ascending order
+func (ub *UndoableBST) InOrder() []int {
+	return ub.tree.InOrder()
+}
+
+// Undo reverses the last n mutations. The values are restored, though the
+// tree shape may differ from before.
+func (ub *UndoableBST) Undo(n int) []string {
+	return ub.log.Undo(n)
+}
+
+// ================================
+// UNDOABLE UNION-FIND
+// ================================
+
+// UndoableUnionFind is a rollback Union-Find. A union has no natural
+// inverse, and path compression would rewrite pointers that undo needs to
+// restore, so Find here walks to the root without compressing. Union by
+// rank alone keeps trees O(log n) deep.
+type UndoableUnionFind struct {
+	uf  *UnionFind
+	log *UndoLog
+}
+
+// NewUndoableUnionFind creates n singleton sets with an undo log
+func NewUndoableUnionFind(n, limit int) *UndoableUnionFind {
+	return &UndoableUnionFind{uf: NewUnionFind(n), log: NewUndoLog(limit)}
+}
+
+// Find returns the root of x's set without modifying the structure
+// Time Complexity: O(log n)
+func (u *UndoableUnionFind) Find(x int) int {
+	for u.uf.parent[x] != x {
+		x = u.uf.parent[x]
+	}
+	return x
+}
+
+// Union merges the sets of x and y. Returns false if already in the same set.
+func (u *UndoableUnionFind) Union(x, y int) bool {
+	rootX, rootY := u.Find(x), u.Find(y)
+	if rootX == rootY {
+		return false
+	}
+	if u.uf.rank[rootX] < u.uf.rank[rootY] {
+		rootX, rootY = rootY, rootX
+	}
+
+	// Only rootY's parent, rootX's rank and the count change
+	oldRank := u.uf.rank[rootX]
+	u.uf.parent[rootY] = rootX
+	if u.uf.rank[rootX] == u.uf.rank[rootY] {
+		u.uf.rank[rootX]++
+	}
+	u.uf.count--
+
+	u.log.Record(fmt.Sprintf("union %d %d", x, y), func() {
+		u.uf.parent[rootY] = rootY
+		u.uf.rank[rootX] = oldRank
+		u.uf.count++
+	})
+	return true
+}
+
+// Connected reports whether x and y are in the same set
+func (u *UndoableUnionFind) Connected(x, y int) bool {
+	return u.Find(x) == u.Find(y)
+}
+
+// Count returns the number of disjoint sets
+func (u *UndoableUnionFind) Count() int {
+	return u.uf.count
+}
+
+// Undo reverses the last n unions
+func (u *UndoableUnionFind) Undo(n int) []string {
+	return u.log.Undo(n)
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// runUndoSession replays REPL-style commands against an undoable Trie:
+// "insert <word>", "delete <word>", "undo <n>"
+func runUndoSession(trie *UndoableTrie, commands []string) {
+	for _, command := range commands {
+		var op, arg string
+		fmt.Sscan(command, &op, &arg)
+
+		switch op {
+		case "insert":
+			trie.Insert(arg)
+		case "delete":
+			trie.Delete(arg)
+		case "undo":
+			n := 1
+			fmt.Sscan(arg, &n)
+			fmt.Printf("  > %-14s (reverted: %s)\n", command, strings.Join(trie.Undo(n), ", "))
+			fmt.Printf("    words: %v\n", trie.Words())
+			continue
+		}
+		fmt.Printf("  > %-14s words: %v\n", command, trie.Words())
+	}
+}
+
+// DemoUndo demonstrates undoing mutations on a Trie, a BST and a Union-Find
+func DemoUndo() {
+	fmt.Println("=== UNDO ACROSS MUTABLE STRUCTURES ===")
+	fmt.Println()
+
+	// Example 1: Trie session
+	fmt.Println("=== EXAMPLE 1: Trie Session ===")
+	runUndoSession(NewUndoableTrie(0), []string{
+		"insert car",
+		"insert cart",
+		"insert care",
+		"delete car",
+		"insert cat",
+		"undo 2",
+		"undo 1",
+	})
+	fmt.Println()
+
+	// Example 2: BST
+	fmt.Println("=== EXAMPLE 2: BST ===")
+	tree := NewUndoableBST(0)
+	for _, val := range []int{50, 30, 70, 60} {
+		tree.Insert(val)
+	}
+	tree.Delete(50)
+	tree.Insert(55)
+	fmt.Printf("After 4 inserts, delete 50, insert 55: %v\n", tree.InOrder())
+	fmt.Printf("Undo 2 (%v): %v\n", tree.Undo(2), tree.InOrder())
+	fmt.Printf("Undo 10 (only %d recorded): %v\n\n", len(tree.Undo(10)), tree.InOrder())
+
+	// Example 3: Union-Find with a bounded log
+	fmt.Println("=== EXAMPLE 3: Union-Find (log keeps last 3 unions) ===")
+	uf := NewUndoableUnionFind(6, 3)
+	for _, pair := range [][]int{{0, 1}, {2, 3}, {1, 3}, {4, 5}} {
+		uf.Union(pair[0], pair[1])
+	}
+	fmt.Printf("Sets: %d, 0~3 connected: %v, 4~5 connected: %v\n", uf.Count(), uf.Connected(0, 3), uf.Connected(4, 5))
+	fmt.Printf("Undo 2 (%v)\n", uf.Undo(2))
+	fmt.Printf("Sets: %d, 0~3 connected: %v, 0~1 connected: %v\n", uf.Count(), uf.Connected(0, 3), uf.Connected(0, 1))
+	fmt.Printf("Undo 5 reverts only %v: the oldest union fell out of the log\n", uf.Undo(5))
+	fmt.Printf("Sets: %d, 0~1 connected: %v\n\n", uf.Count(), uf.Connected(0, 1))
+
+	fmt.Println("Trie and BST undo by applying the inverse operation.")
+	fmt.Println("Union-Find has no inverse for union, so it records the few fields a")
+	fmt.Println("union changes and skips path compression so those fields stay valid.")
+	fmt.Println()
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"fmt"
+	"unicode"
+)
+
+// ================================
+// UNICODE NORMALIZATION FOR TRIES
+// ================================
+
+// NormalizationForm selects how composed characters are stored
+type NormalizationForm int
+
+const (
+	NormNone NormalizationForm = iota // leave characters as they are
+	NFC                               // composed: "é" is one rune
+	NFD                               // decomposed: "é" is "e" + U+0301
+)
+
+// Normalizer turns words into trie keys. The same normalizer is applied on
+// insert and on query, so any two spellings it maps to the same key match.
+type Normalizer struct {
+	Form         NormalizationForm
+	FoldCase     bool // "Straße" and "STRASSE" match
+	StripAccents bool // "café" and "cafe" match
+}
+
+// DefaultNormalizer only folds case, as AutoComplete and SpellChecker
+// always did; folding also maps ß to "ss", which strings.ToLower does not
+var DefaultNormalizer = Normalizer{FoldCase: true}
+
+// latinCompositions lists, per combining mark, pairs of base letter and
+// precomposed letter. It covers Latin-1 Supplement and Latin Extended-A,
+// which is enough for most Western and Central European words; the module
+// has no dependencies, so the full Unicode tables of x/text are not used.
+var latinCompositions = map[rune]string{
+	'\u0300': "AÀEÈIÌOÒUÙaàeèiìoòuù",                             // grave
+	'\u0301': "AÁEÉIÍOÓUÚYÝaáeéiíoóuúyýCĆcćLĹlĺNŃnńRŔrŕSŚsśZŹzź", // acute
+	'\u0302': "AÂEÊIÎOÔUÛaâeêiîoôuûCĈcĉGĜgĝHĤhĥJĴjĵSŜsŝWŴwŵYŶyŷ", // circumflex
+	'\u0303': "AÃNÑOÕaãnñoõIĨiĩUŨuũ",                             // tilde
+	'\u0304': "AĀaāEĒeēIĪiīOŌoōUŪuū",                             // macron
+	'\u0306': "AĂaăEĔeĕGĞgğIĬiĭOŎoŏUŬuŭ",                         // breve
+	'\u0307': "CĊcċEĖeėGĠgġIİZŻzż",                               // dot above
+	'\u0308': "AÄEËIÏOÖUÜaäeëiïoöuüyÿYŸ",                         // diaeresis
+	'\u030A': "AÅaåUŮuů",                                         // ring above
+	'\u030B': "OŐoőUŰuű",                                         // double acute
+	'\u030C': "CČcčDĎdďEĚeěNŇnňRŘrřSŠsšTŤtťZŽzž",                 // caron
+	'\u0327': "CÇcçGĢgģKĶkķLĻlļNŅnņRŖrŗSŞsşTŢtţ",                 // cedilla
+	'\u0328': "AĄaąEĘeęIĮiįUŲuų",                                 // ogonek
+}
+
+// decompositions maps a precomposed letter to its base and mark, and
+// compositions maps base and mark back
+var decompositions, compositions = func() (map[rune][2]rune, map[[2]rune]rune) {
+	decompose, compose := map[rune][2]rune{}, map[[2]rune]rune{}
+	for mark, pairs := range latinCompositions {
+		letters := []rune(pairs)
+		for i := 0; i+1 < len(letters); i += 2 {
+			decompose[letters[i+1]] = [2]rune{letters[i], mark}
+			compose[[2]rune{letters[i], mark}] = letters[i+1]
+		}
+	}
+	return decompose, compose
+}()
+
+// Normalize returns the trie key for word
+// Time Complexity: O(len(word))
+func (n Normalizer) Normalize(word string) string {
+	runes := []rune(word)
+	if n.Form != NormNone || n.StripAccents {
+		decomposed := make([]rune, 0, len(runes))
+		for _, r := range runes {
+			if parts, ok := decompositions[r]; ok {
+				decomposed = append(decomposed, parts[0], parts[1])
+			} else {
+				decomposed = append(decomposed, r)
+			}
+		}
+		runes = decomposed
+	}
+	if n.FoldCase {
+		folded := make([]rune, 0, len(runes))
+		for _, r := range runes {
+			switch r {
+			case 'ß', 'ẞ':
+				folded = append(folded, 's', 's')
+			case 'ς':
+				folded = append(folded, 'σ') // final sigma folds to sigma
+			default:
+				folded = append(folded, unicode.ToLower(r))
+			}
+		}
+		runes = folded
+	}
+	if n.StripAccents {
+		runes = stripMarks(runes)
+	}
+	if n.Form == NFC {
+		composed := make([]rune, 0, len(runes))
+		for _, r := range runes {
+			if last := len(composed) - 1; last >= 0 {
+				if precomposed, ok := compositions[[2]rune{composed[last], r}]; ok {
+					composed[last] = precomposed
+					continue
+				}
+			}
+			composed = append(composed, r)
+		}
+		runes = composed
+	}
+	return string(runes)
+}
+
+// stripMarks drops combining marks (category Mn)
+func stripMarks(runes []rune) []rune {
+	kept := runes[:0]
+	for _, r := range runes {
+		if !unicode.Is(unicode.Mn, r) {
+			kept = append(kept, r)
+		}
+	}
+	return kept
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoUnicodeNormalization matches accented, decomposed and case-variant
+// spellings in the autocomplete and spell checker
+func DemoUnicodeNormalization() {
+	fmt.Println("=== UNICODE NORMALIZATION FOR TRIE KEYS ===")
+	fmt.Println()
+	composed, decomposed := "Café", "Cafe\u0301" // look the same, differ in bytes
+
+	// Example 1: What each normalizer produces
+	fmt.Println("=== EXAMPLE 1: Keys Produced by Each Normalizer ===")
+	normalizers := []struct {
+		name string
+		n    Normalizer
+	}{
+		{"default (lowercase)", DefaultNormalizer},
+		{"NFC + fold", Normalizer{Form: NFC, FoldCase: true}},
+		{"NFD + fold", Normalizer{Form: NFD, FoldCase: true}},
+		{"fold + strip accents", Normalizer{FoldCase: true, StripAccents: true}},
+	}
+	fmt.Printf("%-16s", "Input")
+	for _, normalizer := range normalizers {
+		fmt.Printf(" %-21s", normalizer.name)
+	}
+	fmt.Println()
+	for _, input := range []string{composed, decomposed, "Straße", "STRASSE", "Öl"} {
+		fmt.Printf("%-16s", fmt.Sprintf("%+q", input))
+		for _, normalizer := range normalizers {
+			fmt.Printf(" %-21s", fmt.Sprintf("%+q", normalizer.n.Normalize(input)))
+		}
+		fmt.Println()
+	}
+	fmt.Println("(escaped so that composed and decomposed forms can be told apart)")
+	fmt.Println()
+
+	// Example 2: Autocomplete over a non-ASCII dictionary
+	fmt.Println("=== EXAMPLE 2: Autocomplete on French, German and Czech Words ===")
+	dictionary := []string{"Café", "cafetière", "Crème brûlée", "crêpe", "Straße", "strudel", "Čapek", "čaj", "Zürich", "Žižkov"}
+	queries := []string{"cafe", "Cafe\u0301", "creme", "strass", "CAP", "zu", "ziz"}
+	for _, normalizer := range normalizers[1:] {
+		ac := NewAutoCompleteWithNormalizer(10, normalizer.n)
+		for _, word := range dictionary {
+			ac.AddWord(word)
+		}
+		fmt.Printf("%s:\n", normalizer.name)
+		for _, query := range queries {
+			fmt.Printf("  %-12s -> %q\n", fmt.Sprintf("%+q", query), ac.Suggestions(query))
+		}
+	}
+	fmt.Println("In NFD \"cafe\" is a prefix of \"café\", since the accent is a separate rune")
+	fmt.Println()
+
+	// Example 3: Spell checking must agree across equivalent spellings
+	fmt.Println("=== EXAMPLE 3: Spell Checker Consistency ===")
+	for _, normalizer := range normalizers {
+		sc := NewSpellCheckerWithNormalizer(normalizer.n)
+		for _, word := range dictionary {
+			sc.AddToDictionary(word)
+		}
+		accepted := 0
+		variants := []string{composed, decomposed, "CAFÉ", "STRASSE", "strasse", "CREPE", "Crêpe", "zurich"}
+		for _, variant := range variants {
+			if sc.CheckSpelling(variant) {
+				accepted++
+			}
+		}
+		fmt.Printf("%-21s accepts %d of %d variants; suggestions for \"zurik\": %q\n",
+			normalizer.name, accepted, len(variants), sc.GetSuggestions("zurik"))
+	}
+	fmt.Println()
+
+	fmt.Println("The trie compares runes, so two spellings only meet if they produce the")
+	fmt.Println("same runes. Normalizing once on the way in and once on the way out is")
+	fmt.Println("enough: NFC or NFD makes composed and decomposed forms agree, case")
+	fmt.Println("folding handles ß and final sigma that plain lowercasing misses, and")
+	fmt.Println("stripping accents is a deliberate, lossy choice for forgiving search.")
+	fmt.Println()
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+const (
+	cafeComposed   = "café"  // é as one rune
+	cafeDecomposed = "café" // e + combining acute
+)
+
+func TestNormalizePrecomposedAndDecomposed(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		normalizer Normalizer
+		input      string
+		want       string
+	}{
+		{"NFC composes", Normalizer{Form: NFC}, cafeDecomposed, cafeComposed},
+		{"NFC keeps composed", Normalizer{Form: NFC}, cafeComposed, cafeComposed},
+		{"NFD decomposes", Normalizer{Form: NFD}, cafeComposed, cafeDecomposed},
+		{"NFD keeps decomposed", Normalizer{Form: NFD}, cafeDecomposed, cafeDecomposed},
+		{"NFC with case folding", Normalizer{Form: NFC, FoldCase: true}, "CAFÉ", cafeComposed},
+		{"NFC of Czech", Normalizer{Form: NFC}, "Čapek", "Čapek"},
+		{"strip accents", Normalizer{StripAccents: true}, "Crème brûlée", "Creme brulee"},
+		{"strip decomposed accents", Normalizer{StripAccents: true}, cafeDecomposed, "cafe"},
+		{"fold sharp s", Normalizer{FoldCase: true}, "Straße", "strasse"},
+		{"fold capital sharp s", Normalizer{FoldCase: true}, "STRAẞE", "strasse"},
+		{"fold final sigma", Normalizer{FoldCase: true}, "ΟΔΟΣ", "οδοσ"},
+		{"none leaves input alone", Normalizer{}, cafeDecomposed, cafeDecomposed},
+	} {
+		if got := test.normalizer.Normalize(test.input); got != test.want {
+			t.Errorf("%s: Normalize(%+q) = %+q, want %+q", test.name, test.input, got, test.want)
+		}
+	}
+}
+
+// TestNormalizeFormsAgree checks that both spellings of every letter in the
+// composition table end up as the same key under NFC and under NFD
+func TestNormalizeFormsAgree(t *testing.T) {
+	for precomposed, parts := range decompositions {
+		composed, decomposed := string(precomposed), string(parts[:])
+		for _, normalizer := range []Normalizer{{Form: NFC}, {Form: NFD}, {Form: NFC, FoldCase: true}, {StripAccents: true}} {
+			if a, b := normalizer.Normalize(composed), normalizer.Normalize(decomposed); a != b {
+				t.Errorf("%+v: %+q -> %+q but %+q -> %+q", normalizer, composed, a, decomposed, b)
+			}
+		}
+	}
+}
+
+func TestAutoCompleteNonASCII(t *testing.T) {
+	dictionary := []string{"Café", "cafetière", "Crème brûlée", "Straße", "Čapek", "Zürich"}
+	for _, test := range []struct {
+		name       string
+		normalizer Normalizer
+		query      string
+		want       []string
+	}{
+		{"NFC matches decomposed query", Normalizer{Form: NFC, FoldCase: true}, cafeDecomposed, []string{cafeComposed}},
+		{"NFC keeps accents distinct", Normalizer{Form: NFC, FoldCase: true}, "cafe", []string{"cafetière"}},
+		{"NFD finds prefix before accent", Normalizer{Form: NFD, FoldCase: true}, "cafe", []string{"cafetière", cafeComposed}},
+		{"strip accents", Normalizer{FoldCase: true, StripAccents: true}, "creme", []string{"creme brulee"}},
+		{"strip accents from Czech", Normalizer{FoldCase: true, StripAccents: true}, "CAP", []string{"capek"}},
+		{"sharp s", Normalizer{Form: NFC, FoldCase: true}, "STRASS", []string{"strasse"}},
+		{"no match", Normalizer{Form: NFC, FoldCase: true}, "zu", []string{}},
+	} {
+		ac := NewAutoCompleteWithNormalizer(10, test.normalizer)
+		for _, word := range dictionary {
+			ac.AddWord(word)
+		}
+		got := ac.Suggestions(test.query)
+		if test.normalizer.Form == NFD {
+			// Keys are stored decomposed; compare in the composed form
+			for i := range got {
+				got[i] = Normalizer{Form: NFC}.Normalize(got[i])
+			}
+		}
+		if !slices.Equal(got, test.want) {
+			t.Errorf("%s: Suggestions(%+q) = %+q, want %+q", test.name, test.query, got, test.want)
+		}
+	}
+}
+
+func TestSpellCheckerNonASCII(t *testing.T) {
+	sc := NewSpellCheckerWithNormalizer(Normalizer{Form: NFC, FoldCase: true})
+	for _, word := range []string{"Café", "Čapek", "Straße"} {
+		sc.AddToDictionary(word)
+	}
+	for _, test := range []struct {
+		word string
+		want bool
+	}{
+		{cafeComposed, true},
+		{cafeDecomposed, true},
+		{"CAFÉ", true},
+		{"cafe", false}, // accents are kept without StripAccents
+		{"ČAPEK", true},
+		{"strasse", true},
+		{"STRAẞE", true},
+	} {
+		if got := sc.CheckSpelling(test.word); got != test.want {
+			t.Errorf("CheckSpelling(%+q) = %v, want %v", test.word, got, test.want)
+		}
+	}
+}
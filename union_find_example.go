@@ -34,6 +34,16 @@ func NewUnionFind(n int) *UnionFind {
 	}
 }
 
+// MakeSet adds a new element in a set of its own and returns its index,
+// for callers that discover elements as they go
+func (uf *UnionFind) MakeSet() int {
+	x := len(uf.parent)
+	uf.parent = append(uf.parent, x)
+	uf.rank = append(uf.rank, 0)
+	uf.count++
+	return x
+}
+
 // Find returns the root of the set containing x
 // Uses path compression optimization
 func (uf *UnionFind) Find(x int) int {
@@ -80,13 +90,22 @@ func (uf *UnionFind) Count() int {
 	return uf.count
 }
 
-// GetComponents returns all elements grouped by their components
-func (uf *UnionFind) GetComponents() map[int][]int {
-	components := make(map[int][]int)
+// GetComponents returns all elements grouped by their components.
+// Members of each component are listed in ascending order, and components
+// are ordered by their smallest member.
+func (uf *UnionFind) GetComponents() [][]int {
+	components := [][]int{}
+	position := make(map[int]int) // root -> index of its component
 
 	for i := 0; i < len(uf.parent); i++ {
 		root := uf.Find(i)
-		components[root] = append(components[root], i)
+		p, seen := position[root]
+		if !seen {
+			p = len(components)
+			position[root] = p
+			components = append(components, nil)
+		}
+		components[p] = append(components[p], i)
 	}
 
 	return components
@@ -301,8 +320,8 @@ func AccountsMerge(accounts [][]string) [][]string {
 
 	// Group emails by their root
 	indexToEmails := make(map[int][]string)
-	for email, idx := range emailToIndex {
-		root := uf.Find(idx)
+	for email, emailIndex := range emailToIndex {
+		root := uf.Find(emailIndex)
 		indexToEmails[root] = append(indexToEmails[root], email)
 	}
 
@@ -315,6 +334,11 @@ func AccountsMerge(accounts [][]string) [][]string {
 		result = append(result, account)
 	}
 
+	// Order merged accounts by their first email so output is stable
+	sort.Slice(result, func(i, j int) bool {
+		return result[i][1] < result[j][1]
+	})
+
 	return result
 }
 
@@ -323,12 +347,14 @@ func AccountsMerge(accounts [][]string) [][]string {
 // ================================
 
 func DemoUnionFind() {
-	fmt.Println("=== UNION-FIND (DISJOINT SET UNION) ALGORITHM ===\n")
+	fmt.Println("=== UNION-FIND (DISJOINT SET UNION) ALGORITHM ===")
+	fmt.Println()
 
 	fmt.Println("Union-Find is a data structure that efficiently handles:")
 	fmt.Println("1. Union: Merge two disjoint sets")
 	fmt.Println("2. Find: Determine which set an element belongs to")
-	fmt.Println("3. Connected: Check if two elements are in the same set\n")
+	fmt.Println("3. Connected: Check if two elements are in the same set")
+	fmt.Println()
 
 	// Example 1: Basic operations
 	fmt.Println("=== EXAMPLE 1: Basic Operations ===")
@@ -346,6 +372,7 @@ func DemoUnionFind() {
 		} else {
 			fmt.Printf("Already connected! Still %d sets\n", uf.Count())
 		}
+		fmt.Printf("  %v\n", uf.Snapshot())
 	}
 
 	// Test connectivity
@@ -358,9 +385,8 @@ func DemoUnionFind() {
 
 	// Show final components
 	fmt.Println("\nFinal components:")
-	components := uf.GetComponents()
-	for root, members := range components {
-		fmt.Printf("Component %d: %v\n", root, members)
+	for _, component := range uf.GetComponents() {
+		fmt.Printf("Component %d: %v\n", uf.Find(component[0]), component)
 	}
 	fmt.Println()
 
@@ -401,7 +427,8 @@ func DemoUnionFind() {
 	for _, edge := range mst {
 		fmt.Printf("(%d, %d, %d) ", edge.From, edge.To, edge.Weight)
 	}
-	fmt.Println("\n")
+	fmt.Println()
+	fmt.Println()
 
 	// Example 4: Cycle detection
 	fmt.Println("=== EXAMPLE 4: Cycle Detection ===")
@@ -501,7 +528,8 @@ func DemoAdvancedApplications() {
 			components[root] = append(components[root], i)
 		}
 
-		for root, members := range components {
+		for _, root := range sortedKeys(components) {
+			members := components[root]
 			if len(members) > 1 {
 				fmt.Printf("  Component %d: %v (size: %d)\n", root, members, wuf.GetSize(root))
 			}
@@ -0,0 +1,215 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// ================================
+// UNION-FIND SNAPSHOTS
+// ================================
+
+// UnionFindSnapshot is a copy of a UnionFind's arrays at one moment. It
+// does not share memory with the structure, so later unions and path
+// compression leave it untouched.
+type UnionFindSnapshot struct {
+	Parent []int `json:"parent"`
+	Rank   []int `json:"rank"`
+	Count  int   `json:"count"` // number of disjoint sets
+}
+
+// UnionFindChange is one element whose parent or rank differs between two
+// snapshots
+type UnionFindChange struct {
+	Element              int
+	OldParent, NewParent int
+	OldRank, NewRank     int
+}
+
+// Snapshot copies the parent and rank arrays. It does not call Find, so
+// the snapshot shows the trees exactly as they are, uncompressed paths
+// included.
+// Time Complexity: O(n)
+func (uf *UnionFind) Snapshot() UnionFindSnapshot {
+	return UnionFindSnapshot{Parent: slices.Clone(uf.parent), Rank: slices.Clone(uf.rank), Count: uf.count}
+}
+
+// String renders the snapshot on one line
+func (s UnionFindSnapshot) String() string {
+	return fmt.Sprintf("parent=%v rank=%v sets=%d", s.Parent, s.Rank, s.Count)
+}
+
+// Depth returns how many parent links lead from x to its root
+func (s UnionFindSnapshot) Depth(x int) int {
+	depth := 0
+	for s.Parent[x] != x {
+		x = s.Parent[x]
+		depth++
+	}
+	return depth
+}
+
+// Diff lists the elements whose parent or rank changed since before.
+// Elements added by MakeSet after before was taken count as changed from
+// being their own parent.
+func (s UnionFindSnapshot) Diff(before UnionFindSnapshot) []UnionFindChange {
+	changes := []UnionFindChange{}
+	for x := range s.Parent {
+		oldParent, oldRank := x, 0
+		if x < len(before.Parent) {
+			oldParent, oldRank = before.Parent[x], before.Rank[x]
+		}
+		if oldParent != s.Parent[x] || oldRank != s.Rank[x] {
+			changes = append(changes, UnionFindChange{x, oldParent, s.Parent[x], oldRank, s.Rank[x]})
+		}
+	}
+	return changes
+}
+
+// String describes the change, e.g. "3: parent 2 -> 0" or "0: rank 1 -> 2"
+func (c UnionFindChange) String() string {
+	parts := []string{}
+	if c.OldParent != c.NewParent {
+		parts = append(parts, fmt.Sprintf("parent %d -> %d", c.OldParent, c.NewParent))
+	}
+	if c.OldRank != c.NewRank {
+		parts = append(parts, fmt.Sprintf("rank %d -> %d", c.OldRank, c.NewRank))
+	}
+	return fmt.Sprintf("%d: %s", c.Element, strings.Join(parts, ", "))
+}
+
+// ToDOT draws the forest in Graphviz syntax. Edges point from parent to
+// child so roots are drawn on top; roots are labeled with their rank.
+// Parent pointers that changed since before are drawn in red; pass no
+// snapshot to highlight nothing.
+func (s UnionFindSnapshot) ToDOT(before ...UnionFindSnapshot) string {
+	edges := []dotEdge{}
+	labels := make([]string, len(s.Parent))
+	for x, parent := range s.Parent {
+		if parent == x {
+			labels[x] = fmt.Sprintf("%d\nrank %d", x, s.Rank[x])
+		} else {
+			labels[x] = fmt.Sprint(x)
+			edges = append(edges, dotEdge{from: parent, to: x})
+		}
+	}
+	highlight := DOTHighlight{Labels: labels}
+	if len(before) > 0 {
+		for _, change := range s.Diff(before[0]) {
+			if change.OldParent != change.NewParent {
+				highlight.Edges = append(highlight.Edges, Edge{From: change.NewParent, To: change.Element})
+			}
+		}
+	}
+	return writeDOT("unionfind", true, vertexRange(len(s.Parent)), edges, []DOTHighlight{highlight})
+}
+
+// printForest prints each tree of the snapshot sideways, root first
+func printForest(s UnionFindSnapshot) {
+	children := make([][]int, len(s.Parent))
+	for x, parent := range s.Parent {
+		if parent != x {
+			children[parent] = append(children[parent], x)
+		}
+	}
+	var walk func(x int, indent string)
+	walk = func(x int, indent string) {
+		fmt.Printf("%s%d\n", indent, x)
+		for _, child := range children[x] {
+			walk(child, indent+"  ")
+		}
+	}
+	for x, parent := range s.Parent {
+		if parent == x && len(children[x]) > 0 {
+			fmt.Printf("  root %d (rank %d):\n", x, s.Rank[x])
+			walk(x, "    ")
+		}
+	}
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoUnionFindSnapshots records the forest after every operation, so
+// union by rank and path compression can be seen step by step
+func DemoUnionFindSnapshots() {
+	fmt.Println("=== UNION-FIND SNAPSHOTS ===")
+	fmt.Println()
+
+	// Example 1: Union by rank keeps the trees shallow
+	fmt.Println("=== EXAMPLE 1: Diffs After Each Union ===")
+	uf := NewUnionFind(8)
+	snapshots := []UnionFindSnapshot{uf.Snapshot()}
+	steps := []string{"initial"}
+	for _, pair := range [][2]int{{0, 1}, {2, 3}, {0, 2}, {4, 5}, {6, 7}, {4, 6}, {7, 1}} {
+		before := uf.Snapshot()
+		merged := uf.Union(pair[0], pair[1])
+		after := uf.Snapshot()
+		changes := []string{}
+		for _, change := range after.Diff(before) {
+			changes = append(changes, change.String())
+		}
+		fmt.Printf("Union(%d, %d) merged=%v: %s\n", pair[0], pair[1], merged, strings.Join(changes, "; "))
+		snapshots = append(snapshots, after)
+		steps = append(steps, fmt.Sprintf("union(%d,%d)", pair[0], pair[1]))
+	}
+	fmt.Println(snapshots[len(snapshots)-1])
+	printForest(snapshots[len(snapshots)-1])
+	fmt.Println("Each union hangs one root under the other and bumps the rank on a tie;")
+	fmt.Println("the last one also moved 7, compressed by the Find inside Union.")
+	fmt.Println()
+
+	// Example 2: Path compression rewires a whole path at once
+	fmt.Println("=== EXAMPLE 2: Path Compression During Find ===")
+	deepest, depth := 0, 0
+	final := snapshots[len(snapshots)-1]
+	for x := range final.Parent {
+		if d := final.Depth(x); d > depth {
+			deepest, depth = x, d
+		}
+	}
+	before := uf.Snapshot()
+	root := uf.Find(deepest)
+	after := uf.Snapshot()
+	fmt.Printf("Find(%d) = %d, depth %d -> %d\n", deepest, root, depth, after.Depth(deepest))
+	for _, change := range after.Diff(before) {
+		fmt.Printf("  %v\n", change)
+	}
+	fmt.Println("Ranks are unchanged: they are upper bounds on height, not heights.")
+	snapshots = append(snapshots, after)
+	steps = append(steps, fmt.Sprintf("find(%d)", deepest))
+	fmt.Println()
+
+	// Example 3: One DOT file per step, changed pointers in red
+	fmt.Println("=== EXAMPLE 3: DOT Frames ===")
+	dir, err := os.MkdirTemp("", "unionfind")
+	if err != nil {
+		fmt.Println("Error:", err)
+		return
+	}
+	defer os.RemoveAll(dir)
+	for i, snapshot := range snapshots {
+		dot := snapshot.ToDOT()
+		if i > 0 {
+			dot = snapshot.ToDOT(snapshots[i-1])
+		}
+		name := filepath.Join(dir, fmt.Sprintf("unionfind_%03d.dot", i))
+		if err := os.WriteFile(name, []byte(dot), 0o644); err != nil {
+			fmt.Println("Error:", err)
+			return
+		}
+	}
+	fmt.Printf("Wrote %d frames (%s ... %s); the last one:\n", len(snapshots), steps[0], steps[len(steps)-1])
+	fmt.Print(snapshots[len(snapshots)-1].ToDOT(snapshots[len(snapshots)-2]))
+	fmt.Println()
+
+	fmt.Println("The snapshot copies the arrays without calling Find, so it shows the")
+	fmt.Println("forest exactly as the algorithm left it. Diffing consecutive snapshots")
+	fmt.Println("turns \"union by rank attaches the shorter tree\" and \"path compression")
+	fmt.Println("flattens the path\" into concrete pointer changes you can point at.")
+	fmt.Println()
+}
@@ -0,0 +1,410 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+)
+
+// ================================
+// RADIX-TREE URL ROUTER
+// ================================
+
+// routeNode is a node of a radix tree (compressed trie) over route patterns.
+// Static text is stored on edges, several characters per edge, so routes
+// sharing a prefix such as "/api/users" share the nodes for it.
+type routeNode struct {
+	prefix   string       // static text consumed on the way into this node
+	static   []*routeNode // static children, no two start with the same byte
+	param    *routeNode   // ":name" child, matches one non-empty path segment
+	catchAll *routeNode   // "*name" child, matches the rest of the path
+	name     string       // parameter name on param and catchAll nodes
+	pattern  string       // full pattern if a route ends here
+	handlers map[string]http.HandlerFunc
+}
+
+// Router matches URL paths against patterns made of static segments,
+// ":param" segments and a trailing "*wildcard". Static segments take
+// priority over parameters, and parameters over wildcards, so
+// "/users/new" wins over "/users/:id" for that exact path.
+// Router implements http.Handler; parameters are exposed through
+// (*http.Request).PathValue.
+type Router struct {
+	root *routeNode
+}
+
+// NewRouter creates a router with no routes
+func NewRouter() *Router {
+	return &Router{root: &routeNode{}}
+}
+
+// routeSegment is one piece of a parsed pattern: static text, or a
+// ":param" or "*wildcard" segment holding the parameter name
+type routeSegment struct {
+	kind byte // 0 for static text, ':' or '*' otherwise
+	text string
+}
+
+// Add registers handler for method and pattern, for example
+// Add("GET", "/users/:id/posts/*rest", handler).
+// The pattern is validated and checked against existing routes before the
+// tree is touched, so a rejected pattern leaves no nodes behind.
+// Time Complexity: O(m) for a pattern of length m (times the fan-out)
+func (r *Router) Add(method, pattern string, handler http.HandlerFunc) error {
+	segments, err := parsePattern(pattern)
+	if err != nil {
+		return err
+	}
+	if err := r.root.checkConflicts(method, pattern, segments); err != nil {
+		return err
+	}
+
+	node := r.root
+	for _, segment := range segments {
+		switch segment.kind {
+		case ':':
+			if node.param == nil {
+				node.param = &routeNode{name: segment.text}
+			}
+			node = node.param
+		case '*':
+			if node.catchAll == nil {
+				node.catchAll = &routeNode{name: segment.text}
+			}
+			node = node.catchAll
+		default:
+			node = node.insertStatic(segment.text)
+		}
+	}
+
+	if node.handlers == nil {
+		node.handlers = make(map[string]http.HandlerFunc)
+	}
+	node.pattern = pattern
+	node.handlers[method] = handler
+	return nil
+}
+
+// parsePattern splits pattern into static text and parameter segments,
+// rejecting malformed patterns
+func parsePattern(pattern string) ([]routeSegment, error) {
+	if !strings.HasPrefix(pattern, "/") {
+		return nil, fmt.Errorf("pattern %q must start with /", pattern)
+	}
+
+	var segments []routeSegment
+	rest := pattern
+	for rest != "" {
+		i := strings.IndexAny(rest, ":*")
+		if i < 0 {
+			segments = append(segments, routeSegment{text: rest})
+			break
+		}
+		if i > 0 {
+			if rest[i-1] != '/' {
+				return nil, fmt.Errorf("pattern %q: %c must start a segment", pattern, rest[i])
+			}
+			segments = append(segments, routeSegment{text: rest[:i]})
+		}
+
+		end := strings.IndexByte(rest[i:], '/')
+		if end < 0 {
+			end = len(rest)
+		} else {
+			end += i
+		}
+		name := rest[i+1 : end]
+		if name == "" {
+			return nil, fmt.Errorf("pattern %q: unnamed parameter", pattern)
+		}
+		if rest[i] == '*' && end != len(rest) {
+			return nil, fmt.Errorf("pattern %q: wildcard must be the last segment", pattern)
+		}
+		segments = append(segments, routeSegment{kind: rest[i], text: name})
+		rest = rest[end:]
+	}
+	return segments, nil
+}
+
+// checkConflicts walks the existing tree along segments without changing
+// it, reporting a parameter renamed at the same position or a route that is
+// already registered for method. Once the walk leaves the existing tree the
+// rest of the route is new and cannot conflict.
+func (n *routeNode) checkConflicts(method, pattern string, segments []routeSegment) error {
+	node := n
+	for _, segment := range segments {
+		switch segment.kind {
+		case ':':
+			if node.param != nil && node.param.name != segment.text {
+				return fmt.Errorf("pattern %q: parameter :%s conflicts with :%s", pattern, segment.text, node.param.name)
+			}
+			node = node.param
+		case '*':
+			if node.catchAll != nil && node.catchAll.name != segment.text {
+				return fmt.Errorf("pattern %q: wildcard *%s conflicts with *%s", pattern, segment.text, node.catchAll.name)
+			}
+			node = node.catchAll
+		default:
+			node = node.findStatic(segment.text)
+		}
+		if node == nil {
+			return nil
+		}
+	}
+
+	if _, exists := node.handlers[method]; exists {
+		return fmt.Errorf("route %s %s already registered", method, pattern)
+	}
+	return nil
+}
+
+// findStatic follows the static path text below n, returning nil if the
+// tree has no node ending exactly there
+func (n *routeNode) findStatic(text string) *routeNode {
+	for text != "" {
+		var child *routeNode
+		for _, c := range n.static {
+			if c.prefix[0] == text[0] {
+				child = c
+				break
+			}
+		}
+		if child == nil || !strings.HasPrefix(text, child.prefix) {
+			return nil
+		}
+		n, text = child, text[len(child.prefix):]
+	}
+	return n
+}
+
+// insertStatic follows or creates the static path text below n, splitting
+// an edge when text diverges from it part-way, and returns the final node
+func (n *routeNode) insertStatic(text string) *routeNode {
+	for text != "" {
+		var child *routeNode
+		for _, c := range n.static {
+			if c.prefix[0] == text[0] {
+				child = c
+				break
+			}
+		}
+		if child == nil {
+			child = &routeNode{prefix: text}
+			n.static = append(n.static, child)
+			return child
+		}
+
+		common := 0
+		for common < len(text) && common < len(child.prefix) && text[common] == child.prefix[common] {
+			common++
+		}
+		if common < len(child.prefix) {
+			// Split: the shared part becomes a new node above the old child
+			split := &routeNode{prefix: child.prefix[:common], static: []*routeNode{child}}
+			child.prefix = child.prefix[common:]
+			for i, c := range n.static {
+				if c == child {
+					n.static[i] = split
+				}
+			}
+			child = split
+		}
+		n, text = child, text[common:]
+	}
+	return n
+}
+
+// RouteMatch is the result of matching a path
+type RouteMatch struct {
+	Pattern  string
+	Params   map[string]string
+	handlers map[string]http.HandlerFunc
+}
+
+// Methods returns the methods registered for the matched pattern, sorted
+func (m *RouteMatch) Methods() []string {
+	return sortedKeys(m.handlers)
+}
+
+// Handler returns the handler for method, or an error if the pattern has
+// no handler for it (HTTP 405)
+func (m *RouteMatch) Handler(method string) (http.HandlerFunc, error) {
+	handler, found := m.handlers[method]
+	if !found {
+		return nil, fmt.Errorf("method %s not allowed for %s (allowed: %s)",
+			method, m.Pattern, strings.Join(m.Methods(), ", "))
+	}
+	return handler, nil
+}
+
+// Match finds the route for path and extracts its parameters, or returns
+// an error if no pattern matches (HTTP 404)
+// Time Complexity: O(m) for a path of length m, plus backtracking when a
+// static branch fails and a parameter branch is tried instead
+func (r *Router) Match(path string) (*RouteMatch, error) {
+	params := map[string]string{}
+	node := r.root.match(path, params)
+	if node == nil {
+		return nil, fmt.Errorf("no route matches %s", path)
+	}
+	return &RouteMatch{Pattern: node.pattern, Params: params, handlers: node.handlers}, nil
+}
+
+// match returns the node whose route matches path, recording parameters
+func (n *routeNode) match(path string, params map[string]string) *routeNode {
+	if path == "" && n.handlers != nil {
+		return n
+	}
+
+	for _, child := range n.static {
+		if strings.HasPrefix(path, child.prefix) {
+			if found := child.match(path[len(child.prefix):], params); found != nil {
+				return found
+			}
+		}
+	}
+
+	if n.param != nil {
+		end := strings.IndexByte(path, '/')
+		if end < 0 {
+			end = len(path)
+		}
+		if end > 0 {
+			if found := n.param.match(path[end:], params); found != nil {
+				params[n.param.name] = path[:end]
+				return found
+			}
+		}
+	}
+
+	// The wildcard may match an empty rest, so "/files/" matches "/files/*path"
+	if n.catchAll != nil && n.catchAll.handlers != nil {
+		params[n.catchAll.name] = path
+		return n.catchAll
+	}
+	return nil
+}
+
+// ServeHTTP dispatches the request, answering 404 or 405 when nothing fits
+func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	match, err := r.Match(req.URL.Path)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	handler, err := match.Handler(req.Method)
+	if err != nil {
+		w.Header().Set("Allow", strings.Join(match.Methods(), ", "))
+		http.Error(w, err.Error(), http.StatusMethodNotAllowed)
+		return
+	}
+	for name, value := range match.Params {
+		req.SetPathValue(name, value)
+	}
+	handler(w, req)
+}
+
+// printTree shows the radix tree, one edge per line
+func (n *routeNode) printTree(indent string) {
+	children := append([]*routeNode(nil), n.static...)
+	sort.Slice(children, func(i, j int) bool { return children[i].prefix < children[j].prefix })
+	for _, child := range children {
+		child.printLine(indent, fmt.Sprintf("%q", child.prefix))
+	}
+	if n.param != nil {
+		n.param.printLine(indent, ":"+n.param.name)
+	}
+	if n.catchAll != nil {
+		n.catchAll.printLine(indent, "*"+n.catchAll.name)
+	}
+}
+
+func (n *routeNode) printLine(indent, label string) {
+	if n.handlers != nil {
+		label += fmt.Sprintf("  => %s %v", n.pattern, sortedKeys(n.handlers))
+	}
+	fmt.Printf("%s%s\n", indent, label)
+	n.printTree(indent + "  ")
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoURLRouter demonstrates route registration, matching and dispatch
+func DemoURLRouter() {
+	fmt.Println("=== RADIX-TREE URL ROUTER ===")
+	fmt.Println()
+
+	// reply returns a handler that echoes its route name and parameters
+	reply := func(name string, params ...string) http.HandlerFunc {
+		return func(w http.ResponseWriter, req *http.Request) {
+			fmt.Fprint(w, name)
+			for _, param := range params {
+				fmt.Fprintf(w, " %s=%s", param, req.PathValue(param))
+			}
+		}
+	}
+
+	router := NewRouter()
+	routes := []struct {
+		method, pattern string
+		handler         http.HandlerFunc
+	}{
+		{"GET", "/", reply("home")},
+		{"GET", "/users", reply("list users")},
+		{"POST", "/users", reply("create user")},
+		{"GET", "/users/new", reply("new user form")},
+		{"GET", "/users/:id", reply("show user", "id")},
+		{"DELETE", "/users/:id", reply("delete user", "id")},
+		{"GET", "/users/:id/posts/:post", reply("show post", "id", "post")},
+		{"GET", "/user-guide", reply("guide")},
+		{"GET", "/static/*file", reply("static file", "file")},
+	}
+	for _, route := range routes {
+		if err := router.Add(route.method, route.pattern, route.handler); err != nil {
+			fmt.Printf("Add failed: %v\n", err)
+		}
+	}
+
+	// Example 1: Tree shape
+	fmt.Println("=== EXAMPLE 1: The Radix Tree ===")
+	router.root.printTree("  ")
+	fmt.Println()
+
+	// Example 2: Matching
+	fmt.Println("=== EXAMPLE 2: Dispatching Requests ===")
+	requests := []struct{ method, path string }{
+		{"GET", "/"},
+		{"GET", "/users/new"},
+		{"GET", "/users/42"},
+		{"DELETE", "/users/42"},
+		{"GET", "/users/42/posts/7"},
+		{"GET", "/static/css/site.css"},
+		{"PUT", "/users/42"},
+		{"GET", "/users/42/comments"},
+	}
+	for _, request := range requests {
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(request.method, request.path, nil))
+		body, _ := io.ReadAll(recorder.Result().Body)
+		fmt.Printf("  %-6s %-22s %d %s\n", request.method, request.path, recorder.Code, strings.TrimSpace(string(body)))
+	}
+	fmt.Println()
+
+	// Example 3: Rejected patterns
+	fmt.Println("=== EXAMPLE 3: Rejected Patterns ===")
+	for _, pattern := range []string{"/users/:userID/likes", "/static/*path/raw", "/files:name", "/users"} {
+		if err := router.Add("GET", pattern, reply("x")); err != nil {
+			fmt.Printf("  %v\n", err)
+		}
+	}
+	fmt.Println()
+
+	fmt.Println("Matching walks one edge per shared prefix instead of testing every")
+	fmt.Println("pattern in turn, so lookup cost depends on the path, not the route count.")
+	fmt.Println()
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestRouterCatchAllMatchesEmptyRest(t *testing.T) {
+	router := NewRouter()
+	if err := router.Add("GET", "/files/*path", func(http.ResponseWriter, *http.Request) {}); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	for path, want := range map[string]string{"/files/": "", "/files/a/b.txt": "a/b.txt"} {
+		match, err := router.Match(path)
+		if err != nil {
+			t.Errorf("Match(%q): %v", path, err)
+			continue
+		}
+		if got := match.Params["path"]; got != want {
+			t.Errorf("Match(%q) path = %q, want %q", path, got, want)
+		}
+	}
+	if _, err := router.Match("/files"); err == nil {
+		t.Error("Match(/files) matched /files/*path")
+	}
+}
+
+func TestRouterRejectedAddLeavesTreeUnchanged(t *testing.T) {
+	noop := func(http.ResponseWriter, *http.Request) {}
+	router := NewRouter()
+	for _, pattern := range []string{"/users/:id", "/static/*file"} {
+		if err := router.Add("GET", pattern, noop); err != nil {
+			t.Fatalf("Add(%s): %v", pattern, err)
+		}
+	}
+
+	for _, pattern := range []string{
+		"/users/:id/posts/:post/x:y", // malformed after new static text
+		"/users/:userID/likes",       // renamed parameter
+		"/archive/*path/raw",         // wildcard not last
+		"/static/*path",              // renamed wildcard
+		"/users/:id",                 // already registered
+	} {
+		if err := router.Add("GET", pattern, noop); err == nil {
+			t.Errorf("Add(%s) succeeded", pattern)
+		}
+	}
+
+	users := router.root.findStatic("/users/")
+	if len(router.root.static) != 1 || router.root.static[0].prefix != "/" {
+		t.Fatalf("root edges changed: %d edges", len(router.root.static))
+	}
+	if users == nil || users.param == nil || len(users.param.static) != 0 {
+		t.Error("rejected patterns left nodes below /users/:id")
+	}
+	if router.root.findStatic("/archive/") != nil {
+		t.Error("rejected pattern left a /archive/ node")
+	}
+
+	if err := router.Add("POST", "/users/:id", noop); err != nil {
+		t.Errorf("Add(POST /users/:id): %v", err)
+	}
+}
@@ -0,0 +1,203 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ================================
+// WORD BREAK WITH A TRIE
+// ================================
+
+// wordBreakEnds finds, for every position i of s, the ends j such that
+// s[i:j] is a dictionary word and s[j:] can itself be segmented. It fills
+// positions from the back, walking the trie forward from each one, so a
+// walk stops as soon as no word continues with the next character instead
+// of testing every substring against the dictionary.
+// Time Complexity: O(n * L) where L is the longest word
+func wordBreakEnds(s string, trie *Trie) ([]rune, [][]int) {
+	runes := []rune(s)
+	n := len(runes)
+	ends := make([][]int, n+1)
+	reachable := make([]bool, n+1)
+	reachable[n] = true
+	for i := n - 1; i >= 0; i-- {
+		node := trie.root
+		for j := i; j < n; j++ {
+			node = node.children[runes[j]]
+			if node == nil {
+				break
+			}
+			if node.isEnd && reachable[j+1] {
+				ends[i] = append(ends[i], j+1)
+				reachable[i] = true
+			}
+		}
+	}
+	return runes, ends
+}
+
+// WordBreak segments s into dictionary words, preferring the longest word
+// at each step, and reports whether any segmentation exists
+// Time Complexity: O(n * L)
+func WordBreak(s string, trie *Trie) ([]string, bool) {
+	runes, ends := wordBreakEnds(s, trie)
+	if len(runes) > 0 && len(ends[0]) == 0 {
+		return nil, false
+	}
+	words := []string{}
+	for i := 0; i < len(runes); {
+		j := ends[i][len(ends[i])-1]
+		words = append(words, string(runes[i:j]))
+		i = j
+	}
+	return words, true
+}
+
+// WordBreakAll returns up to limit segmentations of s (all of them if
+// limit <= 0) in lexicographic order of their word lengths, shortest first.
+// Only positions that lead to a full segmentation are ever entered, so no
+// time is spent on dead ends.
+// Time Complexity: O(n * L + output size)
+func WordBreakAll(s string, trie *Trie, limit int) [][]string {
+	runes, ends := wordBreakEnds(s, trie)
+	segmentations := [][]string{}
+	current := []string{}
+	var walk func(i int) bool
+	walk = func(i int) bool {
+		if i == len(runes) {
+			segmentations = append(segmentations, append([]string(nil), current...))
+			return limit <= 0 || len(segmentations) < limit
+		}
+		for _, j := range ends[i] {
+			current = append(current, string(runes[i:j]))
+			more := walk(j)
+			current = current[:len(current)-1]
+			if !more {
+				return false
+			}
+		}
+		return true
+	}
+	if len(runes) > 0 && len(ends[0]) == 0 {
+		return segmentations
+	}
+	walk(0)
+	return segmentations
+}
+
+// WordBreakCount returns how many segmentations s has without listing
+// them; the count saturates at the largest int instead of overflowing
+// Time Complexity: O(n * L)
+func WordBreakCount(s string, trie *Trie) int {
+	runes, ends := wordBreakEnds(s, trie)
+	const saturated = int(^uint(0) >> 1)
+	ways := make([]int, len(runes)+1)
+	ways[len(runes)] = 1
+	for i := len(runes) - 1; i >= 0; i-- {
+		for _, j := range ends[i] {
+			if ways[i] > saturated-ways[j] {
+				ways[i] = saturated
+				break
+			}
+			ways[i] += ways[j]
+		}
+	}
+	return ways[0]
+}
+
+// wordBreakNaive is the textbook recursion without memoization: it retries
+// every suffix from scratch, which is exponential when most prefixes are
+// words but the string as a whole cannot be split
+func wordBreakNaive(runes []rune, trie *Trie) bool {
+	if len(runes) == 0 {
+		return true
+	}
+	node := trie.root
+	for j, r := range runes {
+		if node = node.children[r]; node == nil {
+			return false
+		}
+		if node.isEnd && wordBreakNaive(runes[j+1:], trie) {
+			return true
+		}
+	}
+	return false
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoWordBreak segments hashtags, domain names and run-together text
+func DemoWordBreak() {
+	fmt.Println("=== WORD BREAK WITH A TRIE ===")
+	fmt.Println()
+	dictionary := func(words ...string) *Trie {
+		trie := NewTrie()
+		for _, word := range words {
+			trie.InsertSimple(word)
+		}
+		return trie
+	}
+
+	// Example 1: The classic cases
+	fmt.Println("=== EXAMPLE 1: One Segmentation and All of Them ===")
+	classic := dictionary("apple", "pen", "applepen", "pine", "pineapple", "cats", "dog", "sand", "and", "cat")
+	for _, s := range []string{"pineapplepenapple", "catsanddog", "catsandog"} {
+		words, ok := WordBreak(s, classic)
+		if !ok {
+			fmt.Printf("%-18s cannot be segmented\n", s)
+			continue
+		}
+		fmt.Printf("%-18s -> %s  (%d ways)\n", s, strings.Join(words, " "), WordBreakCount(s, classic))
+		for _, segmentation := range WordBreakAll(s, classic, 0) {
+			fmt.Printf("%20s %s\n", "", strings.Join(segmentation, " "))
+		}
+	}
+	fmt.Println()
+
+	// Example 2: Hashtags and domain names
+	fmt.Println("=== EXAMPLE 2: Hashtags and Domain Names ===")
+	english := dictionary("throw", "throwback", "back", "thursday", "thurs", "day", "men", "mens", "wear",
+		"swear", "depot", "sun", "sunday", "funday", "fun", "who", "represents", "rep", "resents",
+		"present", "presents", "no", "now", "where", "here", "nowhere", "go", "to", "gotham")
+	for _, s := range []string{"throwbackthursday", "mensweardepot", "sundayfunday", "whorepresents", "nowhere", "gothamcity"} {
+		if words, ok := WordBreak(s, english); ok {
+			alternatives := WordBreakAll(s, english, 3)
+			fmt.Printf("%-18s -> %-22s ways: %d", s, strings.Join(words, " "), WordBreakCount(s, english))
+			if len(alternatives) > 1 {
+				fmt.Printf(", e.g. %q", strings.Join(alternatives[0], " "))
+			}
+			fmt.Println()
+		} else {
+			fmt.Printf("%-18s -> no segmentation\n", s)
+		}
+	}
+	fmt.Println()
+
+	// Example 3: Exponentially many segmentations
+	fmt.Println("=== EXAMPLE 3: Dictionary {a, aa, aaa}, Naive Recursion vs DP ===")
+	as := dictionary("a", "aa", "aaa")
+	for _, n := range []int{10, 20, 26} {
+		s := strings.Repeat("a", n) + "b" // nothing ends in b, so no split works
+		start := time.Now()
+		naive := wordBreakNaive([]rune(s), as)
+		naiveTime := time.Since(start)
+		start = time.Now()
+		_, ok := WordBreak(s, as)
+		dpTime := time.Since(start)
+		fmt.Printf("a×%d + b: naive %v in %-12v DP %v in %-10v (a×%d alone has %d segmentations)\n",
+			n, naive, naiveTime.Round(time.Microsecond), ok, dpTime.Round(time.Microsecond), n, WordBreakCount(strings.Repeat("a", n), as))
+	}
+	fmt.Printf("a×200 has %d segmentations (saturated)\n", WordBreakCount(strings.Repeat("a", 200), as))
+	fmt.Println()
+
+	fmt.Println("Each position is solved once, from the back: a trie walk from position i")
+	fmt.Println("visits only prefixes that are still word prefixes, so the work per")
+	fmt.Println("position is bounded by the longest word rather than the string length.")
+	fmt.Println("Listing every segmentation can still be exponential in size, which is")
+	fmt.Println("why WordBreakAll takes a limit and WordBreakCount only counts.")
+	fmt.Println()
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"bufio"
+	"container/heap"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// ================================
+// WORD FREQUENCY WITH THE COUNTING TRIE
+// ================================
+
+// WordCount is a word with the number of times it occurred
+type WordCount struct {
+	Word  string
+	Count int
+}
+
+// wordCountHeap is a min-heap by count; among equal counts the
+// alphabetically last word is on top, so it is the first to be evicted
+type wordCountHeap []WordCount
+
+func (h wordCountHeap) Len() int { return len(h) }
+
+func (h wordCountHeap) Less(i, j int) bool {
+	if h[i].Count != h[j].Count {
+		return h[i].Count < h[j].Count
+	}
+	return h[i].Word > h[j].Word
+}
+
+func (h wordCountHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *wordCountHeap) Push(x interface{}) { *h = append(*h, x.(WordCount)) }
+
+func (h *wordCountHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[0 : n-1]
+	return item
+}
+
+// tokenizeWords splits text into lowercase words: runs of letters, with
+// apostrophes kept inside a word ("don't") but not at its edges
+func tokenizeWords(text string) []string {
+	words := []string{}
+	for _, field := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && r != '\''
+	}) {
+		if word := strings.Trim(field, "'"); word != "" {
+			words = append(words, word)
+		}
+	}
+	return words
+}
+
+// CountWords reads a document and inserts every word into a Trie, whose
+// per-node count records how often each word occurred. It also returns the
+// total number of words read.
+// Time Complexity: O(total characters)
+func CountWords(r io.Reader) (*Trie, int, error) {
+	trie := NewTrie()
+	tokens := 0
+	fields := bufio.NewScanner(r)
+	fields.Buffer(make([]byte, 64*1024), 1<<20)
+	fields.Split(bufio.ScanWords)
+	for fields.Scan() {
+		for _, word := range tokenizeWords(fields.Text()) {
+			trie.InsertSimple(word)
+			tokens++
+		}
+	}
+	if err := fields.Err(); err != nil {
+		return nil, 0, err
+	}
+	return trie, tokens, nil
+}
+
+// TopWords returns the k most frequent words, most frequent first and
+// alphabetically among equal counts. It streams the trie's words through a
+// heap of size k instead of sorting the whole vocabulary.
+// Time Complexity: O(trie nodes + W log k) for W distinct words
+func TopWords(trie *Trie, k int) []WordCount {
+	h := &wordCountHeap{}
+	for it := trie.Iter(""); it.Next(); {
+		candidate := WordCount{it.Word(), it.Count()}
+		if h.Len() < k {
+			heap.Push(h, candidate)
+		} else if k > 0 && (wordCountHeap{(*h)[0], candidate}).Less(0, 1) {
+			// The candidate outranks the weakest word kept so far
+			(*h)[0] = candidate
+			heap.Fix(h, 0)
+		}
+	}
+	top := make([]WordCount, h.Len())
+	for i := len(top) - 1; i >= 0; i-- {
+		top[i] = heap.Pop(h).(WordCount)
+	}
+	return top
+}
+
+// Hapaxes returns the hapax legomena, the words that occur exactly once,
+// in alphabetical order
+// Time Complexity: O(trie nodes)
+func Hapaxes(trie *Trie) []string {
+	hapaxes := []string{}
+	for it := trie.Iter(""); it.Next(); {
+		if it.Count() == 1 {
+			hapaxes = append(hapaxes, it.Word())
+		}
+	}
+	return hapaxes
+}
+
+// ================================
+// DEMONSTRATION
+// ================================
+
+// DemoWordFrequency analyzes the repository's own explanation documents
+func DemoWordFrequency() {
+	fmt.Println("=== WORD FREQUENCY WITH A COUNTING TRIE ===")
+	fmt.Println()
+
+	// Example 1: Tokenizing and counting
+	fmt.Println("=== EXAMPLE 1: Counting Repeated Words ===")
+	text := "The trie doesn't store a word twice: the word's node counts it. 'Quoted' words count too, THE end."
+	trie, tokens, _ := CountWords(strings.NewReader(text))
+	fmt.Printf("Text: %s\n", text)
+	fmt.Printf("Tokens: %v\n", tokenizeWords(text))
+	fmt.Printf("%d tokens, %d distinct; top 3: %v\n", tokens, trie.Size(), TopWords(trie, 3))
+	fmt.Println()
+
+	// Example 2: The wordfreq command on a real document
+	fmt.Println("=== EXAMPLE 2: dsa wordfreq TRIE_EXPLANATION.md ===")
+	document, _ := explanationDocs.ReadFile("TRIE_EXPLANATION.md")
+	fmt.Println("$ dsa wordfreq --top 8 --hapaxes 12 TRIE_EXPLANATION.md")
+	if err := wordFreqCommand(strings.NewReader(string(document)), "TRIE_EXPLANATION.md", 8, 12, os.Stdout); err != nil {
+		fmt.Printf("wordfreq failed: %v\n", err)
+	}
+	fmt.Println()
+
+	// Example 3: The heap agrees with sorting everything
+	fmt.Println("=== EXAMPLE 3: Bounded Heap vs Full Sort, All Documents ===")
+	files, _ := explanationDocs.ReadDir(".")
+	var all strings.Builder
+	for _, file := range files {
+		content, _ := explanationDocs.ReadFile(file.Name())
+		all.Write(content)
+	}
+	corpus, tokens, _ := CountWords(strings.NewReader(all.String()))
+	sorted := []WordCount{}
+	for it := corpus.Iter(""); it.Next(); {
+		sorted = append(sorted, WordCount{it.Word(), it.Count()})
+	}
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Count > sorted[j].Count })
+	agree := true
+	for _, k := range []int{1, 10, 100, len(sorted) + 5} {
+		agree = agree && fmt.Sprint(TopWords(corpus, k)) == fmt.Sprint(sorted[:min(k, len(sorted))])
+	}
+	fmt.Printf("%d documents, %d tokens, %d distinct words, %d hapaxes\n", len(files), tokens, corpus.Size(), len(Hapaxes(corpus)))
+	fmt.Printf("TopWords matches a full sort for k = 1, 10, 100 and more than the vocabulary: %v\n", agree)
+	fmt.Println()
+
+	fmt.Println("Inserting a word that is already present only bumps the count on its")
+	fmt.Println("final node, so the trie doubles as a frequency table whose iteration")
+	fmt.Println("order is alphabetical. Top-k then needs just a size-k heap over that")
+	fmt.Println("stream, and hapaxes are the words whose node count is exactly one.")
+	fmt.Println()
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// ================================
+// WORDFREQ COMMAND
+// ================================
+
+// runWordFreqCommand implements
+//
+//	dsa wordfreq [--top N] [--hapaxes N] FILE
+//
+// which counts the words of FILE ("-" for standard input) in a Trie and
+// prints the N most frequent words and the words that occur only once
+func runWordFreqCommand(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("wordfreq", flag.ContinueOnError)
+	top := flags.Int("top", 20, "most frequent words to list")
+	hapaxes := flags.Int("hapaxes", 20, "words occurring once to list (0 for the count only)")
+	if err := flags.Parse(args); errors.Is(err, flag.ErrHelp) {
+		return nil // flags already printed the usage
+	} else if err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return fmt.Errorf("usage: dsa wordfreq [--top N] [--hapaxes N] FILE")
+	}
+	if *top < 0 || *hapaxes < 0 {
+		return fmt.Errorf("--top and --hapaxes must not be negative")
+	}
+
+	inputPath := flags.Arg(0)
+	input := io.Reader(os.Stdin)
+	if inputPath != "-" {
+		file, err := os.Open(inputPath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		input = file
+	}
+	return wordFreqCommand(input, inputPath, *top, *hapaxes, stdout)
+}
+
+// wordFreqCommand does the work of dsa wordfreq once the file is open
+func wordFreqCommand(input io.Reader, inputName string, top, hapaxes int, out io.Writer) error {
+	trie, tokens, err := CountWords(input)
+	if err != nil {
+		return fmt.Errorf("%s: %w", inputName, err)
+	}
+	if tokens == 0 {
+		fmt.Fprintf(out, "%s: no words\n", inputName)
+		return nil
+	}
+
+	stats := trie.Stats()
+	fmt.Fprintf(out, "%s: %d words, %d distinct, %d trie nodes\n", inputName, tokens, trie.Size(), stats.Nodes)
+	fmt.Fprintf(out, "%6s %8s %7s  %s\n", "RANK", "COUNT", "SHARE", "WORD")
+	for i, word := range TopWords(trie, top) {
+		fmt.Fprintf(out, "%6d %8d %6.2f%%  %s\n", i+1, word.Count, 100*float64(word.Count)/float64(tokens), word.Word)
+	}
+
+	once := Hapaxes(trie)
+	fmt.Fprintf(out, "Hapax legomena: %d (%.1f%% of distinct words)\n", len(once), 100*float64(len(once))/float64(trie.Size()))
+	if hapaxes > 0 && len(once) > 0 {
+		listed := once[:min(hapaxes, len(once))]
+		fmt.Fprintf(out, "  %s", strings.Join(listed, ", "))
+		if hidden := len(once) - len(listed); hidden > 0 {
+			fmt.Fprintf(out, ", ... %d more", hidden)
+		}
+		fmt.Fprintln(out)
+	}
+	return nil
+}